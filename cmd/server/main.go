@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
@@ -19,10 +20,17 @@ import (
 	"loveguru/internal/chat"
 	"loveguru/internal/config"
 	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+	"loveguru/internal/encryption"
 	"loveguru/internal/grpc/middleware"
 	"loveguru/internal/logger"
+	"loveguru/internal/moderation"
 	"loveguru/internal/notifications"
+	"loveguru/internal/performance"
+	"loveguru/internal/queue"
 	"loveguru/internal/rating"
+	"loveguru/internal/recommendation"
+	"loveguru/internal/storage"
 	"loveguru/internal/user"
 
 	pbadmin "loveguru/proto/admin"
@@ -31,6 +39,7 @@ import (
 	pbauth "loveguru/proto/auth"
 	pbcall "loveguru/proto/call"
 	pbchat "loveguru/proto/chat"
+	pbqueue "loveguru/proto/queue"
 	pbrating "loveguru/proto/rating"
 	pbuser "loveguru/proto/user"
 
@@ -62,7 +71,7 @@ func main() {
 	var cacheService *cache.Cache
 	if cfg.Redis.Host != "" {
 		cacheService = cache.NewCache(
-			cfg.Redis.Host+":"+string(rune(cfg.Redis.Port)),
+			fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
 			cfg.Redis.Password,
 			cfg.Redis.DB,
 		)
@@ -93,16 +102,94 @@ func main() {
 		}
 	}
 
+	// Initialize encryption for sensitive data at rest (optional)
+	dataCipher, err := encryption.NewCipherFromHex(cfg.Encryption.Key)
+	if err != nil {
+		log.Printf("Warning: encryption not available: %v", err)
+		log.Println("Advisor credential uploads and client notes will be rejected until an encryption key is configured")
+		dataCipher = nil
+	}
+
+	// Chat messages and AI conversations are encrypted with keys kept in the database,
+	// themselves encrypted with the KEK; without one they are stored as plaintext
+	contentKeyring, err := encryption.NewKeyring(context.Background(), queries, &cfg.Encryption)
+	if err != nil {
+		log.Fatalf("failed to load content encryption keys: %v", err)
+	}
+	if !contentKeyring.Enabled() {
+		log.Println("Warning: encryption.kek not configured, chat messages and AI conversations are stored as plaintext")
+	}
+
+	// Earnings ledger is fed whenever a chat or call session ends
+	earningsLedger := earnings.NewLedger(queries)
+
+	// Advisor session capacity is enforced wherever chat and call sessions are created
+	sessionCapacity := advisor.NewCapacity(dbConn)
+
+	// Background jobs stop when the server shuts down
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// Advisor performance metrics are refreshed incrementally in the background
+	performanceTracker := performance.NewTracker(queries)
+	go performanceTracker.Run(backgroundCtx)
+
+	// Content keys are rotated and older content re-encrypted in the background
+	go contentKeyring.Run(backgroundCtx)
+
 	// Create services
 	authService := auth.NewService(auth.NewRepository(queries), cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL)
 	userService := user.NewService(queries)
-	advisorService := advisor.NewService(queries)
+	applicationWorkflow := advisor.NewApplicationWorkflow(dbConn, notificationService)
+	advisorService := advisor.NewService(queries, applicationWorkflow, dataCipher, recommendation.NewEngine(queries, contentKeyring), performanceTracker, sessionCapacity)
+
+	// Chat attachments are kept in a local blob store and downloaded through signed links
+	var attachmentStore storage.BlobStore
+	if store, err := storage.NewLocalStore(cfg.Attachments.Dir); err != nil {
+		log.Printf("Warning: attachment storage not available: %v", err)
+		log.Println("Chat attachments will be rejected until attachments.dir is writable")
+	} else {
+		attachmentStore = store
+	}
 
-	// Create WebSocket hub for real-time chat
-	chatHub := chat.NewHub(chat.NewService(queries))
-	go chatHub.Run()
+	// Links never share a key with tokens: without a key of their own, one is derived from the JWT secret
+	urlSigningKey := cfg.Attachments.SigningKey
+	if urlSigningKey == "" {
+		urlSigningKey, err = storage.DeriveURLKey(cfg.JWT.Secret)
+		if err != nil {
+			log.Fatalf("invalid attachments config: %v", err)
+		}
+	}
+	attachmentURLs, err := storage.NewURLSigner(urlSigningKey, cfg.Attachments.BaseURL+"/chat/attachments", time.Duration(cfg.Attachments.URLTTL)*time.Second)
+	if err != nil {
+		log.Fatalf("invalid attachments config: %v", err)
+	}
+
+	// Chats are shared across instances through Redis when configured
+	var chatBackend chat.Backend = chat.NewMemoryBackend()
+	if cfg.Chat.Backend == "redis" {
+		if cacheService == nil {
+			log.Fatal("chat.backend is redis but no Redis host is configured")
+		}
+		chatBackend = chat.NewRedisBackend(cacheService)
+	}
 
-	chatService := chat.NewService(queries)
+	// Chat messages are screened for contact details and abuse with rules admins edit
+	// at runtime
+	moderationFilter := moderation.NewFilter(queries)
+	go moderationFilter.Run(backgroundCtx)
+
+	// Create WebSocket hub for real-time chat
+	// Chat service also expires unanswered session requests and ends idle chats, and
+	// pushes messages to participants who are not connected
+	chatService := chat.NewService(queries, earningsLedger, sessionCapacity, notificationService, &cfg.Sessions, attachmentStore, attachmentURLs, chatBackend, moderationFilter, contentKeyring)
+	go chatService.Run(backgroundCtx)
+
+	// Connections are spread over several hubs, which are drained on shutdown
+	chatHubs := chat.NewHubManager(chatService, chatBackend, cfg.Chat.MaxConnectionsPerHub)
+	for i := 0; i < max(cfg.Chat.Hubs, 1); i++ {
+		chatHubs.AddHub(backgroundCtx, fmt.Sprintf("hub-%d", i))
+	}
 
 	// Initialize Agora service
 	agoraService := call.NewAgoraService(&cfg.Agora)
@@ -113,27 +200,32 @@ func main() {
 		log.Println("VoIP functionality will not work properly without valid Agora credentials")
 	}
 
-	callService := call.NewService(queries, agoraService)
+	callService := call.NewService(queries, agoraService, earningsLedger, sessionCapacity)
 
 	ratingService := rating.NewService(queries)
 
+	// Waiting queue offers sessions to queued users as advisors become free
+	queueService := queue.NewService(queries, notificationService, sessionCapacity, chatBackend)
+	go queueService.Run(backgroundCtx)
+
 	// Initialize AI service with real OpenAI integration
-	aiService := ai.NewServiceWithConfig(queries, cfg.OpenAI.APIKey, cfg.OpenAI.BaseURL, cfg.OpenAI.Model, cfg.OpenAI.MaxTokens)
+	aiService := ai.NewServiceWithConfig(queries, cfg.OpenAI.APIKey, cfg.OpenAI.BaseURL, cfg.OpenAI.Model, cfg.OpenAI.MaxTokens, contentKeyring)
 
 	// Validate OpenAI configuration
 	if cfg.OpenAI.APIKey == "" {
 		log.Println("Warning: OpenAI API key not configured. AI chat functionality will not work.")
 	}
 
-	adminService := admin.NewService(queries)
+	adminService := admin.NewService(queries, applicationWorkflow, dataCipher, performanceTracker, moderationFilter, contentKeyring)
 
 	// Create handlers
 	authHandler := auth.NewHandler(authService)
 	userHandler := user.NewHandler(userService)
 	advisorHandler := advisor.NewHandler(advisorService)
-	chatHandler := chat.NewHandler(chatService)
+	chatHandler := chat.NewHandler(chatService, chatHubs)
 	callHandler := call.NewHandler(callService)
 	ratingHandler := rating.NewHandler(ratingService)
+	queueHandler := queue.NewHandler(queueService)
 	aiHandler := ai.NewHandler(aiService)
 	adminHandler := admin.NewHandler(adminService)
 
@@ -153,6 +245,7 @@ func main() {
 	pbchat.RegisterChatServiceServer(s, chatHandler)
 	pbcall.RegisterCallServiceServer(s, callHandler)
 	pbrating.RegisterRatingServiceServer(s, ratingHandler)
+	pbqueue.RegisterQueueServiceServer(s, queueHandler)
 	pbai.RegisterAIServiceServer(s, aiHandler)
 	pbadmin.RegisterAdminServiceServer(s, adminHandler)
 
@@ -171,15 +264,34 @@ func main() {
 			return
 		}
 
-		// Validate token and extract user ID (simplified)
-		userID := "user123" // In real implementation, validate JWT token
+		user, err := middleware.ParseToken(token, cfg.JWT.Secret)
+		if err != nil {
+			http.Error(w, "Invalid token", http.StatusUnauthorized)
+			return
+		}
 
-		chatHub.HandleWebSocket(w, r, sessionID, userID)
+		// Reconnecting clients pass the last message they have to receive only what they missed
+		chatHubs.HandleWebSocket(w, r, sessionID, user.ID, r.URL.Query().Get("resume"))
 	})
 
+	// Admins watch the chat connections of this instance here
+	chatMetricsHandler := chat.NewMetricsHandler(chatHubs, cfg.JWT.Secret)
+	mux.HandleFunc("GET /admin/chat/metrics", chatMetricsHandler.Metrics)
+
+	// Chat attachments are uploaded here before being sent over the chat
+	attachmentHandler := chat.NewAttachmentHandler(chatService, cfg.JWT.Secret)
+	mux.HandleFunc("POST /chat/attachments", attachmentHandler.Upload)
+	mux.HandleFunc("GET /chat/attachments/{id}", attachmentHandler.Download)
+
 	// Health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
+		// Load balancers stop sending new connections to an instance that is draining
+		if chatHubs.Draining() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"status": "draining", "timestamp": "` + time.Now().Format(time.RFC3339) + `"}`))
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{"status": "healthy", "timestamp": "` + time.Now().Format(time.RFC3339) + `"}`))
 	})
@@ -224,6 +336,13 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Chat clients are told to reconnect and get what was queued for them, and what they
+	// sent is stored, before the servers stop
+	if err := chatHubs.Drain(shutdownCtx); err != nil {
+		log.Printf("Chat hubs not fully drained: %v", err)
+	}
+
+	stopBackground()
 	s.GracefulStop()
 
 	if err := httpServer.Shutdown(shutdownCtx); err != nil {
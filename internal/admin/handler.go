@@ -29,3 +29,95 @@ func (h *Handler) GetFlags(ctx context.Context, req *admin.GetFlagsRequest) (*ad
 func (h *Handler) BlockUser(ctx context.Context, req *admin.BlockUserRequest) (*admin.BlockUserResponse, error) {
 	return h.service.BlockUser(ctx, req)
 }
+
+func (h *Handler) GetAdvisorApplications(ctx context.Context, req *admin.GetAdvisorApplicationsRequest) (*admin.GetAdvisorApplicationsResponse, error) {
+	return h.service.GetAdvisorApplications(ctx, req)
+}
+
+func (h *Handler) ReviewAdvisorApplication(ctx context.Context, req *admin.ReviewAdvisorApplicationRequest) (*admin.ReviewAdvisorApplicationResponse, error) {
+	return h.service.ReviewAdvisorApplication(ctx, req)
+}
+
+func (h *Handler) GetCredentialDocument(ctx context.Context, req *admin.GetCredentialDocumentRequest) (*admin.GetCredentialDocumentResponse, error) {
+	return h.service.GetCredentialDocument(ctx, req)
+}
+
+func (h *Handler) CreatePayoutBatch(ctx context.Context, req *admin.CreatePayoutBatchRequest) (*admin.CreatePayoutBatchResponse, error) {
+	return h.service.CreatePayoutBatch(ctx, req)
+}
+
+func (h *Handler) MarkPayoutBatchPaid(ctx context.Context, req *admin.MarkPayoutBatchPaidRequest) (*admin.MarkPayoutBatchPaidResponse, error) {
+	return h.service.MarkPayoutBatchPaid(ctx, req)
+}
+
+func (h *Handler) GetPayoutBatches(ctx context.Context, req *admin.GetPayoutBatchesRequest) (*admin.GetPayoutBatchesResponse, error) {
+	return h.service.GetPayoutBatches(ctx, req)
+}
+
+func (h *Handler) GetCommissionTiers(ctx context.Context, req *admin.GetCommissionTiersRequest) (*admin.GetCommissionTiersResponse, error) {
+	return h.service.GetCommissionTiers(ctx, req)
+}
+
+func (h *Handler) SetCommissionTier(ctx context.Context, req *admin.SetCommissionTierRequest) (*admin.SetCommissionTierResponse, error) {
+	return h.service.SetCommissionTier(ctx, req)
+}
+
+func (h *Handler) SetAdvisorTier(ctx context.Context, req *admin.SetAdvisorTierRequest) (*admin.SetAdvisorTierResponse, error) {
+	return h.service.SetAdvisorTier(ctx, req)
+}
+
+func (h *Handler) GetAdvisorStats(ctx context.Context, req *admin.GetAdvisorStatsRequest) (*admin.GetAdvisorStatsResponse, error) {
+	return h.service.GetAdvisorStats(ctx, req)
+}
+
+func (h *Handler) GetPlatformTemplates(ctx context.Context, req *admin.GetPlatformTemplatesRequest) (*admin.GetPlatformTemplatesResponse, error) {
+	return h.service.GetPlatformTemplates(ctx, req)
+}
+
+func (h *Handler) CreatePlatformTemplate(ctx context.Context, req *admin.CreatePlatformTemplateRequest) (*admin.CreatePlatformTemplateResponse, error) {
+	return h.service.CreatePlatformTemplate(ctx, req)
+}
+
+func (h *Handler) UpdatePlatformTemplate(ctx context.Context, req *admin.UpdatePlatformTemplateRequest) (*admin.UpdatePlatformTemplateResponse, error) {
+	return h.service.UpdatePlatformTemplate(ctx, req)
+}
+
+func (h *Handler) DeletePlatformTemplate(ctx context.Context, req *admin.DeletePlatformTemplateRequest) (*admin.DeletePlatformTemplateResponse, error) {
+	return h.service.DeletePlatformTemplate(ctx, req)
+}
+
+func (h *Handler) GetMessageHistory(ctx context.Context, req *admin.GetMessageHistoryRequest) (*admin.GetMessageHistoryResponse, error) {
+	return h.service.GetMessageHistory(ctx, req)
+}
+
+func (h *Handler) GetModerationRules(ctx context.Context, req *admin.GetModerationRulesRequest) (*admin.GetModerationRulesResponse, error) {
+	return h.service.GetModerationRules(ctx, req)
+}
+
+func (h *Handler) SetModerationRule(ctx context.Context, req *admin.SetModerationRuleRequest) (*admin.SetModerationRuleResponse, error) {
+	return h.service.SetModerationRule(ctx, req)
+}
+
+func (h *Handler) AddModerationTerms(ctx context.Context, req *admin.AddModerationTermsRequest) (*admin.AddModerationTermsResponse, error) {
+	return h.service.AddModerationTerms(ctx, req)
+}
+
+func (h *Handler) RemoveModerationTerms(ctx context.Context, req *admin.RemoveModerationTermsRequest) (*admin.RemoveModerationTermsResponse, error) {
+	return h.service.RemoveModerationTerms(ctx, req)
+}
+
+func (h *Handler) ExportSessionTranscript(ctx context.Context, req *admin.ExportSessionTranscriptRequest) (*admin.ExportSessionTranscriptResponse, error) {
+	return h.service.ExportSessionTranscript(ctx, req)
+}
+
+func (h *Handler) CreditUserBalance(ctx context.Context, req *admin.CreditUserBalanceRequest) (*admin.CreditUserBalanceResponse, error) {
+	return h.service.CreditUserBalance(ctx, req)
+}
+
+func (h *Handler) RotateEncryptionKey(ctx context.Context, req *admin.RotateEncryptionKeyRequest) (*admin.RotateEncryptionKeyResponse, error) {
+	return h.service.RotateEncryptionKey(ctx, req)
+}
+
+func (h *Handler) GetEncryptionStatus(ctx context.Context, req *admin.GetEncryptionStatusRequest) (*admin.GetEncryptionStatusResponse, error) {
+	return h.service.GetEncryptionStatus(ctx, req)
+}
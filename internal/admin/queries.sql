@@ -29,4 +29,29 @@ DELETE FROM specializations WHERE id = $1;
 -- name: GetUserSpecializations :many
 SELECT s.name, s.category FROM specializations s
 JOIN advisors a ON a.specializations && ARRAY[s.name]
-WHERE a.user_id = $1;
\ No newline at end of file
+WHERE a.user_id = $1;
+-- name: ListPlatformTemplates :many
+SELECT * FROM message_templates WHERE advisor_id IS NULL ORDER BY category NULLS LAST, title;
+
+-- name: UpdatePlatformTemplate :one
+UPDATE message_templates
+SET title = $2, body = $3, category = $4, is_active = $5, updated_at = NOW()
+WHERE id = $1 AND advisor_id IS NULL
+RETURNING *;
+
+-- name: DeletePlatformTemplate :execrows
+DELETE FROM message_templates WHERE id = $1 AND advisor_id IS NULL;
+
+-- name: GetAdminFlag :one
+SELECT * FROM admin_flags WHERE id = $1;
+
+-- name: CreditUserBalance :one
+WITH credited AS (
+    INSERT INTO user_balances (user_id, balance) VALUES (sqlc.arg(user_id), sqlc.arg(amount)::numeric)
+    ON CONFLICT (user_id) DO UPDATE SET balance = user_balances.balance + EXCLUDED.balance, updated_at = NOW()
+    RETURNING user_id, balance
+)
+INSERT INTO balance_transactions (user_id, kind, amount, balance_after, created_by, note)
+SELECT credited.user_id, 'CREDIT', sqlc.arg(amount)::numeric, credited.balance, sqlc.arg(created_by), sqlc.narg(note)
+FROM credited
+RETURNING *;
@@ -2,11 +2,22 @@ package admin
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"log"
 	"strconv"
+	"strings"
+	"time"
 
+	"loveguru/internal/advisor"
 	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+	"loveguru/internal/encryption"
 	"loveguru/internal/grpc/middleware"
+	"loveguru/internal/moderation"
+	"loveguru/internal/performance"
+	"loveguru/internal/transcript"
 	"loveguru/proto/admin"
 	"loveguru/proto/common"
 
@@ -14,11 +25,17 @@ import (
 )
 
 type Service struct {
-	repo *db.Queries
+	repo        *db.Queries
+	workflow    *advisor.ApplicationWorkflow
+	cipher      *encryption.Cipher
+	tracker     *performance.Tracker
+	filter      *moderation.Filter
+	transcripts *transcript.Exporter
+	keyring     *encryption.Keyring
 }
 
-func NewService(repo *db.Queries) *Service {
-	return &Service{repo: repo}
+func NewService(repo *db.Queries, workflow *advisor.ApplicationWorkflow, cipher *encryption.Cipher, tracker *performance.Tracker, filter *moderation.Filter, keyring *encryption.Keyring) *Service {
+	return &Service{repo: repo, workflow: workflow, cipher: cipher, tracker: tracker, filter: filter, transcripts: transcript.NewExporter(repo, keyring), keyring: keyring}
 }
 
 func (s *Service) GetPendingAdvisors(ctx context.Context, req *admin.GetPendingAdvisorsRequest) (*admin.GetPendingAdvisorsResponse, error) {
@@ -66,7 +83,35 @@ func (s *Service) ApproveAdvisor(ctx context.Context, req *admin.ApproveAdvisorR
 		return nil, err
 	}
 
-	err = s.repo.ApproveAdvisor(ctx, aid)
+	reviewerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.repo.GetAdvisorApplicationByAdvisorID(ctx, aid)
+	if db.IsNotFound(err) {
+		// Advisors created before the application workflow have no application row
+		if err := s.repo.ApproveAdvisor(ctx, aid); err != nil {
+			return nil, err
+		}
+		return &admin.ApproveAdvisorResponse{Success: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Applicants finish their draft or the changes asked of them and submit it first
+	if app.Status == advisor.ApplicationApproved {
+		return &admin.ApproveAdvisorResponse{Success: true}, nil
+	}
+	if !advisor.CanTransition(app.Status, advisor.ApplicationApproved) {
+		return nil, fmt.Errorf("application not submitted, it is %s", app.Status)
+	}
+
+	_, err = s.workflow.Transition(ctx, app, advisor.TransitionInput{
+		To:      advisor.ApplicationApproved,
+		ActorID: reviewerID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +119,118 @@ func (s *Service) ApproveAdvisor(ctx context.Context, req *admin.ApproveAdvisorR
 	return &admin.ApproveAdvisorResponse{Success: true}, nil
 }
 
+func (s *Service) GetAdvisorApplications(ctx context.Context, req *admin.GetAdvisorApplicationsRequest) (*admin.GetAdvisorApplicationsResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	apps, err := s.repo.ListAdvisorApplicationsByStatus(ctx, db.ListAdvisorApplicationsByStatusParams{
+		Status: req.Status.String(),
+		Limit:  int32(req.Limit),
+		Offset: int32(req.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*common.AdvisorApplication
+	for _, app := range apps {
+		docs, err := s.repo.ListCredentialDocuments(ctx, app.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, advisor.MapApplication(app, docs, true))
+	}
+
+	return &admin.GetAdvisorApplicationsResponse{Applications: resp}, nil
+}
+
+func (s *Service) ReviewAdvisorApplication(ctx context.Context, req *admin.ReviewAdvisorApplicationRequest) (*admin.ReviewAdvisorApplicationResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	reviewerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	appID, err := uuid.Parse(req.ApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drafts and submissions are applicant actions
+	switch req.Status {
+	case common.ApplicationStatus_UNDER_REVIEW, common.ApplicationStatus_NEEDS_CHANGES,
+		common.ApplicationStatus_APPROVED, common.ApplicationStatus_REJECTED:
+	default:
+		return nil, errors.New("admins can only move applications to UNDER_REVIEW, NEEDS_CHANGES, APPROVED or REJECTED")
+	}
+
+	app, err := s.repo.GetAdvisorApplicationByID(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.workflow.Transition(ctx, app, advisor.TransitionInput{
+		To:              req.Status.String(),
+		ActorID:         reviewerID,
+		ReviewerNotes:   req.ReviewerNotes,
+		RejectionReason: req.RejectionReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := s.repo.ListCredentialDocuments(ctx, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.ReviewAdvisorApplicationResponse{Application: advisor.MapApplication(updated, docs, true)}, nil
+}
+
+func (s *Service) GetCredentialDocument(ctx context.Context, req *admin.GetCredentialDocumentRequest) (*admin.GetCredentialDocumentResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	docID, err := uuid.Parse(req.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cipher == nil {
+		return nil, encryption.ErrKeyNotConfigured
+	}
+
+	d, err := s.repo.GetCredentialDocument(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.cipher.Open(d.EncryptedContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.GetCredentialDocumentResponse{
+		Document: &common.CredentialDocument{
+			Id:            d.ID.String(),
+			ApplicationId: d.ApplicationID.String(),
+			FileName:      d.FileName,
+			MimeType:      d.MimeType,
+			SizeBytes:     d.SizeBytes,
+			CreatedAt:     d.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		},
+		Content: content,
+	}, nil
+}
+
 func (s *Service) GetFlags(ctx context.Context, req *admin.GetFlagsRequest) (*admin.GetFlagsResponse, error) {
 	userInfo, ok := middleware.GetUserFromContext(ctx)
 	if !ok || userInfo.Role != "ADMIN" {
@@ -92,13 +249,15 @@ func (s *Service) GetFlags(ctx context.Context, req *admin.GetFlagsRequest) (*ad
 	for _, f := range flags {
 		resp = append(resp, &admin.AdminFlag{
 			Id:                f.ID.String(),
-			ReportedBy:        f.ReportedBy.String(),
+			ReportedBy:        f.ReportedBy.UUID.String(),
 			ReportedUserId:    f.ReportedUserID.UUID.String(),
 			ReportedAdvisorId: f.ReportedAdvisorID.UUID.String(),
 			Reason:            f.Reason,
 			SessionId:         f.SessionID.UUID.String(),
 			CreatedAt:         f.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
 			Status:            f.Status.String,
+			Source:            f.Source,
+			MessageId:         f.MessageID.UUID.String(),
 		})
 	}
 
@@ -124,6 +283,437 @@ func (s *Service) BlockUser(ctx context.Context, req *admin.BlockUserRequest) (*
 	return &admin.BlockUserResponse{Success: true}, nil
 }
 
+func (s *Service) CreatePayoutBatch(ctx context.Context, req *admin.CreatePayoutBatchRequest) (*admin.CreatePayoutBatchResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	aid, err := uuid.Parse(req.AdvisorId)
+	if err != nil {
+		return nil, err
+	}
+
+	periodEnd := time.Now()
+	if req.PeriodEnd != "" {
+		periodEnd, err = time.Parse(time.RFC3339, req.PeriodEnd)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period end: %w", err)
+		}
+	}
+
+	batch, err := s.repo.CreatePayoutBatch(ctx, db.CreatePayoutBatchParams{
+		ID:        uuid.New(),
+		AdvisorID: aid,
+		PeriodEnd: periodEnd,
+		CreatedBy: adminID,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("advisor has no unpaid earnings in this period")
+		}
+		return nil, err
+	}
+
+	return &admin.CreatePayoutBatchResponse{Batch: earnings.MapPayoutBatch(batch)}, nil
+}
+
+func (s *Service) MarkPayoutBatchPaid(ctx context.Context, req *admin.MarkPayoutBatchPaidRequest) (*admin.MarkPayoutBatchPaidResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	bid, err := uuid.Parse(req.BatchId)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.repo.MarkPayoutBatchPaid(ctx, db.MarkPayoutBatchPaidParams{
+		ID:               bid,
+		PaymentReference: sql.NullString{String: req.PaymentReference, Valid: req.PaymentReference != ""},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("payout batch not found or already paid")
+		}
+		return nil, err
+	}
+
+	return &admin.MarkPayoutBatchPaidResponse{Batch: earnings.MapPayoutBatch(batch)}, nil
+}
+
+func (s *Service) GetPayoutBatches(ctx context.Context, req *admin.GetPayoutBatchesRequest) (*admin.GetPayoutBatchesResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	params := db.ListPayoutBatchesParams{
+		Status:    sql.NullString{String: req.Status, Valid: req.Status != ""},
+		RowLimit:  req.Limit,
+		RowOffset: req.Offset,
+	}
+	if params.RowLimit <= 0 {
+		params.RowLimit = 50
+	}
+	if req.AdvisorId != "" {
+		aid, err := uuid.Parse(req.AdvisorId)
+		if err != nil {
+			return nil, err
+		}
+		params.AdvisorID = uuid.NullUUID{UUID: aid, Valid: true}
+	}
+
+	batches, err := s.repo.ListPayoutBatches(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*common.PayoutBatch
+	for _, b := range batches {
+		resp = append(resp, earnings.MapPayoutBatch(b))
+	}
+
+	return &admin.GetPayoutBatchesResponse{Batches: resp}, nil
+}
+
+func (s *Service) GetCommissionTiers(ctx context.Context, req *admin.GetCommissionTiersRequest) (*admin.GetCommissionTiersResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	tiers, err := s.repo.ListCommissionTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*admin.CommissionTier
+	for _, t := range tiers {
+		resp = append(resp, mapCommissionTier(t))
+	}
+
+	return &admin.GetCommissionTiersResponse{Tiers: resp}, nil
+}
+
+func (s *Service) SetCommissionTier(ctx context.Context, req *admin.SetCommissionTierRequest) (*admin.SetCommissionTierResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	if req.Tier == "" {
+		return nil, errors.New("tier is required")
+	}
+	if req.CommissionPercent < 0 || req.CommissionPercent > 100 {
+		return nil, errors.New("commission percent must be between 0 and 100")
+	}
+
+	t, err := s.repo.UpsertCommissionTier(ctx, db.UpsertCommissionTierParams{
+		Tier:              req.Tier,
+		CommissionPercent: fmt.Sprintf("%.2f", req.CommissionPercent),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.SetCommissionTierResponse{Tier: mapCommissionTier(t)}, nil
+}
+
+func (s *Service) SetAdvisorTier(ctx context.Context, req *admin.SetAdvisorTierRequest) (*admin.SetAdvisorTierResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	aid, err := uuid.Parse(req.AdvisorId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetAdvisorTier(ctx, db.SetAdvisorTierParams{ID: aid, Tier: req.Tier})
+	if err != nil {
+		if db.IsForeignKeyViolation(err) {
+			return nil, fmt.Errorf("unknown tier %q", req.Tier)
+		}
+		return nil, err
+	}
+
+	return &admin.SetAdvisorTierResponse{Success: true}, nil
+}
+
+func (s *Service) GetPlatformTemplates(ctx context.Context, req *admin.GetPlatformTemplatesRequest) (*admin.GetPlatformTemplatesResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	templates, err := s.repo.ListPlatformTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.GetPlatformTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, advisor.MapTemplate(t))
+	}
+
+	return resp, nil
+}
+
+func (s *Service) CreatePlatformTemplate(ctx context.Context, req *admin.CreatePlatformTemplateRequest) (*admin.CreatePlatformTemplateResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := advisor.ValidateTemplate(req.Title, req.Body); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.CreateMessageTemplate(ctx, db.CreateMessageTemplateParams{
+		Title:     req.Title,
+		Body:      req.Body,
+		Category:  sql.NullString{String: req.Category, Valid: req.Category != ""},
+		CreatedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.CreatePlatformTemplateResponse{Template: advisor.MapTemplate(t)}, nil
+}
+
+func (s *Service) UpdatePlatformTemplate(ctx context.Context, req *admin.UpdatePlatformTemplateRequest) (*admin.UpdatePlatformTemplateResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	tid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := advisor.ValidateTemplate(req.Title, req.Body); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.UpdatePlatformTemplate(ctx, db.UpdatePlatformTemplateParams{
+		ID:       tid,
+		Title:    req.Title,
+		Body:     req.Body,
+		Category: sql.NullString{String: req.Category, Valid: req.Category != ""},
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("template not found")
+		}
+		return nil, err
+	}
+
+	return &admin.UpdatePlatformTemplateResponse{Template: advisor.MapTemplate(t)}, nil
+}
+
+func (s *Service) DeletePlatformTemplate(ctx context.Context, req *admin.DeletePlatformTemplateRequest) (*admin.DeletePlatformTemplateResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	tid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.repo.DeletePlatformTemplate(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("template not found")
+	}
+
+	return &admin.DeletePlatformTemplateResponse{Success: true}, nil
+}
+
+func (s *Service) GetAdvisorStats(ctx context.Context, req *admin.GetAdvisorStatsRequest) (*admin.GetAdvisorStatsResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	aid, err := uuid.Parse(req.AdvisorId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.repo.GetAdvisorByID(ctx, aid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("advisor not found")
+		}
+		return nil, err
+	}
+
+	from, to, err := earnings.ParseRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.tracker.Report(ctx, a.UserID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.GetAdvisorStatsResponse{Report: report}, nil
+}
+
+func (s *Service) GetModerationRules(ctx context.Context, req *admin.GetModerationRulesRequest) (*admin.GetModerationRulesResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	rules, err := s.repo.ListModerationRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	terms, err := s.repo.ListModerationTerms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.GetModerationRulesResponse{Terms: terms}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, mapModerationRule(r))
+	}
+
+	return resp, nil
+}
+
+// SetModerationRule changes what happens to messages a detector matches. The change
+// applies to this instance at once and to the others within 30 seconds.
+func (s *Service) SetModerationRule(ctx context.Context, req *admin.SetModerationRuleRequest) (*admin.SetModerationRuleResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := moderation.ValidateRule(req.Detector, req.Action); err != nil {
+		return nil, err
+	}
+
+	r, err := s.repo.UpdateModerationRule(ctx, db.UpdateModerationRuleParams{
+		Detector:  req.Detector,
+		Action:    req.Action,
+		Notice:    strings.TrimSpace(req.Notice),
+		UpdatedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadModeration(ctx)
+
+	return &admin.SetModerationRuleResponse{Rule: mapModerationRule(r)}, nil
+}
+
+func (s *Service) AddModerationTerms(ctx context.Context, req *admin.AddModerationTermsRequest) (*admin.AddModerationTermsResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := moderation.NormalizeTerms(req.Terms)
+	if len(terms) == 0 {
+		return nil, errors.New("terms are required")
+	}
+
+	n, err := s.repo.AddModerationTerms(ctx, db.AddModerationTermsParams{
+		Terms:     terms,
+		CreatedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadModeration(ctx)
+
+	return &admin.AddModerationTermsResponse{Added: int32(n)}, nil
+}
+
+func (s *Service) RemoveModerationTerms(ctx context.Context, req *admin.RemoveModerationTermsRequest) (*admin.RemoveModerationTermsResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	terms := moderation.NormalizeTerms(req.Terms)
+	if len(terms) == 0 {
+		return nil, errors.New("terms are required")
+	}
+
+	n, err := s.repo.RemoveModerationTerms(ctx, terms)
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadModeration(ctx)
+
+	return &admin.RemoveModerationTermsResponse{Removed: int32(n)}, nil
+}
+
+// reloadModeration applies changed rules on this instance right away; the others
+// reload on their own
+func (s *Service) reloadModeration(ctx context.Context) {
+	if s.filter == nil {
+		return
+	}
+	if err := s.filter.Reload(ctx); err != nil {
+		log.Printf("Error reloading moderation rules: %v", err)
+	}
+}
+
+func mapModerationRule(r db.ModerationRule) *admin.ModerationRule {
+	return &admin.ModerationRule{
+		Detector:  r.Detector,
+		Action:    r.Action,
+		Notice:    r.Notice,
+		UpdatedAt: r.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func mapCommissionTier(t db.CommissionTier) *admin.CommissionTier {
+	return &admin.CommissionTier{
+		Tier:              t.Tier,
+		CommissionPercent: earnings.ParseAmount(t.CommissionPercent),
+		UpdatedAt:         t.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
 // TODO: Implement specialization management once database queries are available
 /*
 func (s *Service) GetAllSpecializations(ctx context.Context) ([]Specialization, error) {
@@ -158,3 +748,246 @@ type Specialization struct {
 	Category    string
 	IsActive    bool
 }
+
+// GetMessageHistory shows a chat message with the content it had before every edit or
+// deletion, for moderating reports
+func (s *Service) GetMessageHistory(ctx context.Context, req *admin.GetMessageHistoryRequest) (*admin.GetMessageHistoryResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	mid, err := uuid.Parse(req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.repo.GetChatMessageByID(ctx, mid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("message not found")
+		}
+		return nil, err
+	}
+
+	revisions, err := s.repo.GetMessageRevisions(ctx, mid)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.keyring.Open(ctx, m.Content, m.ContentKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.GetMessageHistoryResponse{
+		Message: &common.ChatMessage{
+			Id:         m.ID.String(),
+			SessionId:  m.SessionID.String(),
+			SenderType: m.SenderType,
+			SenderId:   m.SenderID.String(),
+			Content:    content,
+			CreatedAt:  m.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+			IsRead:     m.IsRead.Bool,
+			IsDeleted:  m.DeletedAt.Valid,
+		},
+	}
+	if m.EditedAt.Valid {
+		resp.Message.EditedAt = m.EditedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	if m.DeletedAt.Valid {
+		resp.Message.DeletedAt = m.DeletedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	for _, r := range revisions {
+		content, err := s.keyring.Open(ctx, r.Content, r.ContentKeyID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Revisions = append(resp.Revisions, &admin.MessageRevision{
+			Action:    r.Action,
+			Content:   content,
+			CreatedAt: r.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		})
+	}
+
+	return resp, nil
+}
+
+// ExportSessionTranscript renders a session with the content messages had before they
+// were edited or deleted, as evidence when investigating a flag
+func (s *Service) ExportSessionTranscript(ctx context.Context, req *admin.ExportSessionTranscriptRequest) (*admin.ExportSessionTranscriptResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	var sid uuid.UUID
+	if req.FlagId != "" {
+		fid, err := uuid.Parse(req.FlagId)
+		if err != nil {
+			return nil, err
+		}
+		flag, err := s.repo.GetAdminFlag(ctx, fid)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil, errors.New("flag not found")
+			}
+			return nil, err
+		}
+		if !flag.SessionID.Valid {
+			return nil, errors.New("flag is not about a session")
+		}
+		sid = flag.SessionID.UUID
+	} else {
+		var err error
+		if sid, err = uuid.Parse(req.SessionId); err != nil {
+			return nil, err
+		}
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("session not found")
+		}
+		return nil, err
+	}
+
+	t, err := s.transcripts.Build(ctx, session, true)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := transcript.Render(t, transcript.Format(req.Format.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.ExportSessionTranscriptResponse{
+		Content:  doc.Content,
+		MimeType: doc.MimeType,
+		Filename: doc.Filename,
+	}, nil
+}
+
+// CreditUserBalance adds funds a user paid for to the balance they spend on paid chats
+func (s *Service) CreditUserBalance(ctx context.Context, req *admin.CreditUserBalanceRequest) (*admin.CreditUserBalanceResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := fmt.Sprintf("%.2f", req.Amount)
+	if earnings.ParseAmount(amount) <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	if _, err := s.repo.GetUserByID(ctx, uid); err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+
+	t, err := s.repo.CreditUserBalance(ctx, db.CreditUserBalanceParams{
+		UserID:    uid,
+		Amount:    amount,
+		CreatedBy: uuid.NullUUID{UUID: adminID, Valid: true},
+		Note:      sql.NullString{String: req.Note, Valid: req.Note != ""},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.CreditUserBalanceResponse{Transaction: earnings.MapBalanceTransaction(t)}, nil
+}
+
+// RotateEncryptionKey makes a new key the one chat messages and AI conversations are
+// encrypted with, e.g. when the current one may have leaked. Content encrypted with
+// older keys is re-encrypted in the background.
+func (s *Service) RotateEncryptionKey(ctx context.Context, req *admin.RotateEncryptionKeyRequest) (*admin.RotateEncryptionKeyResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	if !s.keyring.Enabled() {
+		return nil, errors.New("content encryption is not configured")
+	}
+
+	key, err := s.keyring.Rotate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin.RotateEncryptionKeyResponse{
+		Key: &admin.EncryptionKey{
+			Id:        key.ID.String(),
+			CreatedAt: key.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			Active:    true,
+		},
+	}, nil
+}
+
+// GetEncryptionStatus shows the content keys and how much content is still waiting to
+// be re-encrypted or indexed for search
+func (s *Service) GetEncryptionStatus(ctx context.Context, req *admin.GetEncryptionStatusRequest) (*admin.GetEncryptionStatusResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok || userInfo.Role != "ADMIN" {
+		return nil, errors.New("unauthorized")
+	}
+
+	keys, err := s.repo.ListEncryptionKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.repo.CountContentByKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unindexed, err := s.repo.CountUnindexedMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.GetEncryptionStatusResponse{
+		Enabled:        s.keyring.Enabled(),
+		KekId:          s.keyring.KEKID(),
+		UnindexedCount: unindexed,
+	}
+
+	byKey := make(map[uuid.UUID]int32, len(counts))
+	for _, c := range counts {
+		if !c.ContentKeyID.Valid {
+			resp.PlaintextCount = c.ContentCount
+			continue
+		}
+		byKey[c.ContentKeyID.UUID] = c.ContentCount
+	}
+
+	active := s.keyring.ActiveKeyID()
+	for _, k := range keys {
+		if k.Purpose != encryption.PurposeContent {
+			continue
+		}
+		resp.Keys = append(resp.Keys, &admin.EncryptionKey{
+			Id:           k.ID.String(),
+			CreatedAt:    k.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			Active:       active.Valid && active.UUID == k.ID,
+			ContentCount: byKey[k.ID],
+		})
+	}
+
+	return resp, nil
+}
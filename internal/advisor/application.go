@@ -0,0 +1,208 @@
+package advisor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"loveguru/internal/db"
+	"loveguru/internal/notifications"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+// Application statuses as stored in advisor_applications.status
+const (
+	ApplicationDraft        = "DRAFT"
+	ApplicationSubmitted    = "SUBMITTED"
+	ApplicationUnderReview  = "UNDER_REVIEW"
+	ApplicationNeedsChanges = "NEEDS_CHANGES"
+	ApplicationApproved     = "APPROVED"
+	ApplicationRejected     = "REJECTED"
+)
+
+var ErrInvalidTransition = errors.New("invalid application status transition")
+
+// applicationTransitions lists the statuses reachable from each status.
+// Applicants move DRAFT, NEEDS_CHANGES and REJECTED applications to SUBMITTED;
+// every other transition is performed by an admin.
+var applicationTransitions = map[string][]string{
+	ApplicationDraft:        {ApplicationSubmitted},
+	ApplicationSubmitted:    {ApplicationUnderReview, ApplicationNeedsChanges, ApplicationApproved, ApplicationRejected},
+	ApplicationUnderReview:  {ApplicationNeedsChanges, ApplicationApproved, ApplicationRejected},
+	ApplicationNeedsChanges: {ApplicationSubmitted},
+	ApplicationRejected:     {ApplicationSubmitted},
+	ApplicationApproved:     {},
+}
+
+// CanTransition reports whether an application may move from one status to another
+func CanTransition(from, to string) bool {
+	for _, next := range applicationTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEditable reports whether the applicant may still change the application
+func IsEditable(status string) bool {
+	return status == ApplicationDraft || status == ApplicationNeedsChanges || status == ApplicationRejected
+}
+
+// ApplicationWorkflow applies status transitions and notifies the applicant.
+// It is shared by the advisor and admin services.
+type ApplicationWorkflow struct {
+	conn     *sql.DB
+	repo     *db.Queries
+	notifier *notifications.NotificationService
+}
+
+func NewApplicationWorkflow(conn *sql.DB, notifier *notifications.NotificationService) *ApplicationWorkflow {
+	return &ApplicationWorkflow{conn: conn, repo: db.New(conn), notifier: notifier}
+}
+
+// TransitionInput describes a requested status change
+type TransitionInput struct {
+	To              string
+	ActorID         uuid.UUID
+	ReviewerNotes   string
+	RejectionReason string
+}
+
+// Transition moves the application to a new status, records the event and emails the applicant.
+// The update only succeeds if the application is still in the status it was read with. The
+// status, its event and, on approval, the advisor's status change in one transaction, so a
+// failed step leaves the application as it was and the transition can be retried.
+func (w *ApplicationWorkflow) Transition(ctx context.Context, app db.AdvisorApplication, in TransitionInput) (db.AdvisorApplication, error) {
+	if !CanTransition(app.Status, in.To) {
+		return db.AdvisorApplication{}, fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, app.Status, in.To)
+	}
+
+	if (in.To == ApplicationRejected || in.To == ApplicationNeedsChanges) && in.RejectionReason == "" {
+		return db.AdvisorApplication{}, errors.New("a reason is required to reject or request changes")
+	}
+
+	params := db.TransitionAdvisorApplicationParams{
+		ID:              app.ID,
+		FromStatus:      app.Status,
+		ToStatus:        in.To,
+		ReviewerNotes:   sql.NullString{String: in.ReviewerNotes, Valid: in.ReviewerNotes != ""},
+		RejectionReason: sql.NullString{String: in.RejectionReason, Valid: in.RejectionReason != ""},
+	}
+	if in.ActorID != app.UserID {
+		params.ReviewerID = uuid.NullUUID{UUID: in.ActorID, Valid: true}
+	}
+
+	note := in.RejectionReason
+	if note == "" {
+		note = in.ReviewerNotes
+	}
+
+	var updated db.AdvisorApplication
+	err := db.Transaction(ctx, w.conn, func(q *db.Queries) error {
+		var err error
+		updated, err = q.TransitionAdvisorApplication(ctx, params)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return errors.New("application was modified concurrently, please reload")
+			}
+			return err
+		}
+
+		err = q.InsertAdvisorApplicationEvent(ctx, db.InsertAdvisorApplicationEventParams{
+			ApplicationID: app.ID,
+			FromStatus:    app.Status,
+			ToStatus:      in.To,
+			ActorID:       in.ActorID,
+			Note:          sql.NullString{String: note, Valid: note != ""},
+		})
+		if err != nil {
+			return fmt.Errorf("recording application event: %w", err)
+		}
+
+		if in.To == ApplicationApproved {
+			return q.ApproveAdvisor(ctx, updated.AdvisorID)
+		}
+		return nil
+	})
+	if err != nil {
+		return db.AdvisorApplication{}, err
+	}
+
+	w.notifyApplicant(ctx, updated)
+
+	return updated, nil
+}
+
+// notifyApplicant emails the applicant about the application's current status.
+// Email failures are logged and never fail the transition.
+func (w *ApplicationWorkflow) notifyApplicant(ctx context.Context, app db.AdvisorApplication) {
+	if w.notifier == nil {
+		return
+	}
+
+	u, err := w.repo.GetUserByID(ctx, app.UserID)
+	if err != nil {
+		log.Printf("Error loading applicant %s: %v", app.UserID, err)
+		return
+	}
+	if !u.Email.Valid || u.Email.String == "" {
+		return
+	}
+
+	switch app.Status {
+	case ApplicationApproved:
+		err = w.notifier.SendAdvisorApprovalEmail(ctx, u.Email.String, u.DisplayName)
+	case ApplicationRejected:
+		err = w.notifier.SendAdvisorRejectionEmail(ctx, u.Email.String, u.DisplayName, app.RejectionReason.String)
+	default:
+		err = w.notifier.SendAdvisorApplicationStatusEmail(ctx, u.Email.String, u.DisplayName, app.Status, app.RejectionReason.String)
+	}
+	if err != nil {
+		log.Printf("Error sending application email to %s: %v", app.UserID, err)
+	}
+}
+
+// MapApplication converts an application row to its protobuf form.
+// Reviewer notes are internal and only included when includeReviewerNotes is set.
+func MapApplication(a db.AdvisorApplication, docs []db.ListCredentialDocumentsRow, includeReviewerNotes bool) *common.AdvisorApplication {
+	app := &common.AdvisorApplication{
+		Id:              a.ID.String(),
+		AdvisorId:       a.AdvisorID.String(),
+		UserId:          a.UserID.String(),
+		Status:          common.ApplicationStatus(common.ApplicationStatus_value[a.Status]),
+		RejectionReason: a.RejectionReason.String,
+		CreatedAt:       a.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:       a.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if includeReviewerNotes {
+		app.ReviewerNotes = a.ReviewerNotes.String
+	}
+	if a.SubmittedAt.Valid {
+		app.SubmittedAt = a.SubmittedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	if a.ReviewedAt.Valid {
+		app.ReviewedAt = a.ReviewedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+
+	for _, d := range docs {
+		app.Documents = append(app.Documents, MapCredentialDocument(d))
+	}
+
+	return app
+}
+
+func MapCredentialDocument(d db.ListCredentialDocumentsRow) *common.CredentialDocument {
+	return &common.CredentialDocument{
+		Id:            d.ID.String(),
+		ApplicationId: d.ApplicationID.String(),
+		FileName:      d.FileName,
+		MimeType:      d.MimeType,
+		SizeBytes:     d.SizeBytes,
+		CreatedAt:     d.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+}
@@ -0,0 +1,118 @@
+package advisor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"loveguru/internal/db"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Bounds for an advisor's max_concurrent_chats, matching the column constraint
+const (
+	MinChatCapacity = 1
+	MaxChatCapacity = 5
+)
+
+// ErrAtCapacity is returned when the advisor cannot take another session right now
+var ErrAtCapacity = status.Error(codes.ResourceExhausted, "advisor is at capacity, try again later or join their queue")
+
+// Capacity enforces how many sessions an advisor has at once. Chats are limited by the
+// advisor's max_concurrent_chats and a call is exclusive: it needs no other ongoing
+// session and blocks new chats until it ends. Advisors are reported BUSY while full.
+type Capacity struct {
+	conn *sql.DB
+}
+
+func NewCapacity(conn *sql.DB) *Capacity {
+	return &Capacity{conn: conn}
+}
+
+// Reserve runs create while holding the advisor's row lock, so concurrent requests for
+// the same advisor are checked one at a time. create must use the Queries it is given.
+func (c *Capacity) Reserve(ctx context.Context, advisorUserID uuid.UUID, sessionType string, create func(q *db.Queries) (db.Session, error)) (db.Session, error) {
+	var session db.Session
+
+	err := db.Transaction(ctx, c.conn, func(q *db.Queries) error {
+		a, err := q.GetAdvisorCapacityForUpdate(ctx, advisorUserID)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return errors.New("advisor not found")
+			}
+			return err
+		}
+
+		load, err := q.CountOngoingAdvisorSessions(ctx, uuid.NullUUID{UUID: advisorUserID, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		isCall := sessionType == common.SessionType_CALL.String()
+		if load.Calls > 0 || (isCall && load.Chats > 0) || (!isCall && load.Chats >= a.MaxConcurrentChats) {
+			return ErrAtCapacity
+		}
+
+		session, err = create(q)
+		if err != nil {
+			return err
+		}
+
+		full := isCall || load.Chats+1 >= a.MaxConcurrentChats
+		if full && a.Status.String == "ONLINE" {
+			return q.SetAdvisorAutoBusy(ctx, db.SetAdvisorAutoBusyParams{ID: a.ID, Busy: true})
+		}
+		return nil
+	})
+	if err != nil {
+		return db.Session{}, err
+	}
+
+	return session, nil
+}
+
+// Sync reports the advisor BUSY when they are full and puts them back ONLINE once they
+// have room again, leaving any status the advisor set themselves alone. Call it after
+// one of their sessions ends or their capacity changes; failures are logged.
+func (c *Capacity) Sync(ctx context.Context, advisorUserID uuid.UUID) {
+	err := db.Transaction(ctx, c.conn, func(q *db.Queries) error {
+		a, err := q.GetAdvisorCapacityForUpdate(ctx, advisorUserID)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		load, err := q.CountOngoingAdvisorSessions(ctx, uuid.NullUUID{UUID: advisorUserID, Valid: true})
+		if err != nil {
+			return err
+		}
+
+		full := load.Calls > 0 || load.Chats >= a.MaxConcurrentChats
+		switch {
+		case full && a.Status.String == "ONLINE":
+			return q.SetAdvisorAutoBusy(ctx, db.SetAdvisorAutoBusyParams{ID: a.ID, Busy: true})
+		case !full && a.Status.String == "BUSY" && a.AutoBusy:
+			return q.SetAdvisorAutoBusy(ctx, db.SetAdvisorAutoBusyParams{ID: a.ID, Busy: false})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error syncing capacity for advisor %s: %v", advisorUserID, err)
+	}
+}
+
+// ValidateChatCapacity checks a requested max_concurrent_chats value
+func ValidateChatCapacity(n int32) error {
+	if n < MinChatCapacity || n > MaxChatCapacity {
+		return fmt.Errorf("max concurrent chats must be between %d and %d", MinChatCapacity, MaxChatCapacity)
+	}
+	return nil
+}
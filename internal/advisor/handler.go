@@ -29,3 +29,75 @@ func (h *Handler) ApplyAsAdvisor(ctx context.Context, req *advisor.ApplyAsAdviso
 func (h *Handler) UpdateProfile(ctx context.Context, req *advisor.UpdateProfileRequest) (*advisor.UpdateProfileResponse, error) {
 	return h.service.UpdateProfile(ctx, req)
 }
+
+func (h *Handler) SaveApplicationDraft(ctx context.Context, req *advisor.SaveApplicationDraftRequest) (*advisor.SaveApplicationDraftResponse, error) {
+	return h.service.SaveApplicationDraft(ctx, req)
+}
+
+func (h *Handler) UploadCredentialDocument(ctx context.Context, req *advisor.UploadCredentialDocumentRequest) (*advisor.UploadCredentialDocumentResponse, error) {
+	return h.service.UploadCredentialDocument(ctx, req)
+}
+
+func (h *Handler) SubmitApplication(ctx context.Context, req *advisor.SubmitApplicationRequest) (*advisor.SubmitApplicationResponse, error) {
+	return h.service.SubmitApplication(ctx, req)
+}
+
+func (h *Handler) GetMyApplication(ctx context.Context, req *advisor.GetMyApplicationRequest) (*advisor.GetMyApplicationResponse, error) {
+	return h.service.GetMyApplication(ctx, req)
+}
+
+func (h *Handler) GetEarnings(ctx context.Context, req *advisor.GetEarningsRequest) (*advisor.GetEarningsResponse, error) {
+	return h.service.GetEarnings(ctx, req)
+}
+
+func (h *Handler) GetRecommendations(ctx context.Context, req *advisor.GetRecommendationsRequest) (*advisor.GetRecommendationsResponse, error) {
+	return h.service.GetRecommendations(ctx, req)
+}
+
+func (h *Handler) ListTemplates(ctx context.Context, req *advisor.ListTemplatesRequest) (*advisor.ListTemplatesResponse, error) {
+	return h.service.ListTemplates(ctx, req)
+}
+
+func (h *Handler) CreateTemplate(ctx context.Context, req *advisor.CreateTemplateRequest) (*advisor.CreateTemplateResponse, error) {
+	return h.service.CreateTemplate(ctx, req)
+}
+
+func (h *Handler) UpdateTemplate(ctx context.Context, req *advisor.UpdateTemplateRequest) (*advisor.UpdateTemplateResponse, error) {
+	return h.service.UpdateTemplate(ctx, req)
+}
+
+func (h *Handler) DeleteTemplate(ctx context.Context, req *advisor.DeleteTemplateRequest) (*advisor.DeleteTemplateResponse, error) {
+	return h.service.DeleteTemplate(ctx, req)
+}
+
+func (h *Handler) ListClientNotes(ctx context.Context, req *advisor.ListClientNotesRequest) (*advisor.ListClientNotesResponse, error) {
+	return h.service.ListClientNotes(ctx, req)
+}
+
+func (h *Handler) CreateClientNote(ctx context.Context, req *advisor.CreateClientNoteRequest) (*advisor.CreateClientNoteResponse, error) {
+	return h.service.CreateClientNote(ctx, req)
+}
+
+func (h *Handler) UpdateClientNote(ctx context.Context, req *advisor.UpdateClientNoteRequest) (*advisor.UpdateClientNoteResponse, error) {
+	return h.service.UpdateClientNote(ctx, req)
+}
+
+func (h *Handler) DeleteClientNote(ctx context.Context, req *advisor.DeleteClientNoteRequest) (*advisor.DeleteClientNoteResponse, error) {
+	return h.service.DeleteClientNote(ctx, req)
+}
+
+func (h *Handler) GetClientContext(ctx context.Context, req *advisor.GetClientContextRequest) (*advisor.GetClientContextResponse, error) {
+	return h.service.GetClientContext(ctx, req)
+}
+
+func (h *Handler) GetMyStats(ctx context.Context, req *advisor.GetMyStatsRequest) (*advisor.GetMyStatsResponse, error) {
+	return h.service.GetMyStats(ctx, req)
+}
+
+func (h *Handler) SetChatCapacity(ctx context.Context, req *advisor.SetChatCapacityRequest) (*advisor.SetChatCapacityResponse, error) {
+	return h.service.SetChatCapacity(ctx, req)
+}
+
+func (h *Handler) SetPricing(ctx context.Context, req *advisor.SetPricingRequest) (*advisor.SetPricingResponse, error) {
+	return h.service.SetPricing(ctx, req)
+}
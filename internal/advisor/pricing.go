@@ -0,0 +1,69 @@
+package advisor
+
+import (
+	"fmt"
+
+	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+	"loveguru/proto/common"
+)
+
+// Pricing types as stored in advisor_pricing.session_type and sessions.pricing_type
+const (
+	PricingChat      = "CHAT"
+	PricingCall      = "CALL"
+	PricingAIHandoff = "AI_HANDOFF"
+)
+
+const defaultCurrency = "USD"
+
+var validPricingTypes = map[string]struct{}{
+	PricingChat:      {},
+	PricingCall:      {},
+	PricingAIHandoff: {},
+}
+
+// validatePricing checks a pricing entry and fills in defaults
+func validatePricing(p *common.AdvisorPricing) error {
+	if _, ok := validPricingTypes[p.SessionType]; !ok {
+		return fmt.Errorf("invalid session type %q, expected CHAT, CALL or AI_HANDOFF", p.SessionType)
+	}
+	if p.PerMinuteRate < 0 {
+		return fmt.Errorf("per-minute rate for %s must not be negative", p.SessionType)
+	}
+	if p.MinBillableMinutes < 0 {
+		return fmt.Errorf("minimum billable minutes for %s must not be negative", p.SessionType)
+	}
+	if p.FirstSessionDiscountPercent < 0 || p.FirstSessionDiscountPercent > 100 {
+		return fmt.Errorf("first-session discount for %s must be between 0 and 100", p.SessionType)
+	}
+	if p.Currency == "" {
+		p.Currency = defaultCurrency
+	}
+	return nil
+}
+
+func MapPricing(p db.AdvisorPricing) *common.AdvisorPricing {
+	return &common.AdvisorPricing{
+		SessionType:                 p.SessionType,
+		PerMinuteRate:               earnings.ParseAmount(p.PerMinuteRate),
+		MinBillableMinutes:          p.MinBillableMinutes,
+		FirstSessionDiscountPercent: earnings.ParseAmount(p.FirstSessionDiscountPercent),
+		Currency:                    p.Currency,
+	}
+}
+
+// MapSessionPricing returns the price snapshot stored on a session, or nil for
+// sessions created without advisor pricing
+func MapSessionPricing(s db.Session) *common.AdvisorPricing {
+	if !s.PricePerMinute.Valid {
+		return nil
+	}
+	return &common.AdvisorPricing{
+		SessionType:                 s.PricingType.String,
+		PerMinuteRate:               earnings.ParseAmount(s.PricePerMinute.String),
+		MinBillableMinutes:          s.MinBillableMinutes.Int32,
+		FirstSessionDiscountPercent: earnings.ParseAmount(s.DiscountPercent.String),
+		Currency:                    s.Currency.String,
+	}
+}
@@ -10,16 +10,163 @@ SELECT a.*, u.* FROM advisors a JOIN users u ON a.user_id = u.id WHERE a.id = $1
 SELECT * FROM advisors WHERE user_id = $1;
 
 -- name: UpdateAdvisor :one
-UPDATE advisors SET bio = $2, experience_years = $3, languages = $4, specializations = $5, hourly_rate = $6, status = $7, updated_at = NOW()
+UPDATE advisors SET bio = $2, experience_years = $3, languages = $4, specializations = $5, hourly_rate = $6, status = $7, auto_busy = FALSE, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
 -- name: ListAdvisors :many
-SELECT a.*, u.*, 0 as average_rating
+SELECT a.*, u.*,
+       COALESCE((SELECT AVG(r.rating) FROM ratings r WHERE r.advisor_id = a.user_id), 0)::float8 AS average_rating,
+       p.per_minute_rate
 FROM advisors a
 JOIN users u ON a.user_id = u.id
+LEFT JOIN advisor_pricing p ON p.advisor_id = a.id AND p.session_type = sqlc.arg(pricing_type)::text
 WHERE a.status = 'ONLINE'
-LIMIT $2 OFFSET $1;
+  AND (sqlc.narg(min_price)::numeric IS NULL OR p.per_minute_rate >= sqlc.narg(min_price))
+  AND (sqlc.narg(max_price)::numeric IS NULL OR p.per_minute_rate <= sqlc.narg(max_price))
+  AND (sqlc.narg(currency)::text IS NULL OR p.currency = sqlc.narg(currency))
+ORDER BY
+  CASE WHEN sqlc.arg(sort)::text = 'price' THEN p.per_minute_rate END ASC NULLS LAST,
+  CASE WHEN sqlc.arg(sort)::text = 'price_desc' THEN p.per_minute_rate END DESC NULLS LAST,
+  CASE WHEN sqlc.arg(sort)::text = 'experience' THEN a.experience_years END DESC NULLS LAST,
+  average_rating DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
 
 -- name: UpdateAdvisorStatus :exec
-UPDATE advisors SET status = $2, updated_at = NOW() WHERE id = $1;
\ No newline at end of file
+UPDATE advisors SET status = $2, auto_busy = FALSE, updated_at = NOW() WHERE id = $1;
+-- Advisor Application Workflow
+
+-- name: UpdateAdvisorApplicationProfile :one
+UPDATE advisors SET bio = $2, experience_years = $3, languages = $4, specializations = $5, hourly_rate = $6, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateAdvisorApplication :one
+INSERT INTO advisor_applications (advisor_id, user_id, status, submitted_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetAdvisorApplicationByID :one
+SELECT * FROM advisor_applications WHERE id = $1;
+
+-- name: GetAdvisorApplicationByUserID :one
+SELECT * FROM advisor_applications WHERE user_id = $1;
+
+-- name: GetAdvisorApplicationByAdvisorID :one
+SELECT * FROM advisor_applications WHERE advisor_id = $1;
+
+-- name: ListAdvisorApplicationsByStatus :many
+SELECT * FROM advisor_applications WHERE status = $1 ORDER BY submitted_at ASC NULLS LAST LIMIT $2 OFFSET $3;
+
+-- name: TransitionAdvisorApplication :one
+UPDATE advisor_applications
+SET status = sqlc.arg(to_status),
+    reviewer_id = COALESCE(sqlc.narg(reviewer_id), reviewer_id),
+    reviewer_notes = COALESCE(sqlc.narg(reviewer_notes), reviewer_notes),
+    rejection_reason = CASE WHEN sqlc.arg(to_status) IN ('NEEDS_CHANGES', 'REJECTED') THEN sqlc.narg(rejection_reason) ELSE rejection_reason END,
+    submitted_at = CASE WHEN sqlc.arg(to_status) = 'SUBMITTED' THEN NOW() ELSE submitted_at END,
+    reviewed_at = CASE WHEN sqlc.arg(to_status) IN ('NEEDS_CHANGES', 'APPROVED', 'REJECTED') THEN NOW() ELSE reviewed_at END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: InsertAdvisorApplicationEvent :exec
+INSERT INTO advisor_application_events (application_id, from_status, to_status, actor_id, note)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: InsertCredentialDocument :one
+INSERT INTO advisor_credential_documents (application_id, file_name, mime_type, size_bytes, encrypted_content)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, application_id, file_name, mime_type, size_bytes, created_at;
+
+-- name: ListCredentialDocuments :many
+SELECT id, application_id, file_name, mime_type, size_bytes, created_at
+FROM advisor_credential_documents
+WHERE application_id = $1
+ORDER BY created_at;
+
+-- name: GetCredentialDocument :one
+SELECT * FROM advisor_credential_documents WHERE id = $1;
+
+-- name: UpsertAdvisorPricing :one
+INSERT INTO advisor_pricing (advisor_id, session_type, per_minute_rate, min_billable_minutes, first_session_discount_percent, currency)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (advisor_id, session_type) DO UPDATE SET
+    per_minute_rate = EXCLUDED.per_minute_rate,
+    min_billable_minutes = EXCLUDED.min_billable_minutes,
+    first_session_discount_percent = EXCLUDED.first_session_discount_percent,
+    currency = EXCLUDED.currency,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListAdvisorPricing :many
+SELECT * FROM advisor_pricing WHERE advisor_id = $1 ORDER BY session_type;
+
+-- name: ListPricingForAdvisors :many
+SELECT * FROM advisor_pricing WHERE advisor_id = ANY(sqlc.arg(advisor_ids)::uuid[]) ORDER BY advisor_id, session_type;
+
+-- name: CreateMessageTemplate :one
+INSERT INTO message_templates (advisor_id, title, body, category, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateAdvisorTemplate :one
+UPDATE message_templates
+SET title = $3, body = $4, category = $5, updated_at = NOW()
+WHERE id = $1 AND advisor_id = $2
+RETURNING *;
+
+-- name: DeleteAdvisorTemplate :execrows
+DELETE FROM message_templates WHERE id = $1 AND advisor_id = $2;
+
+-- name: ListTemplatesForAdvisor :many
+-- The advisor's own saved replies followed by active platform templates
+SELECT * FROM message_templates
+WHERE advisor_id = $1 OR (advisor_id IS NULL AND is_active)
+ORDER BY advisor_id NULLS LAST, category NULLS LAST, title;
+
+-- name: GetTemplateForAdvisor :one
+SELECT * FROM message_templates
+WHERE id = $1 AND is_active AND (advisor_id = $2 OR advisor_id IS NULL);
+
+-- name: CreateClientNote :one
+INSERT INTO advisor_client_notes (advisor_id, user_id, session_id, encrypted_content)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateClientNote :one
+UPDATE advisor_client_notes
+SET encrypted_content = $3, updated_at = NOW()
+WHERE id = $1 AND advisor_id = $2
+RETURNING *;
+
+-- name: DeleteClientNote :execrows
+DELETE FROM advisor_client_notes WHERE id = $1 AND advisor_id = $2;
+
+-- name: ListClientNotes :many
+SELECT * FROM advisor_client_notes
+WHERE advisor_id = $1 AND user_id = $2
+ORDER BY created_at DESC
+LIMIT $3;
+
+-- name: CountSessionsBetween :one
+SELECT COUNT(*)::INTEGER FROM sessions WHERE advisor_id = sqlc.arg(advisor_user_id) AND user_id = sqlc.arg(user_id);
+
+-- name: GetAdvisorCapacityForUpdate :one
+-- Serializes session creation per advisor
+SELECT id, status, auto_busy, max_concurrent_chats FROM advisors WHERE user_id = $1 FOR UPDATE;
+
+-- name: CountOngoingAdvisorSessions :one
+SELECT COUNT(*) FILTER (WHERE type = 'CALL')::int AS calls,
+       COUNT(*) FILTER (WHERE type <> 'CALL')::int AS chats
+FROM sessions WHERE advisor_id = $1 AND status IN ('REQUESTED', 'ONGOING');
+
+-- name: SetAdvisorAutoBusy :exec
+UPDATE advisors
+SET status = CASE WHEN sqlc.arg(busy)::boolean THEN 'BUSY' ELSE 'ONLINE' END,
+    auto_busy = sqlc.arg(busy)::boolean,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: SetAdvisorChatCapacity :one
+UPDATE advisors SET max_concurrent_chats = $2, updated_at = NOW() WHERE user_id = $1 RETURNING *;
@@ -5,44 +5,146 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+	"loveguru/internal/encryption"
 	"loveguru/internal/grpc/middleware"
+	"loveguru/internal/performance"
+	"loveguru/internal/recommendation"
 	"loveguru/proto/advisor"
 	"loveguru/proto/common"
 
 	"github.com/google/uuid"
 )
 
+// maxCredentialDocumentSize caps a single uploaded credential document
+const maxCredentialDocumentSize = 10 << 20
+
+// maxClientNoteLength caps a single private client note
+const maxClientNoteLength = 10000
+
+var allowedCredentialMimeTypes = map[string]struct{}{
+	"application/pdf": {},
+	"image/jpeg":      {},
+	"image/png":       {},
+}
+
 type Service struct {
-	repo *db.Queries
+	repo        *db.Queries
+	workflow    *ApplicationWorkflow
+	cipher      *encryption.Cipher
+	recommender *recommendation.Engine
+	tracker     *performance.Tracker
+	capacity    *Capacity
 }
 
-func NewService(repo *db.Queries) *Service {
-	return &Service{repo: repo}
+// NewService creates the advisor service. cipher may be nil when no encryption key
+// is configured, in which case credential uploads are refused.
+func NewService(repo *db.Queries, workflow *ApplicationWorkflow, cipher *encryption.Cipher, recommender *recommendation.Engine, tracker *performance.Tracker, capacity *Capacity) *Service {
+	return &Service{repo: repo, workflow: workflow, cipher: cipher, recommender: recommender, tracker: tracker, capacity: capacity}
 }
 
 func (s *Service) ListAdvisors(ctx context.Context, req *advisor.ListAdvisorsRequest) (*advisor.ListAdvisorsResponse, error) {
+	pricingType := req.PricingType
+	if pricingType == "" {
+		pricingType = PricingChat
+	}
+	if _, ok := validPricingTypes[pricingType]; !ok {
+		return nil, fmt.Errorf("invalid pricing type %q, expected CHAT, CALL or AI_HANDOFF", pricingType)
+	}
+
 	advisors, err := s.repo.ListAdvisors(ctx, db.ListAdvisorsParams{
-		Limit:  int32(req.Limit),
-		Offset: int32(req.Offset),
+		PricingType: pricingType,
+		MinPrice:    sql.NullString{String: fmt.Sprintf("%.2f", req.PriceMin), Valid: req.PriceMin > 0},
+		MaxPrice:    sql.NullString{String: fmt.Sprintf("%.2f", req.PriceMax), Valid: req.PriceMax > 0},
+		Currency:    sql.NullString{String: req.Currency, Valid: req.Currency != ""},
+		Sort:        req.Sort,
+		RowLimit:    req.Limit,
+		RowOffset:   req.Offset,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	ids := make([]uuid.UUID, 0, len(advisors))
+	for _, a := range advisors {
+		ids = append(ids, a.ID)
+	}
+	prices, err := s.repo.ListPricingForAdvisors(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	pricing := make(map[uuid.UUID][]*common.AdvisorPricing)
+	for _, p := range prices {
+		pricing[p.AdvisorID] = append(pricing[p.AdvisorID], MapPricing(p))
+	}
+
 	var resp []*advisor.AdvisorWithRating
 	for _, a := range advisors {
 		resp = append(resp, &advisor.AdvisorWithRating{
 			Advisor:       s.mapAdvisorFromRow(a),
 			User:          s.mapUserFromRow(a),
-			AverageRating: float64(a.AverageRating),
+			AverageRating: a.AverageRating,
+			Pricing:       pricing[a.ID],
 		})
 	}
 
 	return &advisor.ListAdvisorsResponse{Advisors: resp}, nil
 }
 
+func (s *Service) GetRecommendations(ctx context.Context, req *advisor.GetRecommendationsRequest) (*advisor.GetRecommendationsResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("unauthenticated")
+	}
+
+	uid, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	recs, err := s.recommender.Recommend(ctx, uid, req.Languages, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(recs))
+	for _, r := range recs {
+		ids = append(ids, r.Advisor.ID)
+	}
+	prices, err := s.repo.ListPricingForAdvisors(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	pricing := make(map[uuid.UUID][]*common.AdvisorPricing)
+	for _, p := range prices {
+		pricing[p.AdvisorID] = append(pricing[p.AdvisorID], MapPricing(p))
+	}
+
+	resp := &advisor.GetRecommendationsResponse{}
+	for _, r := range recs {
+		resp.Recommendations = append(resp.Recommendations, &advisor.Recommendation{
+			Advisor: &advisor.AdvisorWithRating{
+				Advisor:       s.mapAdvisorFromRecommendedRow(r.Advisor),
+				User:          s.mapUserFromRecommendedRow(r.Advisor),
+				AverageRating: r.Advisor.AverageRating,
+				Pricing:       pricing[r.Advisor.ID],
+			},
+			Score:   r.Score,
+			Reasons: r.Reasons,
+		})
+	}
+
+	return resp, nil
+}
+
 func (s *Service) GetAdvisor(ctx context.Context, req *advisor.GetAdvisorRequest) (*advisor.GetAdvisorResponse, error) {
 	uid, err := uuid.Parse(req.Id)
 	if err != nil {
@@ -54,12 +156,20 @@ func (s *Service) GetAdvisor(ctx context.Context, req *advisor.GetAdvisorRequest
 		return nil, err
 	}
 
-	return &advisor.GetAdvisorResponse{
-		Advisor: &advisor.AdvisorWithRating{
-			Advisor: s.mapAdvisorFromGetRow(a),
-			User:    s.mapUserFromGetRow(a),
-		},
-	}, nil
+	prices, err := s.repo.ListAdvisorPricing(ctx, a.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &advisor.AdvisorWithRating{
+		Advisor: s.mapAdvisorFromGetRow(a),
+		User:    s.mapUserFromGetRow(a),
+	}
+	for _, p := range prices {
+		resp.Pricing = append(resp.Pricing, MapPricing(p))
+	}
+
+	return &advisor.GetAdvisorResponse{Advisor: resp}, nil
 }
 
 func (s *Service) ApplyAsAdvisor(ctx context.Context, req *advisor.ApplyAsAdvisorRequest) (*advisor.ApplyAsAdvisorResponse, error) {
@@ -85,9 +195,788 @@ func (s *Service) ApplyAsAdvisor(ctx context.Context, req *advisor.ApplyAsAdviso
 		return nil, err
 	}
 
+	// One-shot applications skip the draft step and go straight to review
+	app, err := s.repo.CreateAdvisorApplication(ctx, db.CreateAdvisorApplicationParams{
+		AdvisorID:   a.ID,
+		UserID:      uid,
+		Status:      ApplicationSubmitted,
+		SubmittedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.workflow.notifyApplicant(ctx, app)
+
 	return &advisor.ApplyAsAdvisorResponse{Advisor: s.mapAdvisor(a)}, nil
 }
 
+func (s *Service) SaveApplicationDraft(ctx context.Context, req *advisor.SaveApplicationDraftRequest) (*advisor.SaveApplicationDraftResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("unauthenticated")
+	}
+
+	uid, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bio := sql.NullString{String: req.Bio, Valid: req.Bio != ""}
+	experience := sql.NullInt32{Int32: int32(req.ExperienceYears), Valid: req.ExperienceYears > 0}
+	rate := sql.NullString{String: fmt.Sprintf("%.2f", req.HourlyRate), Valid: req.HourlyRate > 0}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, uid)
+	if db.IsNotFound(err) {
+		a, err = s.repo.CreateAdvisor(ctx, db.CreateAdvisorParams{
+			UserID:          uid,
+			Bio:             bio,
+			ExperienceYears: experience,
+			Languages:       req.Languages,
+			Specializations: req.Specializations,
+			HourlyRate:      rate,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		app, err := s.repo.CreateAdvisorApplication(ctx, db.CreateAdvisorApplicationParams{
+			AdvisorID: a.ID,
+			UserID:    uid,
+			Status:    ApplicationDraft,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &advisor.SaveApplicationDraftResponse{Application: MapApplication(app, nil, false)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.repo.GetAdvisorApplicationByAdvisorID(ctx, a.ID)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("advisor profile has no application")
+		}
+		return nil, err
+	}
+
+	if !IsEditable(app.Status) {
+		return nil, fmt.Errorf("application cannot be edited while %s", app.Status)
+	}
+
+	_, err = s.repo.UpdateAdvisorApplicationProfile(ctx, db.UpdateAdvisorApplicationProfileParams{
+		ID:              a.ID,
+		Bio:             bio,
+		ExperienceYears: experience,
+		Languages:       req.Languages,
+		Specializations: req.Specializations,
+		HourlyRate:      rate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := s.repo.ListCredentialDocuments(ctx, app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.SaveApplicationDraftResponse{Application: MapApplication(app, docs, false)}, nil
+}
+
+func (s *Service) UploadCredentialDocument(ctx context.Context, req *advisor.UploadCredentialDocumentRequest) (*advisor.UploadCredentialDocumentResponse, error) {
+	app, err := s.getMyApplication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsEditable(app.Status) {
+		return nil, fmt.Errorf("documents cannot be added while application is %s", app.Status)
+	}
+
+	if req.FileName == "" || len(req.Content) == 0 {
+		return nil, errors.New("file name and content are required")
+	}
+	if len(req.Content) > maxCredentialDocumentSize {
+		return nil, fmt.Errorf("document exceeds maximum size of %d bytes", maxCredentialDocumentSize)
+	}
+	if _, ok := allowedCredentialMimeTypes[req.MimeType]; !ok {
+		return nil, fmt.Errorf("unsupported document type: %s", req.MimeType)
+	}
+
+	if s.cipher == nil {
+		return nil, encryption.ErrKeyNotConfigured
+	}
+
+	sealed, err := s.cipher.Seal(req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.repo.InsertCredentialDocument(ctx, db.InsertCredentialDocumentParams{
+		ApplicationID:    app.ID,
+		FileName:         req.FileName,
+		MimeType:         req.MimeType,
+		SizeBytes:        int32(len(req.Content)),
+		EncryptedContent: sealed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.UploadCredentialDocumentResponse{
+		Document: MapCredentialDocument(db.ListCredentialDocumentsRow(d)),
+	}, nil
+}
+
+func (s *Service) SubmitApplication(ctx context.Context, req *advisor.SubmitApplicationRequest) (*advisor.SubmitApplicationResponse, error) {
+	app, err := s.getMyApplication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, app.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !a.Bio.Valid || len(a.Specializations) == 0 {
+		return nil, errors.New("bio and at least one specialization are required before submitting")
+	}
+
+	updated, err := s.workflow.Transition(ctx, app, TransitionInput{
+		To:      ApplicationSubmitted,
+		ActorID: app.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := s.repo.ListCredentialDocuments(ctx, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.SubmitApplicationResponse{Application: MapApplication(updated, docs, false)}, nil
+}
+
+func (s *Service) GetMyApplication(ctx context.Context, req *advisor.GetMyApplicationRequest) (*advisor.GetMyApplicationResponse, error) {
+	app, err := s.getMyApplication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := s.repo.ListCredentialDocuments(ctx, app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.GetMyApplicationResponse{Application: MapApplication(app, docs, false)}, nil
+}
+
+func (s *Service) GetEarnings(ctx context.Context, req *advisor.GetEarningsRequest) (*advisor.GetEarningsResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("unauthenticated")
+	}
+
+	uid, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, uid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("not an advisor")
+		}
+		return nil, err
+	}
+
+	period, err := earnings.ParsePeriod(req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := earnings.ParseRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	totals, err := s.repo.GetEarningsByPeriod(ctx, db.GetEarningsByPeriodParams{
+		Period:    period,
+		AdvisorID: a.ID,
+		FromTime:  sql.NullTime{Time: from, Valid: true},
+		ToTime:    sql.NullTime{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.ListEarnings(ctx, db.ListEarningsParams{
+		AdvisorID: a.ID,
+		FromTime:  sql.NullTime{Time: from, Valid: true},
+		ToTime:    sql.NullTime{Time: to, Valid: true},
+		RowLimit:  limit,
+		RowOffset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unpaid, err := s.repo.GetUnpaidEarningsTotal(ctx, a.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payouts, err := s.repo.ListPayoutBatches(ctx, db.ListPayoutBatchesParams{
+		AdvisorID: uuid.NullUUID{UUID: a.ID, Valid: true},
+		RowLimit:  10,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &advisor.GetEarningsResponse{UnpaidAmount: earnings.ParseAmount(unpaid)}
+	for _, t := range totals {
+		resp.Periods = append(resp.Periods, &advisor.EarningsPeriod{
+			PeriodStart:      t.PeriodStart.Format("2006-01-02T15:04:05Z"),
+			SessionCount:     int32(t.SessionCount),
+			TotalSeconds:     t.TotalSeconds,
+			GrossAmount:      earnings.ParseAmount(t.GrossAmount),
+			CommissionAmount: earnings.ParseAmount(t.CommissionAmount),
+			NetAmount:        earnings.ParseAmount(t.NetAmount),
+		})
+	}
+	for _, e := range items {
+		resp.Items = append(resp.Items, earnings.MapLineItem(e))
+	}
+	for _, b := range payouts {
+		resp.Payouts = append(resp.Payouts, earnings.MapPayoutBatch(b))
+	}
+
+	return resp, nil
+}
+
+// SetPricing replaces the caller's prices for the given session types.
+// Existing sessions keep the price they were created with.
+func (s *Service) SetPricing(ctx context.Context, req *advisor.SetPricingRequest) (*advisor.SetPricingResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("unauthenticated")
+	}
+
+	uid, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, uid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("not an advisor")
+		}
+		return nil, err
+	}
+
+	if len(req.Pricing) == 0 {
+		return nil, errors.New("at least one price is required")
+	}
+	for _, p := range req.Pricing {
+		if err := validatePricing(p); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &advisor.SetPricingResponse{}
+	for _, p := range req.Pricing {
+		saved, err := s.repo.UpsertAdvisorPricing(ctx, db.UpsertAdvisorPricingParams{
+			AdvisorID:                   a.ID,
+			SessionType:                 p.SessionType,
+			PerMinuteRate:               fmt.Sprintf("%.2f", p.PerMinuteRate),
+			MinBillableMinutes:          p.MinBillableMinutes,
+			FirstSessionDiscountPercent: fmt.Sprintf("%.2f", p.FirstSessionDiscountPercent),
+			Currency:                    p.Currency,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Pricing = append(resp.Pricing, MapPricing(saved))
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ListTemplates(ctx context.Context, req *advisor.ListTemplatesRequest) (*advisor.ListTemplatesResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.repo.ListTemplatesForAdvisor(ctx, uuid.NullUUID{UUID: a.ID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &advisor.ListTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, MapTemplate(t))
+	}
+
+	return resp, nil
+}
+
+func (s *Service) CreateTemplate(ctx context.Context, req *advisor.CreateTemplateRequest) (*advisor.CreateTemplateResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateTemplate(req.Title, req.Body); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.CreateMessageTemplate(ctx, db.CreateMessageTemplateParams{
+		AdvisorID: uuid.NullUUID{UUID: a.ID, Valid: true},
+		Title:     req.Title,
+		Body:      req.Body,
+		Category:  sql.NullString{String: req.Category, Valid: req.Category != ""},
+		CreatedBy: uuid.NullUUID{UUID: a.UserID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.CreateTemplateResponse{Template: MapTemplate(t)}, nil
+}
+
+func (s *Service) UpdateTemplate(ctx context.Context, req *advisor.UpdateTemplateRequest) (*advisor.UpdateTemplateResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateTemplate(req.Title, req.Body); err != nil {
+		return nil, err
+	}
+
+	t, err := s.repo.UpdateAdvisorTemplate(ctx, db.UpdateAdvisorTemplateParams{
+		ID:        tid,
+		AdvisorID: uuid.NullUUID{UUID: a.ID, Valid: true},
+		Title:     req.Title,
+		Body:      req.Body,
+		Category:  sql.NullString{String: req.Category, Valid: req.Category != ""},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("template not found")
+		}
+		return nil, err
+	}
+
+	return &advisor.UpdateTemplateResponse{Template: MapTemplate(t)}, nil
+}
+
+func (s *Service) DeleteTemplate(ctx context.Context, req *advisor.DeleteTemplateRequest) (*advisor.DeleteTemplateResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.repo.DeleteAdvisorTemplate(ctx, db.DeleteAdvisorTemplateParams{
+		ID:        tid,
+		AdvisorID: uuid.NullUUID{UUID: a.ID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("template not found")
+	}
+
+	return &advisor.DeleteTemplateResponse{Success: true}, nil
+}
+
+func (s *Service) ListClientNotes(ctx context.Context, req *advisor.ListClientNotesRequest) (*advisor.ListClientNotesResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	notes, err := s.listClientNotes(ctx, a.ID, uid, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.ListClientNotesResponse{Notes: notes}, nil
+}
+
+func (s *Service) CreateClientNote(ctx context.Context, req *advisor.CreateClientNoteRequest) (*advisor.CreateClientNoteResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateClientNote(req.Content); err != nil {
+		return nil, err
+	}
+
+	// Advisors may only keep notes on users they have had sessions with
+	var sessionID uuid.NullUUID
+	if req.SessionId != "" {
+		sid, err := uuid.Parse(req.SessionId)
+		if err != nil {
+			return nil, err
+		}
+		session, err := s.repo.GetSessionByID(ctx, sid)
+		if err != nil {
+			return nil, err
+		}
+		if session.UserID != uid || session.AdvisorID.UUID != a.UserID {
+			return nil, errors.New("session does not belong to this client")
+		}
+		sessionID = uuid.NullUUID{UUID: sid, Valid: true}
+	} else {
+		count, err := s.repo.CountSessionsBetween(ctx, db.CountSessionsBetweenParams{
+			AdvisorUserID: uuid.NullUUID{UUID: a.UserID, Valid: true},
+			UserID:        uid,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, errors.New("no sessions with this client")
+		}
+	}
+
+	if s.cipher == nil {
+		return nil, encryption.ErrKeyNotConfigured
+	}
+
+	sealed, err := s.cipher.Seal([]byte(req.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.repo.CreateClientNote(ctx, db.CreateClientNoteParams{
+		AdvisorID:        a.ID,
+		UserID:           uid,
+		SessionID:        sessionID,
+		EncryptedContent: sealed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.CreateClientNoteResponse{Note: mapClientNote(n, req.Content)}, nil
+}
+
+func (s *Service) UpdateClientNote(ctx context.Context, req *advisor.UpdateClientNoteRequest) (*advisor.UpdateClientNoteResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateClientNote(req.Content); err != nil {
+		return nil, err
+	}
+
+	if s.cipher == nil {
+		return nil, encryption.ErrKeyNotConfigured
+	}
+
+	sealed, err := s.cipher.Seal([]byte(req.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.repo.UpdateClientNote(ctx, db.UpdateClientNoteParams{
+		ID:               nid,
+		AdvisorID:        a.ID,
+		EncryptedContent: sealed,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("note not found")
+		}
+		return nil, err
+	}
+
+	return &advisor.UpdateClientNoteResponse{Note: mapClientNote(n, req.Content)}, nil
+}
+
+func (s *Service) DeleteClientNote(ctx context.Context, req *advisor.DeleteClientNoteRequest) (*advisor.DeleteClientNoteResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.DeleteClientNote(ctx, db.DeleteClientNoteParams{ID: nid, AdvisorID: a.ID})
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errors.New("note not found")
+	}
+
+	return &advisor.DeleteClientNoteResponse{Success: true}, nil
+}
+
+// GetClientContext returns what the advisor needs when a client opens a session:
+// the client, how often they have met and the advisor's private notes
+func (s *Service) GetClientContext(ctx context.Context, req *advisor.GetClientContextRequest) (*advisor.GetClientContextResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sid, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	if !session.AdvisorID.Valid || session.AdvisorID.UUID != a.UserID {
+		return nil, errors.New("unauthorized")
+	}
+
+	u, err := s.repo.GetUserByID(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountSessionsBetween(ctx, db.CountSessionsBetweenParams{
+		AdvisorUserID: session.AdvisorID,
+		UserID:        session.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &advisor.GetClientContextResponse{
+		Session: &common.Session{
+			Id:        session.ID.String(),
+			UserId:    session.UserID.String(),
+			AdvisorId: session.AdvisorID.UUID.String(),
+			Type:      common.SessionType(common.SessionType_value[session.Type]),
+			StartedAt: session.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
+			EndedAt:   session.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
+			Status:    common.SessionStatus(common.SessionStatus_value[session.Status.String]),
+			Pricing:   MapSessionPricing(session),
+		},
+		User: &common.User{
+			Id:          u.ID.String(),
+			DisplayName: u.DisplayName,
+			Gender:      common.Gender(common.Gender_value[u.Gender.String]),
+			Dob:         u.Dob.Time.Format("2006-01-02"),
+			CreatedAt:   u.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+			IsActive:    u.IsActive.Bool,
+		},
+		PreviousSessions: count - 1,
+	}
+
+	// Notes are unavailable without an encryption key but the rest of the context still is
+	if s.cipher != nil {
+		notes, err := s.listClientNotes(ctx, a.ID, session.UserID, 50)
+		if err != nil {
+			return nil, err
+		}
+		resp.Notes = notes
+	}
+
+	return resp, nil
+}
+
+func (s *Service) listClientNotes(ctx context.Context, advisorID, userID uuid.UUID, limit int32) ([]*advisor.ClientNote, error) {
+	if s.cipher == nil {
+		return nil, encryption.ErrKeyNotConfigured
+	}
+
+	rows, err := s.repo.ListClientNotes(ctx, db.ListClientNotesParams{
+		AdvisorID: advisorID,
+		UserID:    userID,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var notes []*advisor.ClientNote
+	for _, n := range rows {
+		content, err := s.cipher.Open(n.EncryptedContent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt note %s: %w", n.ID, err)
+		}
+		notes = append(notes, mapClientNote(n, string(content)))
+	}
+
+	return notes, nil
+}
+
+func validateClientNote(content string) error {
+	if content == "" {
+		return errors.New("note content is required")
+	}
+	if len(content) > maxClientNoteLength {
+		return fmt.Errorf("note must be at most %d characters", maxClientNoteLength)
+	}
+	return nil
+}
+
+func mapClientNote(n db.AdvisorClientNote, content string) *advisor.ClientNote {
+	note := &advisor.ClientNote{
+		Id:        n.ID.String(),
+		UserId:    n.UserID.String(),
+		Content:   content,
+		CreatedAt: n.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: n.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if n.SessionID.Valid {
+		note.SessionId = n.SessionID.UUID.String()
+	}
+	return note
+}
+
+func (s *Service) GetMyStats(ctx context.Context, req *advisor.GetMyStatsRequest) (*advisor.GetMyStatsResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := earnings.ParseRange(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.tracker.Report(ctx, a.UserID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.GetMyStatsResponse{Report: report}, nil
+}
+
+func (s *Service) SetChatCapacity(ctx context.Context, req *advisor.SetChatCapacityRequest) (*advisor.SetChatCapacityResponse, error) {
+	a, err := s.getMyAdvisor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateChatCapacity(req.MaxConcurrentChats); err != nil {
+		return nil, err
+	}
+
+	a, err = s.repo.SetAdvisorChatCapacity(ctx, db.SetAdvisorChatCapacityParams{
+		UserID:             a.UserID,
+		MaxConcurrentChats: req.MaxConcurrentChats,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The new limit may put the advisor over capacity or give them room again
+	s.capacity.Sync(ctx, a.UserID)
+
+	a, err = s.repo.GetAdvisorByUserID(ctx, a.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &advisor.SetChatCapacityResponse{
+		MaxConcurrentChats: a.MaxConcurrentChats,
+		Status:             common.AdvisorStatus(common.AdvisorStatus_value[a.Status.String]),
+	}, nil
+}
+
+// getMyAdvisor loads the caller's advisor profile
+func (s *Service) getMyAdvisor(ctx context.Context) (db.Advisor, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return db.Advisor{}, errors.New("unauthenticated")
+	}
+
+	uid, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return db.Advisor{}, err
+	}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, uid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.Advisor{}, errors.New("not an advisor")
+		}
+		return db.Advisor{}, err
+	}
+
+	return a, nil
+}
+
+func (s *Service) getMyApplication(ctx context.Context) (db.AdvisorApplication, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return db.AdvisorApplication{}, errors.New("unauthenticated")
+	}
+
+	uid, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return db.AdvisorApplication{}, err
+	}
+
+	app, err := s.repo.GetAdvisorApplicationByUserID(ctx, uid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.AdvisorApplication{}, errors.New("no advisor application found")
+		}
+		return db.AdvisorApplication{}, err
+	}
+
+	return app, nil
+}
+
 func (s *Service) UpdateProfile(ctx context.Context, req *advisor.UpdateProfileRequest) (*advisor.UpdateProfileResponse, error) {
 	userInfo, ok := middleware.GetUserFromContext(ctx)
 	if !ok {
@@ -177,6 +1066,37 @@ func (s *Service) mapUserFromGetRow(a db.GetAdvisorByIDRow) *common.User {
 	}
 }
 
+func (s *Service) mapAdvisorFromRecommendedRow(a db.GetRecommendedAdvisorsRow) *common.Advisor {
+	return &common.Advisor{
+		Id:              a.ID.String(),
+		UserId:          a.UserID.String(),
+		Bio:             a.Bio.String,
+		ExperienceYears: int32(a.ExperienceYears.Int32),
+		Languages:       a.Languages,
+		Specializations: a.Specializations,
+		IsVerified:      a.IsVerified.Bool,
+		HourlyRate:      parseFloat(a.HourlyRate.String),
+		Status:          common.AdvisorStatus(common.AdvisorStatus_value[a.Status.String]),
+		CreatedAt:       a.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:       a.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func (s *Service) mapUserFromRecommendedRow(a db.GetRecommendedAdvisorsRow) *common.User {
+	return &common.User{
+		Id:          a.UserID.String(),
+		Email:       a.Email.String,
+		Phone:       a.Phone.String,
+		DisplayName: a.DisplayName,
+		Role:        common.Role(common.Role_value[a.Role]),
+		Gender:      common.Gender(common.Gender_value[a.Gender.String]),
+		Dob:         a.Dob.Time.Format("2006-01-02"),
+		CreatedAt:   a.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   a.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		IsActive:    a.IsActive.Bool,
+	}
+}
+
 func (s *Service) mapAdvisor(a db.Advisor) *common.Advisor {
 	return &common.Advisor{
 		Id:              a.ID.String(),
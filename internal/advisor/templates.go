@@ -0,0 +1,80 @@
+package advisor
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"loveguru/internal/db"
+	"loveguru/proto/common"
+)
+
+// Variables that may appear in a template as {{name}}
+const (
+	TemplateVarUserName    = "user_name"
+	TemplateVarAdvisorName = "advisor_name"
+)
+
+const (
+	maxTemplateTitleLength = 100
+	maxTemplateBodyLength  = 4000
+)
+
+var templateVarPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z_]+)\s*\}\}`)
+
+var knownTemplateVars = map[string]struct{}{
+	TemplateVarUserName:    {},
+	TemplateVarAdvisorName: {},
+}
+
+// ValidateTemplate checks a template's title and body and rejects unknown variables
+func ValidateTemplate(title, body string) error {
+	if strings.TrimSpace(title) == "" {
+		return errors.New("template title is required")
+	}
+	if strings.TrimSpace(body) == "" {
+		return errors.New("template body is required")
+	}
+	if len(title) > maxTemplateTitleLength {
+		return fmt.Errorf("template title must be at most %d characters", maxTemplateTitleLength)
+	}
+	if len(body) > maxTemplateBodyLength {
+		return fmt.Errorf("template body must be at most %d characters", maxTemplateBodyLength)
+	}
+
+	for _, m := range templateVarPattern.FindAllStringSubmatch(body, -1) {
+		if _, ok := knownTemplateVars[m[1]]; !ok {
+			return fmt.Errorf("unknown template variable {{%s}}", m[1])
+		}
+	}
+	return nil
+}
+
+// RenderTemplate replaces {{name}} variables in body with their values
+func RenderTemplate(body string, vars map[string]string) string {
+	return templateVarPattern.ReplaceAllStringFunc(body, func(m string) string {
+		name := templateVarPattern.FindStringSubmatch(m)[1]
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+func MapTemplate(t db.MessageTemplate) *common.MessageTemplate {
+	tmpl := &common.MessageTemplate{
+		Id:         t.ID.String(),
+		Title:      t.Title,
+		Body:       t.Body,
+		Category:   t.Category.String,
+		IsPlatform: !t.AdvisorID.Valid,
+		IsActive:   t.IsActive,
+		CreatedAt:  t.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:  t.UpdatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if t.AdvisorID.Valid {
+		tmpl.AdvisorId = t.AdvisorID.UUID.String()
+	}
+	return tmpl
+}
@@ -1,6 +1,6 @@
 -- name: InsertAIInteraction :one
-INSERT INTO ai_interactions (user_id, prompt, response)
-VALUES ($1, $2, $3)
+INSERT INTO ai_interactions (user_id, prompt, response, content_key_id)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 -- FAQ Management
 
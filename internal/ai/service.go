@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"loveguru/internal/db"
+	"loveguru/internal/encryption"
 	"loveguru/internal/grpc/middleware"
 	"loveguru/proto/ai"
 
@@ -12,18 +13,20 @@ import (
 )
 
 type Service struct {
-	repo   *db.Queries
-	openai *OpenAIClient
+	repo    *db.Queries
+	openai  *OpenAIClient
+	keyring *encryption.Keyring // encrypts stored conversations, nil to store them as sent
 }
 
 func NewService(repo *db.Queries, apiKey, baseURL string) *Service {
-	return NewServiceWithConfig(repo, apiKey, baseURL, "gpt-3.5-turbo", 500)
+	return NewServiceWithConfig(repo, apiKey, baseURL, "gpt-3.5-turbo", 500, nil)
 }
 
-func NewServiceWithConfig(repo *db.Queries, apiKey, baseURL, model string, maxTokens int) *Service {
+func NewServiceWithConfig(repo *db.Queries, apiKey, baseURL, model string, maxTokens int, keyring *encryption.Keyring) *Service {
 	return &Service{
-		repo:   repo,
-		openai: NewOpenAIClientWithConfig(apiKey, baseURL, model, maxTokens),
+		repo:    repo,
+		openai:  NewOpenAIClientWithConfig(apiKey, baseURL, model, maxTokens),
+		keyring: keyring,
 	}
 }
 
@@ -52,10 +55,15 @@ func (s *Service) Chat(ctx context.Context, req *ai.ChatRequest) (*ai.ChatRespon
 	}
 
 	// Store interaction
+	sealed, keyID, err := s.keyring.SealAll(req.Message, response)
+	if err != nil {
+		return nil, err
+	}
 	_, err = s.repo.InsertAIInteraction(ctx, db.InsertAIInteractionParams{
-		UserID:   uid,
-		Prompt:   req.Message,
-		Response: response,
+		UserID:       uid,
+		Prompt:       sealed[0],
+		Response:     sealed[1],
+		ContentKeyID: keyID,
 	})
 	if err != nil {
 		return nil, err
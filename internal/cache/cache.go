@@ -117,3 +117,38 @@ func (c *Cache) LRange(ctx context.Context, key string, start, stop int64) ([]st
 func (c *Cache) LTrim(ctx context.Context, key string, start, stop int64) error {
 	return c.client.LTrim(ctx, key, start, stop).Err()
 }
+
+// Publish posts message to a pub/sub channel
+func (c *Cache) Publish(ctx context.Context, channel string, message interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return c.client.Publish(ctx, channel, data).Err()
+}
+
+// Subscribe listens on pub/sub channels. The subscription reconnects on its own;
+// close it when done.
+func (c *Cache) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return c.client.Subscribe(ctx, channels...)
+}
+
+// ZAdd adds member to the sorted set stored at key, or updates its score
+func (c *Cache) ZAdd(ctx context.Context, key, member string, score float64) error {
+	return c.client.ZAdd(ctx, key, &redis.Z{Score: score, Member: member}).Err()
+}
+
+// ZRem removes members from the sorted set stored at key
+func (c *Cache) ZRem(ctx context.Context, key string, members ...interface{}) error {
+	return c.client.ZRem(ctx, key, members...).Err()
+}
+
+// ZRangeByScore returns the members of the sorted set with a score between min and max
+func (c *Cache) ZRangeByScore(ctx context.Context, key, min, max string) ([]string, error) {
+	return c.client.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: min, Max: max}).Result()
+}
+
+// ZRemRangeByScore removes the members of the sorted set with a score between min and max
+func (c *Cache) ZRemRangeByScore(ctx context.Context, key, min, max string) error {
+	return c.client.ZRemRangeByScore(ctx, key, min, max).Err()
+}
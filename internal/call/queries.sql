@@ -1,6 +1,13 @@
 -- name: CreateCallSession :one
-INSERT INTO sessions (user_id, advisor_id, type)
-VALUES ($1, $2, 'CALL')
+INSERT INTO sessions (user_id, advisor_id, type, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency)
+SELECT sqlc.arg(user_id)::uuid, sqlc.narg(advisor_id)::uuid, 'CALL', p.session_type, p.per_minute_rate, p.min_billable_minutes,
+       CASE WHEN p.id IS NULL THEN NULL
+            WHEN EXISTS (SELECT 1 FROM sessions prev WHERE prev.user_id = sqlc.arg(user_id) AND prev.advisor_id = sqlc.narg(advisor_id)) THEN 0
+            ELSE p.first_session_discount_percent END,
+       p.currency
+FROM (SELECT 1) AS one
+LEFT JOIN advisors a ON a.user_id = sqlc.narg(advisor_id)
+LEFT JOIN advisor_pricing p ON p.advisor_id = a.id AND p.session_type = 'CALL'
 RETURNING *;
 
 -- name: EndCall :exec
@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log"
 	"time"
 
+	"loveguru/internal/advisor"
 	"loveguru/internal/db"
+	"loveguru/internal/earnings"
 	"loveguru/internal/grpc/middleware"
 	"loveguru/proto/call"
 	"loveguru/proto/common"
@@ -18,12 +21,16 @@ import (
 type Service struct {
 	repo         *db.Queries
 	agoraService *AgoraService
+	ledger       *earnings.Ledger
+	capacity     *advisor.Capacity
 }
 
-func NewService(repo *db.Queries, agoraService *AgoraService) *Service {
+func NewService(repo *db.Queries, agoraService *AgoraService, ledger *earnings.Ledger, capacity *advisor.Capacity) *Service {
 	return &Service{
 		repo:         repo,
 		agoraService: agoraService,
+		ledger:       ledger,
+		capacity:     capacity,
 	}
 }
 
@@ -43,9 +50,12 @@ func (s *Service) CreateSession(ctx context.Context, req *call.CreateSessionRequ
 		return nil, err
 	}
 
-	session, err := s.repo.CreateCallSession(ctx, db.CreateCallSessionParams{
-		UserID:    uid,
-		AdvisorID: uuid.NullUUID{UUID: aid, Valid: true},
+	// Calls are exclusive, so the advisor must not be in any other session
+	session, err := s.capacity.Reserve(ctx, aid, common.SessionType_CALL.String(), func(q *db.Queries) (db.Session, error) {
+		return q.CreateCallSession(ctx, db.CreateCallSessionParams{
+			UserID:    uid,
+			AdvisorID: uuid.NullUUID{UUID: aid, Valid: true},
+		})
 	})
 	if err != nil {
 		return nil, err
@@ -54,16 +64,19 @@ func (s *Service) CreateSession(ctx context.Context, req *call.CreateSessionRequ
 	// Create Agora call session
 	agoraCallInfo, err := s.agoraService.CreateCallSession(ctx, userInfo.ID, req.AdvisorId)
 	if err != nil {
-		// Log the error for debugging
+		// Free the advisor again, the call never started
+		s.cancelSession(ctx, session)
 		return nil, fmt.Errorf("failed to create Agora call session: %w", err)
 	}
 
 	// Validate Agora call info
 	if agoraCallInfo == nil {
+		s.cancelSession(ctx, session)
 		return nil, fmt.Errorf("Agora call session returned nil info")
 	}
 
 	if agoraCallInfo.Token == "" {
+		s.cancelSession(ctx, session)
 		return nil, fmt.Errorf("Agora call session returned empty token")
 	}
 
@@ -79,6 +92,7 @@ func (s *Service) CreateSession(ctx context.Context, req *call.CreateSessionRequ
 			StartedAt: session.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
 			EndedAt:   session.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
 			Status:    common.SessionStatus(common.SessionStatus_value[session.Status.String]),
+			Pricing:   advisor.MapSessionPricing(session),
 		},
 		CallToken: callToken,
 		RoomId:    roomID,
@@ -129,9 +143,27 @@ func (s *Service) EndCall(ctx context.Context, req *call.EndCallRequest) (*call.
 		return nil, err
 	}
 
+	s.ledger.RecordSession(ctx, sid)
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	if session.AdvisorID.Valid {
+		s.capacity.Sync(ctx, session.AdvisorID.UUID)
+	}
+
 	return &call.EndCallResponse{Success: true}, nil
 }
 
+// cancelSession cancels a call session that could not be set up
+func (s *Service) cancelSession(ctx context.Context, session db.Session) {
+	if err := s.repo.CancelSession(ctx, session.ID); err != nil {
+		log.Printf("Error cancelling call session %s: %v", session.ID, err)
+	}
+	s.capacity.Sync(ctx, session.AdvisorID.UUID)
+}
+
 func (s *Service) GetCall(ctx context.Context, req *call.GetCallRequest) (*call.GetCallResponse, error) {
 	sid, err := uuid.Parse(req.SessionId)
 	if err != nil {
@@ -152,6 +184,7 @@ func (s *Service) GetCall(ctx context.Context, req *call.GetCallRequest) (*call.
 			StartedAt: session.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
 			EndedAt:   session.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
 			Status:    common.SessionStatus(common.SessionStatus_value[session.Status.String]),
+			Pricing:   advisor.MapSessionPricing(session),
 		},
 	}, nil
 }
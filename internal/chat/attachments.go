@@ -0,0 +1,353 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"loveguru/internal/db"
+	"loveguru/proto/chat"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+const (
+	maxImageSize     = 10 << 20 // 10MB
+	maxVoiceNoteSize = 5 << 20  // 5MB, several minutes of compressed speech
+	// maxAttachmentSize is the most an upload may be before its type is known
+	maxAttachmentSize = maxImageSize
+)
+
+const (
+	attachmentKindImage = "IMAGE"
+	attachmentKindVoice = "VOICE"
+
+	messageKindAttachment = "ATTACHMENT"
+)
+
+var (
+	errAttachmentsUnavailable = errors.New("attachments are not available")
+	errUnsupportedAttachment  = errors.New("only images and voice notes can be sent")
+	errAttachmentTooLarge     = errors.New("attachment is too large")
+	errAttachmentDeleted      = errors.New("attachment was deleted")
+	errNotParticipant         = errors.New("you are not part of this session")
+	errSessionNotActive       = errors.New("session is not active")
+)
+
+type attachmentType struct {
+	kind    string
+	maxSize int
+}
+
+// allowedAttachmentTypes maps the sniffed MIME type of an upload to how it is sent
+var allowedAttachmentTypes = map[string]attachmentType{
+	"image/jpeg": {attachmentKindImage, maxImageSize},
+	"image/png":  {attachmentKindImage, maxImageSize},
+	"image/gif":  {attachmentKindImage, maxImageSize},
+	"image/webp": {attachmentKindImage, maxImageSize},
+	"audio/aac":  {attachmentKindVoice, maxVoiceNoteSize},
+	"audio/mp4":  {attachmentKindVoice, maxVoiceNoteSize},
+	"audio/mpeg": {attachmentKindVoice, maxVoiceNoteSize},
+	"audio/ogg":  {attachmentKindVoice, maxVoiceNoteSize},
+	"audio/webm": {attachmentKindVoice, maxVoiceNoteSize},
+	"audio/wave": {attachmentKindVoice, maxVoiceNoteSize},
+	"audio/3gpp": {attachmentKindVoice, maxVoiceNoteSize},
+}
+
+// UploadAttachment stores a file the uploader is about to send in the session. Its type
+// is sniffed from the content rather than trusted from the client. The attachment is
+// only visible to the other participant once sent with SendAttachmentMessage.
+func (s *Service) UploadAttachment(ctx context.Context, sessionID, uploaderID string, r io.Reader, durationMs int32) (db.ChatAttachment, error) {
+	if s.blobs == nil {
+		return db.ChatAttachment{}, errAttachmentsUnavailable
+	}
+
+	session, uid, err := s.activeSessionFor(ctx, sessionID, uploaderID)
+	if err != nil {
+		return db.ChatAttachment{}, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxAttachmentSize+1))
+	if err != nil {
+		return db.ChatAttachment{}, err
+	}
+	if len(data) == 0 {
+		return db.ChatAttachment{}, fmt.Errorf("%w: attachment is empty", errUnsupportedAttachment)
+	}
+
+	mimeType := sniffMimeType(data)
+	t, ok := allowedAttachmentTypes[mimeType]
+	if !ok {
+		return db.ChatAttachment{}, fmt.Errorf("%w, got %s", errUnsupportedAttachment, mimeType)
+	}
+	if len(data) > t.maxSize {
+		return db.ChatAttachment{}, fmt.Errorf("%w: %s attachments are limited to %d bytes", errAttachmentTooLarge, strings.ToLower(t.kind), t.maxSize)
+	}
+
+	params := db.CreateChatAttachmentParams{
+		SessionID:  session.ID,
+		UploaderID: uid,
+		Kind:       t.kind,
+		MimeType:   mimeType,
+		SizeBytes:  int64(len(data)),
+		StorageKey: fmt.Sprintf("chat/%s/%s", session.ID, uuid.New()),
+	}
+	if t.kind == attachmentKindVoice && durationMs > 0 {
+		params.DurationMs.Int32, params.DurationMs.Valid = durationMs, true
+	}
+
+	if err := s.blobs.Put(ctx, params.StorageKey, bytes.NewReader(data)); err != nil {
+		return db.ChatAttachment{}, err
+	}
+
+	attachment, err := s.repo.CreateChatAttachment(ctx, params)
+	if err != nil {
+		if delErr := s.blobs.Delete(ctx, params.StorageKey); delErr != nil {
+			log.Printf("Error removing orphaned attachment %s: %v", params.StorageKey, delErr)
+		}
+		return db.ChatAttachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// SendAttachmentMessage sends an attachment the sender uploaded to the session, with an
+// optional caption. It returns the stored message and the attachment with a fresh link.
+func (s *Service) SendAttachmentMessage(ctx context.Context, sessionID, senderID, attachmentID, caption, clientMessageID string) (db.ChatMessage, *common.ChatAttachment, error) {
+	session, uid, err := s.activeSessionFor(ctx, sessionID, senderID)
+	if err != nil {
+		return db.ChatMessage{}, nil, err
+	}
+
+	aid, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return db.ChatMessage{}, nil, err
+	}
+
+	senderType := "USER"
+	if session.AdvisorID.Valid && session.AdvisorID.UUID == uid {
+		senderType = "ADVISOR"
+	}
+
+	screened, err := s.moderate(strings.TrimSpace(caption))
+	if err != nil {
+		return db.ChatMessage{}, nil, err
+	}
+
+	sealed, keyID, terms, err := s.sealContent(screened.Content)
+	if err != nil {
+		return db.ChatMessage{}, nil, err
+	}
+
+	msg, err := s.repo.InsertAttachmentMessage(ctx, db.InsertAttachmentMessageParams{
+		ID:              uuid.New(),
+		SenderType:      senderType,
+		Content:         sealed,
+		ContentKeyID:    keyID,
+		SearchTerms:     terms,
+		ClientMessageID: sql.NullString{String: clientMessageID, Valid: clientMessageID != ""},
+		AttachmentID:    aid,
+		SessionID:       session.ID,
+		SenderID:        uid,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.ChatMessage{}, nil, errors.New("attachment not found or already sent")
+		}
+		return db.ChatMessage{}, nil, err
+	}
+
+	msg.Content = screened.Content
+	s.flagMessage(ctx, msg, screened.Flagged)
+	s.startBilling(ctx, session.ID, senderType)
+
+	row, err := s.repo.GetChatAttachment(ctx, aid)
+	if err != nil {
+		return db.ChatMessage{}, nil, err
+	}
+
+	preview := msg.Content
+	if preview == "" {
+		preview = "Sent a photo"
+		if row.ChatAttachment.Kind == attachmentKindVoice {
+			preview = "Sent a voice note"
+		}
+	}
+	s.queueMessagePush(sessionID, senderID, preview)
+
+	return msg, s.mapAttachment(row.ChatAttachment), nil
+}
+
+// GetAttachmentURL issues a new download link for an attachment, e.g. once the link that
+// came with the message has expired
+func (s *Service) GetAttachmentURL(ctx context.Context, req *chat.GetAttachmentURLRequest) (*chat.GetAttachmentURLResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aid, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.repo.GetChatAttachmentForMember(ctx, db.GetChatAttachmentForMemberParams{
+		ID:       aid,
+		MemberID: uid,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("attachment not found")
+		}
+		return nil, err
+	}
+
+	// Attachments that were not sent yet are only visible to their uploader
+	if !row.ChatAttachment.MessageID.Valid && row.ChatAttachment.UploaderID != uid {
+		return nil, errors.New("attachment not found")
+	}
+	if row.MessageDeleted {
+		return nil, errAttachmentDeleted
+	}
+
+	return &chat.GetAttachmentURLResponse{Attachment: s.mapAttachment(row.ChatAttachment)}, nil
+}
+
+// OpenAttachment returns the content of an attachment for a verified download link
+func (s *Service) OpenAttachment(ctx context.Context, attachmentID string) (db.ChatAttachment, io.ReadCloser, error) {
+	if s.blobs == nil {
+		return db.ChatAttachment{}, nil, errAttachmentsUnavailable
+	}
+
+	aid, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return db.ChatAttachment{}, nil, err
+	}
+
+	row, err := s.repo.GetChatAttachment(ctx, aid)
+	if err != nil {
+		return db.ChatAttachment{}, nil, err
+	}
+	if row.MessageDeleted {
+		return db.ChatAttachment{}, nil, errAttachmentDeleted
+	}
+
+	content, err := s.blobs.Open(ctx, row.ChatAttachment.StorageKey)
+	if err != nil {
+		return db.ChatAttachment{}, nil, err
+	}
+
+	return row.ChatAttachment, content, nil
+}
+
+// messageAttachments loads the attachments of the messages with fresh download links,
+// keyed by message ID. Attachments of deleted messages are left out.
+func (s *Service) messageAttachments(ctx context.Context, messages []db.ChatMessage) (map[uuid.UUID]*common.ChatAttachment, error) {
+	var ids []uuid.UUID
+	for _, m := range messages {
+		if m.Kind == messageKindAttachment && !m.DeletedAt.Valid {
+			ids = append(ids, m.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	attachments, err := s.repo.GetMessageAttachments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byMessage := make(map[uuid.UUID]*common.ChatAttachment, len(attachments))
+	for _, a := range attachments {
+		byMessage[a.MessageID.UUID] = s.mapAttachment(a)
+	}
+	return byMessage, nil
+}
+
+// activeSessionFor loads an ongoing session the user takes part in
+func (s *Service) activeSessionFor(ctx context.Context, sessionID, userID string) (db.Session, uuid.UUID, error) {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return db.Session{}, uuid.Nil, err
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return db.Session{}, uuid.Nil, err
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.Session{}, uuid.Nil, errNotParticipant
+		}
+		return db.Session{}, uuid.Nil, err
+	}
+	if session.UserID != uid && (!session.AdvisorID.Valid || session.AdvisorID.UUID != uid) {
+		return db.Session{}, uuid.Nil, errNotParticipant
+	}
+	if session.Status.String != common.SessionStatus_ONGOING.String() {
+		return db.Session{}, uuid.Nil, errSessionNotActive
+	}
+
+	return session, uid, nil
+}
+
+func (s *Service) mapAttachment(a db.ChatAttachment) *common.ChatAttachment {
+	attachment := &common.ChatAttachment{
+		Id:         a.ID.String(),
+		Kind:       a.Kind,
+		MimeType:   a.MimeType,
+		SizeBytes:  a.SizeBytes,
+		DurationMs: a.DurationMs.Int32,
+	}
+	if s.urls != nil {
+		url, expiresAt := s.urls.Sign(a.ID.String())
+		attachment.Url = url
+		attachment.UrlExpiresAt = expiresAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	return attachment
+}
+
+// sniffMimeType detects the type of an upload from its first bytes. It extends
+// http.DetectContentType with the containers phones record voice notes in.
+func sniffMimeType(data []byte) string {
+	mimeType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+
+	switch mimeType {
+	case "application/ogg":
+		// Opus and Vorbis voice notes
+		return "audio/ogg"
+	case "video/webm":
+		// Browsers record voice notes as audio-only WebM
+		return "audio/webm"
+	case "video/mp4", "application/octet-stream":
+		if len(data) >= 12 && string(data[4:8]) == "ftyp" {
+			brand := string(data[8:12])
+			switch {
+			case brand == "M4A ":
+				return "audio/mp4"
+			case strings.HasPrefix(brand, "3gp"):
+				return "audio/3gpp"
+			}
+		}
+		if len(data) >= 2 && data[0] == 0xFF && data[1]&0xF6 == 0xF0 {
+			// AAC in ADTS frames
+			return "audio/aac"
+		}
+		if len(data) >= 2 && data[0] == 0xFF && data[1]&0xE0 == 0xE0 && data[1]&0x06 != 0 {
+			// MP3 without an ID3 tag
+			return "audio/mpeg"
+		}
+	}
+
+	return mimeType
+}
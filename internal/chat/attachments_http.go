@@ -0,0 +1,134 @@
+package chat
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"loveguru/internal/db"
+	"loveguru/internal/grpc/middleware"
+	"loveguru/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+// maxUploadRequestSize leaves room for the multipart framing around the largest file
+const maxUploadRequestSize = maxAttachmentSize + 64<<10
+
+// AttachmentHandler serves attachment uploads and their signed download links over
+// HTTP, next to the WebSocket endpoint
+type AttachmentHandler struct {
+	service   *Service
+	jwtSecret string
+}
+
+func NewAttachmentHandler(service *Service, jwtSecret string) *AttachmentHandler {
+	return &AttachmentHandler{service: service, jwtSecret: jwtSecret}
+}
+
+// Upload accepts a multipart form with session_id, file and, for voice notes, an
+// optional duration_ms. It requires the same bearer token as the gRPC API and answers
+// with the attachment to send over the chat.
+func (h *AttachmentHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	user, err := middleware.ParseToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), h.jwtSecret)
+	if err != nil {
+		http.Error(w, "Invalid or missing token", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadRequestSize)
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, errAttachmentTooLarge.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "Missing file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	sessionID := r.FormValue("session_id")
+	if _, err := uuid.Parse(sessionID); err != nil {
+		http.Error(w, "Missing or invalid session_id", http.StatusBadRequest)
+		return
+	}
+
+	var durationMs int32
+	if d := r.FormValue("duration_ms"); d != "" {
+		n, err := strconv.ParseInt(d, 10, 32)
+		if err != nil || n < 0 {
+			http.Error(w, "Invalid duration_ms", http.StatusBadRequest)
+			return
+		}
+		durationMs = int32(n)
+	}
+
+	attachment, err := h.service.UploadAttachment(r.Context(), sessionID, user.ID, file, durationMs)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.service.mapAttachment(attachment))
+}
+
+// Download serves /chat/attachments/{id} to anyone holding a valid signed link
+func (h *AttachmentHandler) Download(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if h.service.urls == nil {
+		writeAttachmentError(w, errAttachmentsUnavailable)
+		return
+	}
+
+	if err := h.service.urls.Verify(id, r.URL.Query().Get("expires"), r.URL.Query().Get("sig")); err != nil {
+		http.Error(w, "Invalid or expired link", http.StatusForbidden)
+		return
+	}
+
+	attachment, content, err := h.service.OpenAttachment(r.Context(), id)
+	if err != nil {
+		writeAttachmentError(w, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.MimeType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", "inline")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age=300")
+
+	if _, err := io.Copy(w, content); err != nil {
+		log.Printf("Error serving attachment %s: %v", id, err)
+	}
+}
+
+func writeAttachmentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errUnsupportedAttachment):
+		http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+	case errors.Is(err, errAttachmentTooLarge):
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+	case errors.Is(err, errNotParticipant):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, errSessionNotActive):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, errAttachmentDeleted):
+		http.Error(w, err.Error(), http.StatusGone)
+	case errors.Is(err, errAttachmentsUnavailable):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	case db.IsNotFound(err), errors.Is(err, storage.ErrNotFound):
+		http.Error(w, "Attachment not found", http.StatusNotFound)
+	default:
+		log.Printf("Error handling attachment request: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
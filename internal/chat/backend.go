@@ -0,0 +1,132 @@
+package chat
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// presenceTTL is how long a connection counts as present without a heartbeat. Hubs
+// refresh their connections well within it, so only crashed instances go stale.
+const presenceTTL = 3 * time.Minute
+
+// Event is a hub message on its way to the clients of a session on every instance
+type Event struct {
+	Message       Message `json:"message"`
+	ExcludeUserID string  `json:"exclude_user_id,omitempty"` // skip this user's connections, e.g. the typist
+	UserID        string  `json:"user_id,omitempty"`         // only this user's connections, e.g. their balance
+	ExcludeConnID string  `json:"exclude_conn_id,omitempty"` // skip this connection, e.g. the device the user read on
+}
+
+// Backend fans hub events out to every hub instance and tracks which users are
+// connected to a session anywhere in the cluster
+type Backend interface {
+	// Publish sends the event to all instances, including this one
+	Publish(ctx context.Context, event Event) error
+	// Subscribe delivers events published by any instance until ctx is cancelled
+	Subscribe(ctx context.Context) <-chan Event
+
+	// Join records or refreshes a connection of the user to the session
+	Join(ctx context.Context, sessionID, userID, connID string) error
+	// Leave removes a connection recorded by Join
+	Leave(ctx context.Context, sessionID, userID, connID string) error
+	// Present returns the users with at least one live connection to the session
+	Present(ctx context.Context, sessionID string) ([]string, error)
+}
+
+// MemoryBackend keeps everything in process. It is the default for a single instance
+// and doubles as a fake for exercising the hub without Redis.
+type MemoryBackend struct {
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+	presence    map[string]map[string]presenceEntry // session ID -> connection ID -> entry
+}
+
+type presenceEntry struct {
+	userID string
+	seenAt time.Time
+}
+
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		subscribers: make(map[chan Event]struct{}),
+		presence:    make(map[string]map[string]presenceEntry),
+	}
+}
+
+func (b *MemoryBackend) Publish(ctx context.Context, event Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Subscribe(ctx context.Context) <-chan Event {
+	ch := make(chan Event, 256)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (b *MemoryBackend) Join(ctx context.Context, sessionID, userID, connID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	conns, ok := b.presence[sessionID]
+	if !ok {
+		conns = make(map[string]presenceEntry)
+		b.presence[sessionID] = conns
+	}
+	conns[connID] = presenceEntry{userID: userID, seenAt: time.Now()}
+	return nil
+}
+
+func (b *MemoryBackend) Leave(ctx context.Context, sessionID, userID, connID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if conns, ok := b.presence[sessionID]; ok {
+		delete(conns, connID)
+		if len(conns) == 0 {
+			delete(b.presence, sessionID)
+		}
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Present(ctx context.Context, sessionID string) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cutoff := time.Now().Add(-presenceTTL)
+	seen := make(map[string]struct{})
+	var users []string
+	for _, e := range b.presence[sessionID] {
+		if e.seenAt.Before(cutoff) {
+			continue
+		}
+		if _, ok := seen[e.userID]; !ok {
+			seen[e.userID] = struct{}{}
+			users = append(users, e.userID)
+		}
+	}
+	return users, nil
+}
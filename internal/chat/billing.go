@@ -0,0 +1,205 @@
+package chat
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+
+	"github.com/google/uuid"
+)
+
+// lowBalanceMinutes is how many minutes of a paid chat the balance must still cover
+// before the user is warned that it is running out
+const lowBalanceMinutes = 2
+
+// endedForFunds is the reason given when a chat ends because the user ran out of funds
+const endedForFunds = "INSUFFICIENT_FUNDS"
+
+// startBilling starts metering a paid chat when the advisor first replies, and charges
+// the first minute right away
+func (s *Service) startBilling(ctx context.Context, sessionID uuid.UUID, senderType string) {
+	if senderType != "ADVISOR" {
+		return
+	}
+
+	started, err := s.repo.StartSessionBilling(ctx, sessionID)
+	if err != nil {
+		log.Printf("Error starting billing for session %s: %v", sessionID, err)
+		return
+	}
+	if started > 0 {
+		s.meter(ctx, uuid.NullUUID{UUID: sessionID, Valid: true}, false)
+	}
+}
+
+// meterSessions charges every paid chat for the minutes that started since it was last
+// charged
+func (s *Service) meterSessions(ctx context.Context) {
+	s.meter(ctx, uuid.NullUUID{}, false)
+}
+
+// settleSession charges the minutes a chat started before it ends. A user who cannot pay
+// for them is not charged further.
+func (s *Service) settleSession(ctx context.Context, sessionID uuid.UUID) {
+	s.meter(ctx, uuid.NullUUID{UUID: sessionID, Valid: true}, true)
+}
+
+// meter charges the due minutes of metered chats, or of one of them, and ends the chats
+// whose user can no longer pay unless they are ending anyway
+func (s *Service) meter(ctx context.Context, sessionID uuid.NullUUID, ending bool) {
+	due, err := s.repo.ListDueSessionCharges(ctx, sessionID)
+	if err != nil {
+		log.Printf("Error listing due session charges: %v", err)
+		return
+	}
+
+	for _, row := range due {
+		if s.chargeSession(ctx, row) || ending {
+			continue
+		}
+		s.endForFunds(ctx, row.ID)
+	}
+}
+
+// chargeSession charges the started minutes of a chat one at a time and tells the user
+// what is left. It reports false when the balance does not cover the next minute.
+func (s *Service) chargeSession(ctx context.Context, row db.ListDueSessionChargesRow) bool {
+	price := cents(row.MinutePrice)
+	balance := cents(row.Balance)
+	billedMinutes, billedAmount := row.BilledMinutes, row.BilledAmount
+
+	charged := false
+	funded := true
+	for minute := row.BilledMinutes; minute < row.DueMinutes; minute++ {
+		if balance < price {
+			funded = false
+			break
+		}
+
+		result, err := s.repo.ChargeSessionMinute(ctx, db.ChargeSessionMinuteParams{
+			SessionID:     row.ID,
+			BilledMinutes: minute,
+			Amount:        row.MinutePrice,
+		})
+		if err != nil {
+			if !db.IsNotFound(err) {
+				log.Printf("Error charging session %s: %v", row.ID, err)
+				break
+			}
+			// Nothing was charged: another instance got there first, the chat ended or
+			// another chat of the user spent the balance
+			funded = s.minuteFunded(ctx, row.ID, minute, price)
+			break
+		}
+
+		balance = cents(result.Balance)
+		billedMinutes, billedAmount = result.BilledMinutes, result.BilledAmount
+		charged = true
+	}
+
+	if charged {
+		s.publishBalance(ctx, row, balance, price, billedMinutes, billedAmount)
+	}
+	return funded
+}
+
+// minuteFunded reports whether a minute that could not be charged may still be paid
+// for. It is false only when the minute is still due and the balance does not cover it;
+// a minute another instance charged, or a chat that ended, is left to the next run.
+func (s *Service) minuteFunded(ctx context.Context, sessionID uuid.UUID, minute int32, price int64) bool {
+	due, err := s.repo.ListDueSessionCharges(ctx, uuid.NullUUID{UUID: sessionID, Valid: true})
+	if err != nil {
+		log.Printf("Error listing due charges of session %s: %v", sessionID, err)
+		return true
+	}
+	for _, row := range due {
+		if row.BilledMinutes == minute && cents(row.Balance) < price {
+			return false
+		}
+	}
+	return true
+}
+
+// publishBalance pushes the user's balance to their connections to the chat, with a
+// warning when it is about to run out
+func (s *Service) publishBalance(ctx context.Context, row db.ListDueSessionChargesRow, balance, price int64, billedMinutes int32, billedAmount string) {
+	minutesLeft := int64(0)
+	if price > 0 {
+		minutesLeft = balance / price
+	}
+
+	data := map[string]interface{}{
+		"balance":        float64(balance) / 100,
+		"minutes_left":   minutesLeft,
+		"minute_price":   float64(price) / 100,
+		"billed_minutes": billedMinutes,
+		"billed_amount":  earnings.ParseAmount(billedAmount),
+		"currency":       row.Currency.String,
+	}
+
+	s.publishToUser(ctx, row.ID, row.UserID, Message{
+		Type:      "BALANCE_UPDATE",
+		SessionID: row.ID.String(),
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+
+	if minutesLeft < lowBalanceMinutes {
+		s.publishToUser(ctx, row.ID, row.UserID, Message{
+			Type:      "BALANCE_LOW",
+			SessionID: row.ID.String(),
+			Timestamp: time.Now(),
+			Data: map[string]interface{}{
+				"balance":      data["balance"],
+				"minutes_left": minutesLeft,
+				"notice":       "Your balance is running low. Top up to keep chatting, or the chat ends when it runs out.",
+			},
+		})
+	}
+}
+
+// endForFunds ends a chat the user can no longer pay for and tells both participants why
+func (s *Service) endForFunds(ctx context.Context, sessionID uuid.UUID) {
+	session, err := s.repo.EndSessionForFunds(ctx, sessionID)
+	if err != nil {
+		if !db.IsNotFound(err) {
+			log.Printf("Error ending session %s for insufficient funds: %v", sessionID, err)
+		}
+		return
+	}
+
+	log.Printf("Ended session %s: insufficient funds", session.ID)
+	s.ledger.RecordSession(ctx, session.ID)
+	s.capacity.Sync(ctx, session.AdvisorID.UUID)
+
+	event := Event{
+		Message: Message{
+			Type:      "SESSION_ENDED",
+			SessionID: session.ID.String(),
+			Timestamp: time.Now(),
+			Data: map[string]interface{}{
+				"reason": endedForFunds,
+			},
+		},
+	}
+	if err := s.presence.Publish(ctx, event); err != nil {
+		log.Printf("Error publishing SESSION_ENDED for session %s: %v", session.ID, err)
+	}
+	s.pushSessionUpdate(ctx, session, "ended")
+}
+
+// publishToUser sends a frame to the user's connections to the session on every instance
+func (s *Service) publishToUser(ctx context.Context, sessionID, userID uuid.UUID, msg Message) {
+	if err := s.presence.Publish(ctx, Event{Message: msg, UserID: userID.String()}); err != nil {
+		log.Printf("Error publishing %s for session %s: %v", msg.Type, sessionID, err)
+	}
+}
+
+// cents converts a DECIMAL amount to cents, so balances are compared exactly
+func cents(amount string) int64 {
+	return int64(math.Round(earnings.ParseAmount(amount) * 100))
+}
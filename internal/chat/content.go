@@ -0,0 +1,32 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+
+	"loveguru/internal/db"
+
+	"github.com/google/uuid"
+)
+
+// sealContent encrypts message content for storage and returns the terms the message is
+// searched by
+func (s *Service) sealContent(content string) (string, uuid.NullUUID, []string, error) {
+	sealed, keyID, err := s.keyring.Seal(content)
+	if err != nil {
+		return "", uuid.NullUUID{}, nil, fmt.Errorf("encrypting message: %w", err)
+	}
+	return sealed, keyID, s.keyring.SearchTerms(content), nil
+}
+
+// openMessages decrypts the content of messages read from the database in place
+func (s *Service) openMessages(ctx context.Context, messages []db.ChatMessage) error {
+	for i := range messages {
+		content, err := s.keyring.Open(ctx, messages[i].Content, messages[i].ContentKeyID)
+		if err != nil {
+			return fmt.Errorf("decrypting message %s: %w", messages[i].ID, err)
+		}
+		messages[i].Content = content
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package chat
+
+import (
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// A user may be connected to a session from several devices at once, e.g. an advisor
+// with a laptop and a phone. The hub indexes connections by session and user so every
+// device gets the session's frames, and typing, read state and presence are tracked
+// for the user rather than for the device.
+
+// addClient registers a connection. Callers hold clientLock.
+func (h *Hub) addClient(client *Client) {
+	h.clients[client.ID] = client
+
+	users, ok := h.sessions[client.SessionID]
+	if !ok {
+		users = make(map[string]map[string]*Client)
+		h.sessions[client.SessionID] = users
+	}
+	conns, ok := users[client.UserID]
+	if !ok {
+		conns = make(map[string]*Client)
+		users[client.UserID] = conns
+	}
+	conns[client.ID] = client
+
+	h.metrics.connected(len(h.clients))
+}
+
+// removeClient drops a connection and closes its done channel. It reports false when
+// the connection was already dropped. Callers hold clientLock.
+func (h *Hub) removeClient(client *Client) bool {
+	if _, ok := h.clients[client.ID]; !ok {
+		return false
+	}
+	delete(h.clients, client.ID)
+	close(client.done)
+
+	users := h.sessions[client.SessionID]
+	delete(users[client.UserID], client.ID)
+	if len(users[client.UserID]) == 0 {
+		delete(users, client.UserID)
+	}
+	if len(users) == 0 {
+		delete(h.sessions, client.SessionID)
+	}
+
+	h.metrics.disconnected(len(h.clients), time.Since(client.connectedAt))
+	return true
+}
+
+// setTyping records whether the connection is typing. The other participant is told
+// when the user starts typing on any device and when they stopped on all of them.
+func (h *Hub) setTyping(client *Client, typing bool) {
+	h.clientLock.Lock()
+	before := h.userTyping(client)
+	client.typing = typing
+	after := h.userTyping(client)
+	h.clientLock.Unlock()
+
+	if before == after {
+		return
+	}
+
+	indicator := TypingIndicator{
+		Type:      "TYPING_STOPPED",
+		SessionID: client.SessionID,
+		UserID:    client.UserID,
+		IsTyping:  after,
+		Timestamp: time.Now(),
+	}
+	if after {
+		indicator.Type = "TYPING_STARTED"
+	}
+	h.broadcastTypingIndicator(indicator)
+}
+
+// userTyping reports whether the user is typing on any of their connections to the
+// client's session, including the client itself. Callers hold clientLock.
+func (h *Hub) userTyping(client *Client) bool {
+	if client.typing {
+		return true
+	}
+	for _, conn := range h.sessions[client.SessionID][client.UserID] {
+		if conn.typing {
+			return true
+		}
+	}
+	return false
+}
+
+// syncReadState tells the reader's other devices how far they have read, so they can
+// clear unread messages the user already saw elsewhere
+func (h *Hub) syncReadState(client *Client, receipt ReadReceipt) {
+	receipt.Type = "READ_STATE"
+	h.publish(Event{
+		Message: Message{
+			Type:      "READ_STATE",
+			SessionID: receipt.SessionID,
+			Timestamp: receipt.ReadAt,
+			Data:      receipt,
+		},
+		UserID:        client.UserID,
+		ExcludeConnID: client.ID,
+	})
+}
+
+// sendReadState tells a new connection how far its user has read the session
+func (h *Hub) sendReadState(client *Client) {
+	sid, err := uuid.Parse(client.SessionID)
+	if err != nil {
+		return
+	}
+
+	receipts, err := h.service.repo.GetSessionReadReceipts(h.ctx, sid)
+	if err != nil {
+		log.Printf("Error getting read receipts: %v", err)
+		return
+	}
+
+	for _, r := range receipts {
+		if r.UserID.String() != client.UserID || !r.LastReadMessageID.Valid {
+			continue
+		}
+
+		h.send(client, Message{
+			Type:      "READ_STATE",
+			SessionID: client.SessionID,
+			Timestamp: r.UpdatedAt.Time,
+			Data: ReadReceipt{
+				Type:      "READ_STATE",
+				SessionID: client.SessionID,
+				MessageID: r.LastReadMessageID.UUID.String(),
+				ReaderID:  client.UserID,
+				ReadAt:    r.UpdatedAt.Time,
+			},
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Before the server stops, its hubs are drained: frames being handled are stored, every
+// client is sent a RECONNECT frame and whatever else was queued for it, and then its
+// connection is closed with a hint to reconnect. Clients resume with resume_after on
+// another instance, or on this one once it is back, without losing messages.
+
+const (
+	// reconnectDelay and reconnectJitter spread the clients of a drained hub over a few
+	// seconds, so they do not all reconnect at once
+	reconnectDelay  = time.Second
+	reconnectJitter = 4 * time.Second
+
+	// retryAfterSeconds is the Retry-After of connections refused while draining
+	retryAfterSeconds = "5"
+)
+
+var (
+	errDraining = errors.New("server is restarting, reconnect and resume where you left off")
+	errHubFull  = errors.New("too many chat connections, try again later")
+)
+
+// newClient creates a client of the session that has not connected to a hub yet
+func newClient(id, sessionID, userID, resumeAfter string, protocol int) *Client {
+	client := &Client{
+		ID:          id,
+		Send:        make(chan Message, 256),
+		SessionID:   sessionID,
+		UserID:      userID,
+		ResumeAfter: resumeAfter,
+		Protocol:    protocol,
+		connectedAt: time.Now(),
+		done:        make(chan struct{}),
+		flushed:     make(chan struct{}),
+	}
+	client.lastActive.Store(client.connectedAt.UnixNano())
+	return client
+}
+
+// pending takes the frames still queued for a client the hub stopped serving, so they
+// are written before its connection is closed
+func (c *Client) pending() []Message {
+	var messages []Message
+	for {
+		select {
+		case message := <-c.Send:
+			messages = append(messages, message)
+		default:
+			return messages
+		}
+	}
+}
+
+// Draining reports whether the hub is shutting down
+func (h *Hub) Draining() bool {
+	return h.draining.Load()
+}
+
+// accepting returns why the hub turns new clients away, if it does
+func (h *Hub) accepting() error {
+	if h.draining.Load() {
+		return errDraining
+	}
+	if h.maxConnections <= 0 {
+		return nil
+	}
+
+	h.clientLock.RLock()
+	defer h.clientLock.RUnlock()
+	if len(h.clients) >= h.maxConnections {
+		return errHubFull
+	}
+	return nil
+}
+
+// Drain turns new clients away, waits for the frames being handled, tells every client
+// to reconnect and closes its connection once everything queued for it was written. It
+// returns early with the context's error when ctx is done first.
+func (h *Hub) Drain(ctx context.Context) error {
+	h.draining.Store(true)
+
+	// Frames handled from now on are dropped, so once the lock is free nothing is being stored
+	idle := make(chan struct{})
+	go func() {
+		h.persisting.Lock()
+		h.persisting.Unlock()
+		close(idle)
+	}()
+	select {
+	case <-idle:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	h.clientLock.Lock()
+	clients := make([]*Client, 0, len(h.clients))
+	for _, client := range h.clients {
+		select {
+		case client.Send <- reconnectFrame(client):
+			h.metrics.sent()
+		default:
+			// A client that fell behind reconnects on the close code alone
+		}
+		client.closeCode = websocket.CloseServiceRestart
+		clients = append(clients, client)
+		h.removeClient(client)
+	}
+	h.clientLock.Unlock()
+
+	for _, client := range clients {
+		select {
+		case <-client.flushed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// reconnectFrame tells a client the server is going away and when to reconnect
+func reconnectFrame(client *Client) Message {
+	retryAfter := reconnectDelay + time.Duration(rand.Int63n(int64(reconnectJitter)))
+	return Message{
+		Type:      "RECONNECT",
+		SessionID: client.SessionID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"reason":         "SERVER_RESTART",
+			"retry_after_ms": retryAfter.Milliseconds(),
+		},
+	}
+}
+
+// closeReason is the reason sent along with a WebSocket close code
+func closeReason(code int) string {
+	if code == websocket.CloseServiceRestart {
+		return errDraining.Error()
+	}
+	return ""
+}
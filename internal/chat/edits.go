@@ -0,0 +1,153 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+// messageEditWindow is how long after sending a message its sender may still edit it.
+// Deleting for everyone is always allowed.
+const messageEditWindow = 15 * time.Minute
+
+// EditMessage replaces the content of one of the sender's messages. The previous content
+// is kept as a revision for moderation.
+func (s *Service) EditMessage(ctx context.Context, sessionID, messageID, senderID, content string) (db.ChatMessage, error) {
+	if strings.TrimSpace(content) == "" {
+		return db.ChatMessage{}, errors.New("message content is required")
+	}
+
+	sid, mid, uid, err := parseMessageRef(sessionID, messageID, senderID)
+	if err != nil {
+		return db.ChatMessage{}, err
+	}
+
+	if err := s.checkOwnMessage(ctx, sid, mid, uid); err != nil {
+		return db.ChatMessage{}, err
+	}
+
+	// Edits are screened like new messages, so an edit cannot sneak in what a send could not
+	screened, err := s.moderate(content)
+	if err != nil {
+		return db.ChatMessage{}, err
+	}
+
+	sealed, keyID, terms, err := s.sealContent(screened.Content)
+	if err != nil {
+		return db.ChatMessage{}, err
+	}
+
+	msg, err := s.repo.EditChatMessage(ctx, db.EditChatMessageParams{
+		Content:       sealed,
+		ContentKeyID:  keyID,
+		SearchTerms:   terms,
+		ID:            mid,
+		SessionID:     sid,
+		SenderID:      uid,
+		EditableSince: sql.NullTime{Time: time.Now().Add(-messageEditWindow), Valid: true},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.ChatMessage{}, fmt.Errorf("messages can only be edited within %d minutes of sending", int(messageEditWindow.Minutes()))
+		}
+		return db.ChatMessage{}, err
+	}
+
+	s.flagMessage(ctx, msg, screened.Flagged)
+
+	msg.Content = screened.Content
+	return msg, nil
+}
+
+// DeleteMessage deletes one of the sender's messages for everyone. The message stays as
+// a tombstone without content; the content is kept as a revision for moderation.
+func (s *Service) DeleteMessage(ctx context.Context, sessionID, messageID, senderID string) (db.ChatMessage, error) {
+	sid, mid, uid, err := parseMessageRef(sessionID, messageID, senderID)
+	if err != nil {
+		return db.ChatMessage{}, err
+	}
+
+	if err := s.checkOwnMessage(ctx, sid, mid, uid); err != nil {
+		return db.ChatMessage{}, err
+	}
+
+	msg, err := s.repo.DeleteChatMessage(ctx, db.DeleteChatMessageParams{
+		ID:        mid,
+		SessionID: sid,
+		SenderID:  uid,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.ChatMessage{}, errors.New("message was already deleted")
+		}
+		return db.ChatMessage{}, err
+	}
+
+	return msg, nil
+}
+
+// checkOwnMessage explains why a message cannot be changed by the user, if it cannot
+func (s *Service) checkOwnMessage(ctx context.Context, sessionID, messageID, userID uuid.UUID) error {
+	msg, err := s.repo.GetMessageForReader(ctx, db.GetMessageForReaderParams{ID: messageID, SessionID: sessionID, ReaderID: userID})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return errors.New("message not found")
+		}
+		return err
+	}
+	if msg.SenderID != userID {
+		return errors.New("you can only change your own messages")
+	}
+	if msg.DeletedAt.Valid {
+		return errors.New("message was deleted")
+	}
+	return nil
+}
+
+func parseMessageRef(sessionID, messageID, userID string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	mid, err := uuid.Parse(messageID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	return sid, mid, uid, nil
+}
+
+func mapMessage(m db.ChatMessage) *common.ChatMessage {
+	msg := &common.ChatMessage{
+		Id:         m.ID.String(),
+		SessionId:  m.SessionID.String(),
+		SenderType: m.SenderType,
+		SenderId:   m.SenderID.String(),
+		Content:    m.Content,
+		CreatedAt:  m.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+		IsRead:     m.IsRead.Bool,
+		IsDeleted:  m.DeletedAt.Valid,
+		Kind:       m.Kind,
+	}
+	if m.ClientMessageID.Valid {
+		msg.ClientMessageId = m.ClientMessageID.String
+	}
+	if m.EditedAt.Valid {
+		msg.EditedAt = m.EditedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	if m.DeletedAt.Valid {
+		msg.DeletedAt = m.DeletedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	return msg
+}
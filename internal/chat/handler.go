@@ -8,10 +8,11 @@ import (
 type Handler struct {
 	chat.UnimplementedChatServiceServer
 	service *Service
+	hubs    *HubManager
 }
 
-func NewHandler(service *Service) *Handler {
-	return &Handler{service: service}
+func NewHandler(service *Service, hubs *HubManager) *Handler {
+	return &Handler{service: service, hubs: hubs}
 }
 
 func (h *Handler) CreateSession(ctx context.Context, req *chat.CreateSessionRequest) (*chat.CreateSessionResponse, error) {
@@ -22,23 +23,42 @@ func (h *Handler) GetMessages(ctx context.Context, req *chat.GetMessagesRequest)
 	return h.service.GetMessages(ctx, req)
 }
 
+func (h *Handler) EndSession(ctx context.Context, req *chat.EndSessionRequest) (*chat.EndSessionResponse, error) {
+	return h.service.EndSession(ctx, req)
+}
+
+func (h *Handler) ListSessionRequests(ctx context.Context, req *chat.ListSessionRequestsRequest) (*chat.ListSessionRequestsResponse, error) {
+	return h.service.ListSessionRequests(ctx, req)
+}
+
+func (h *Handler) AcceptSession(ctx context.Context, req *chat.AcceptSessionRequest) (*chat.AcceptSessionResponse, error) {
+	return h.service.AcceptSession(ctx, req)
+}
+
+func (h *Handler) DeclineSession(ctx context.Context, req *chat.DeclineSessionRequest) (*chat.DeclineSessionResponse, error) {
+	return h.service.DeclineSession(ctx, req)
+}
+
+func (h *Handler) GetUnreadCounts(ctx context.Context, req *chat.GetUnreadCountsRequest) (*chat.GetUnreadCountsResponse, error) {
+	return h.service.GetUnreadCounts(ctx, req)
+}
+
+func (h *Handler) GetAttachmentURL(ctx context.Context, req *chat.GetAttachmentURLRequest) (*chat.GetAttachmentURLResponse, error) {
+	return h.service.GetAttachmentURL(ctx, req)
+}
+
+func (h *Handler) SyncMessages(ctx context.Context, req *chat.SyncMessagesRequest) (*chat.SyncMessagesResponse, error) {
+	return h.service.SyncMessages(ctx, req)
+}
+
+func (h *Handler) SearchMessages(ctx context.Context, req *chat.SearchMessagesRequest) (*chat.SearchMessagesResponse, error) {
+	return h.service.SearchMessages(ctx, req)
+}
+
+func (h *Handler) ExportTranscript(ctx context.Context, req *chat.ExportTranscriptRequest) (*chat.ExportTranscriptResponse, error) {
+	return h.service.ExportTranscript(ctx, req)
+}
+
 func (h *Handler) ChatStream(stream chat.ChatService_ChatStreamServer) error {
-	for {
-		req, err := stream.Recv()
-		if err != nil {
-			return err
-		}
-
-		// For now, just echo the message back
-		resp := &chat.ChatMessageResponse{
-			Message: &chat.ChatMessage{
-				SessionId: req.SessionId,
-				Content:   req.Content,
-			},
-		}
-
-		if err := stream.Send(resp); err != nil {
-			return err
-		}
-	}
+	return h.hubs.HandleStream(stream)
 }
@@ -0,0 +1,160 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"loveguru/internal/db"
+	"loveguru/proto/chat"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+// History is paged by (created_at, id) relative to a cursor message, so messages that
+// arrive while a client pages do not shift the pages like an offset would
+const (
+	defaultHistoryPage = 50
+	maxHistoryPage     = 200
+	defaultSyncPage    = 200
+	maxSyncPage        = 500
+)
+
+func (s *Service) SyncMessages(ctx context.Context, req *chat.SyncMessagesRequest) (*chat.SyncMessagesResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(req.Limit, defaultSyncPage, maxSyncPage)
+	params := db.SyncMessagesParams{MemberID: uid, MaxMessages: limit + 1}
+	if req.LastMessageId != "" {
+		cursor, err := s.messageCursor(ctx, req.LastMessageId, uid)
+		if err != nil {
+			return nil, err
+		}
+		params.AfterID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+		params.AfterAt = cursor.CreatedAt
+	}
+
+	messages, err := s.repo.SyncMessages(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[:limit]
+	}
+
+	msgs, err := s.mapMessages(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chat.SyncMessagesResponse{Messages: msgs, HasMore: hasMore}, nil
+}
+
+// messagesBefore returns up to limit messages of the session just before the cursor,
+// oldest first, or the latest ones without a cursor. It reports whether there are more.
+func (s *Service) messagesBefore(ctx context.Context, sessionID uuid.UUID, cursor *db.GetMessageCursorRow, limit int32) ([]db.ChatMessage, bool, error) {
+	params := db.ListMessagesBeforeParams{SessionID: sessionID, MaxMessages: limit + 1}
+	if cursor != nil {
+		params.BeforeID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+		params.BeforeAt = cursor.CreatedAt
+	}
+
+	messages, err := s.repo.ListMessagesBefore(ctx, params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[:limit]
+	}
+	if err := s.openMessages(ctx, messages); err != nil {
+		return nil, false, err
+	}
+	slices.Reverse(messages)
+	return messages, hasMore, nil
+}
+
+// messagesAfter returns up to limit messages of the session just after the cursor,
+// oldest first. It reports whether there are more.
+func (s *Service) messagesAfter(ctx context.Context, sessionID uuid.UUID, cursor db.GetMessageCursorRow, limit int32) ([]db.ChatMessage, bool, error) {
+	messages, err := s.repo.ListMessagesAfter(ctx, db.ListMessagesAfterParams{
+		SessionID:   sessionID,
+		AfterAt:     cursor.CreatedAt.Time,
+		AfterID:     cursor.ID,
+		MaxMessages: limit + 1,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[:limit]
+	}
+	if err := s.openMessages(ctx, messages); err != nil {
+		return nil, false, err
+	}
+	return messages, hasMore, nil
+}
+
+// messageCursor looks up the position of a message the member can see
+func (s *Service) messageCursor(ctx context.Context, messageID string, memberID uuid.UUID) (db.GetMessageCursorRow, error) {
+	mid, err := uuid.Parse(messageID)
+	if err != nil {
+		return db.GetMessageCursorRow{}, err
+	}
+
+	cursor, err := s.repo.GetMessageCursor(ctx, db.GetMessageCursorParams{ID: mid, MemberID: memberID})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return db.GetMessageCursorRow{}, errors.New("cursor message not found")
+		}
+		return db.GetMessageCursorRow{}, err
+	}
+	return cursor, nil
+}
+
+// sessionCursor is messageCursor for a message that must belong to the session
+func (s *Service) sessionCursor(ctx context.Context, sessionID uuid.UUID, messageID string, memberID uuid.UUID) (db.GetMessageCursorRow, error) {
+	cursor, err := s.messageCursor(ctx, messageID, memberID)
+	if err != nil {
+		return db.GetMessageCursorRow{}, err
+	}
+	if cursor.SessionID != sessionID {
+		return db.GetMessageCursorRow{}, errors.New("cursor message is not part of this session")
+	}
+	return cursor, nil
+}
+
+// mapMessages maps messages for the API, with their attachments
+func (s *Service) mapMessages(ctx context.Context, messages []db.ChatMessage) ([]*common.ChatMessage, error) {
+	attachments, err := s.messageAttachments(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []*common.ChatMessage
+	for _, m := range messages {
+		msg := mapMessage(m)
+		msg.Attachment = attachments[m.ID]
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+func pageSize(requested, def, max int32) int32 {
+	if requested <= 0 {
+		return def
+	}
+	if requested > max {
+		return max
+	}
+	return requested
+}
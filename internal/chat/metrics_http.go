@@ -0,0 +1,37 @@
+package chat
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"loveguru/internal/grpc/middleware"
+)
+
+// MetricsHandler serves the connection metrics of this instance's chat hubs to admins
+type MetricsHandler struct {
+	hubs      *HubManager
+	jwtSecret string
+}
+
+func NewMetricsHandler(hubs *HubManager, jwtSecret string) *MetricsHandler {
+	return &MetricsHandler{hubs: hubs, jwtSecret: jwtSecret}
+}
+
+// Metrics answers with the health and metrics of every hub and their totals. It
+// requires the bearer token of an admin.
+func (h *MetricsHandler) Metrics(w http.ResponseWriter, r *http.Request) {
+	user, err := middleware.ParseToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), h.jwtSecret)
+	if err != nil {
+		http.Error(w, "Invalid or missing token", http.StatusUnauthorized)
+		return
+	}
+	if user.Role != "ADMIN" {
+		http.Error(w, "unauthorized", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(h.hubs.HealthCheck())
+}
@@ -0,0 +1,42 @@
+package chat
+
+import (
+	"context"
+	"log"
+
+	"loveguru/internal/db"
+	"loveguru/internal/moderation"
+
+	"github.com/google/uuid"
+)
+
+// moderate screens what a participant is about to store. Blocked content fails with a
+// *moderation.BlockedError.
+func (s *Service) moderate(content string) (moderation.Result, error) {
+	if s.moderation == nil || content == "" {
+		return moderation.Result{Content: content}, nil
+	}
+	return s.moderation.Check(content)
+}
+
+// flagMessage flags a stored message that FLAG rules matched for admins to review
+func (s *Service) flagMessage(ctx context.Context, msg db.ChatMessage, flagged []moderation.Detector) {
+	if len(flagged) == 0 {
+		return
+	}
+
+	params := db.CreateModerationFlagParams{
+		SessionID: uuid.NullUUID{UUID: msg.SessionID, Valid: true},
+		MessageID: uuid.NullUUID{UUID: msg.ID, Valid: true},
+		Reason:    moderation.Reason(flagged),
+	}
+	if msg.SenderType == "ADVISOR" {
+		params.ReportedAdvisorID = uuid.NullUUID{UUID: msg.SenderID, Valid: true}
+	} else {
+		params.ReportedUserID = uuid.NullUUID{UUID: msg.SenderID, Valid: true}
+	}
+
+	if _, err := s.repo.CreateModerationFlag(ctx, params); err != nil {
+		log.Printf("Error flagging message %s: %v", msg.ID, err)
+	}
+}
@@ -0,0 +1,170 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/internal/moderation"
+
+	"github.com/google/uuid"
+)
+
+// WebSocket protocol versions, negotiated with the v query parameter of /ws/chat.
+// Version 1 is the original protocol and the default, so old clients keep working.
+// Version 2 acknowledges every message a client sends with an ACK or NACK frame.
+const (
+	protocolV1 = 1
+	protocolV2 = 2
+
+	latestProtocol = protocolV2
+)
+
+// maxClientMessageIDLength bounds the IDs clients give their messages; a UUID fits
+const maxClientMessageIDLength = 64
+
+var errInvalidClientMessageID = fmt.Errorf("client_id must be at most %d characters", maxClientMessageIDLength)
+
+// parseProtocolVersion reads the v query parameter, defaulting to version 1
+func parseProtocolVersion(v string) (int, error) {
+	if v == "" {
+		return protocolV1, nil
+	}
+
+	version, err := strconv.Atoi(v)
+	if err != nil || version < protocolV1 || version > latestProtocol {
+		return 0, fmt.Errorf("unsupported protocol version %q, this server speaks 1 to %d", v, latestProtocol)
+	}
+	return version, nil
+}
+
+// sentMessageID returns the message the sender already sent in the session under the
+// client message ID, so a send retried after a dropped connection is not stored twice
+func (s *Service) sentMessageID(ctx context.Context, sessionID, senderID, clientMessageID string) (string, bool, error) {
+	if clientMessageID == "" {
+		return "", false, nil
+	}
+	if len(clientMessageID) > maxClientMessageIDLength {
+		return "", false, errInvalidClientMessageID
+	}
+
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return "", false, err
+	}
+
+	msg, err := s.repo.GetMessageByClientID(ctx, db.GetMessageByClientIDParams{
+		SessionID:       sid,
+		ClientMessageID: sql.NullString{String: clientMessageID, Valid: true},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if msg.SenderID.String() != senderID {
+		return "", false, errors.New("client_id was already used by another participant")
+	}
+
+	return msg.ID.String(), true, nil
+}
+
+// acknowledgeDuplicate ACKs a frame whose message was already stored under its client
+// ID and reports whether it was. Such frames are not stored or broadcast again.
+func (h *Hub) acknowledgeDuplicate(client *Client, frame Message) bool {
+	messageID, found, err := h.service.sentMessageID(h.ctx, client.SessionID, client.UserID, frame.ClientID)
+	if err != nil {
+		h.nack(client, frame, err)
+		return true
+	}
+	if !found {
+		return false
+	}
+
+	h.reply(client, Message{
+		Type:      "ACK",
+		SessionID: client.SessionID,
+		ClientID:  frame.ClientID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"message_id": messageID,
+			"duplicate":  true,
+		},
+	})
+	return true
+}
+
+// ack confirms to a version 2 client that its message was stored under messageID
+func (h *Hub) ack(client *Client, frame Message, messageID string) {
+	h.reply(client, Message{
+		Type:      "ACK",
+		SessionID: client.SessionID,
+		ClientID:  frame.ClientID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"message_id": messageID,
+		},
+	})
+}
+
+// nack tells a version 2 client that its message was rejected and not stored
+func (h *Hub) nack(client *Client, frame Message, err error) {
+	log.Printf("Rejected %s from %s: %v", frame.Type, client.UserID, err)
+	h.noticeBlocked(client, frame, err)
+
+	h.reply(client, Message{
+		Type:      "NACK",
+		SessionID: client.SessionID,
+		ClientID:  frame.ClientID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"error": err.Error(),
+		},
+	})
+}
+
+// noticeBlocked tells the sender why moderation blocked its frame. Clients of every
+// version get the notice, since it is meant for the person typing.
+func (h *Hub) noticeBlocked(client *Client, frame Message, err error) {
+	var blocked *moderation.BlockedError
+	if !errors.As(err, &blocked) {
+		return
+	}
+
+	h.send(client, Message{
+		Type:      "MESSAGE_BLOCKED",
+		SessionID: client.SessionID,
+		ClientID:  frame.ClientID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"detector": blocked.Detector,
+			"notice":   blocked.Notice,
+		},
+	})
+}
+
+// reply sends a frame to this connection only. Version 1 clients do not understand
+// acknowledgements, so they get none.
+func (h *Hub) reply(client *Client, message Message) {
+	if client.Protocol < protocolV2 {
+		return
+	}
+	h.send(client, message)
+}
+
+// send queues a frame for this connection only
+func (h *Hub) send(client *Client, message Message) {
+	select {
+	case client.Send <- message:
+		h.metrics.sent()
+	case <-client.done:
+	case <-time.After(5 * time.Second):
+		log.Printf("Dropped %s for %s: client is not reading", message.Type, client.UserID)
+	}
+}
@@ -0,0 +1,138 @@
+package chat
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// chatPushDelay is how long a sender's messages are collected before participants
+	// who are not connected are notified, so a burst of messages becomes one push
+	chatPushDelay = 10 * time.Second
+	// chatPushTimeout bounds resolving recipients and sending the push
+	chatPushTimeout = 30 * time.Second
+	// maxPushPreview is how much of a message is shown in its notification
+	maxPushPreview = 50
+)
+
+type pushKey struct {
+	sessionID string
+	senderID  string
+}
+
+// pendingPush collects the messages of one sender until their notification goes out
+type pendingPush struct {
+	count   int
+	preview string // the latest message
+}
+
+// queueMessagePush notifies the other participants of a new message unless they are
+// connected to the session. Messages the sender writes within chatPushDelay of the first
+// one are collapsed into the same notification.
+func (s *Service) queueMessagePush(sessionID, senderID, preview string) {
+	if s.notifier == nil {
+		return
+	}
+
+	key := pushKey{sessionID: sessionID, senderID: senderID}
+
+	s.pushLock.Lock()
+	defer s.pushLock.Unlock()
+
+	if p, ok := s.pendingPushes[key]; ok {
+		p.count++
+		p.preview = preview
+		return
+	}
+
+	s.pendingPushes[key] = &pendingPush{count: 1, preview: preview}
+	time.AfterFunc(chatPushDelay, func() { s.sendPushNotificationForMessage(key) })
+}
+
+// sendPushNotificationForMessage sends the collected notification to the participants
+// who are still not connected once the sender has paused
+func (s *Service) sendPushNotificationForMessage(key pushKey) {
+	s.pushLock.Lock()
+	p := s.pendingPushes[key]
+	delete(s.pendingPushes, key)
+	s.pushLock.Unlock()
+
+	if p == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), chatPushTimeout)
+	defer cancel()
+
+	recipients, err := s.offlineRecipients(ctx, key.sessionID, key.senderID)
+	if err != nil {
+		log.Printf("Error resolving push recipients for session %s: %v", key.sessionID, err)
+		return
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	senderName, err := s.getUserDisplayName(ctx, key.senderID)
+	if err != nil {
+		log.Printf("Error getting sender name: %v", err)
+		senderName = "Someone"
+	}
+
+	preview := []rune(p.preview)
+	if len(preview) > maxPushPreview {
+		preview = append(preview[:maxPushPreview], []rune("...")...)
+	}
+
+	for _, recipient := range recipients {
+		deviceTokens := s.deviceTokens(ctx, recipient)
+		if len(deviceTokens) == 0 {
+			continue
+		}
+
+		if p.count == 1 {
+			err = s.notifier.SendChatNotification(deviceTokens, senderName, string(preview), key.sessionID)
+		} else {
+			err = s.notifier.SendChatBurstNotification(deviceTokens, senderName, p.count, key.sessionID)
+		}
+		if err != nil {
+			log.Printf("Error sending chat notification to %s: %v", recipient, err)
+		}
+	}
+}
+
+// offlineRecipients returns the participants other than the sender who have no
+// connection to the session on any instance
+func (s *Service) offlineRecipients(ctx context.Context, sessionID, senderID string) ([]uuid.UUID, error) {
+	participants, err := s.GetSessionParticipants(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A duplicate notification is better than a missed one, so failing presence counts
+	// everyone as offline
+	var present []string
+	if s.presence != nil {
+		present, err = s.presence.Present(ctx, sessionID)
+		if err != nil {
+			log.Printf("Error reading presence for session %s: %v", sessionID, err)
+		}
+	}
+
+	var recipients []uuid.UUID
+	for _, p := range participants {
+		if p == senderID || slices.Contains(present, p) {
+			continue
+		}
+		uid, err := uuid.Parse(p)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, uid)
+	}
+	return recipients, nil
+}
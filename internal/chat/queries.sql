@@ -1,6 +1,19 @@
 -- name: CreateSession :one
-INSERT INTO sessions (user_id, advisor_id, type)
-VALUES ($1, $2, $3)
+-- Snapshots the advisor's current price for the pricing type; the first-session discount
+-- only applies when the user has never had a session with this advisor. Sessions created as
+-- REQUESTED wait for the advisor to accept them.
+INSERT INTO sessions (user_id, advisor_id, type, status, requested_at, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency)
+SELECT sqlc.arg(user_id)::uuid, sqlc.narg(advisor_id)::uuid, sqlc.arg(type)::text, sqlc.arg(status)::text,
+       CASE WHEN sqlc.arg(status)::text = 'REQUESTED' THEN NOW() END,
+       p.session_type, p.per_minute_rate, p.min_billable_minutes,
+       CASE WHEN p.id IS NULL THEN NULL
+            WHEN EXISTS (SELECT 1 FROM sessions prev WHERE prev.user_id = sqlc.arg(user_id) AND prev.advisor_id = sqlc.narg(advisor_id)
+                         AND prev.status NOT IN ('REQUESTED', 'DECLINED', 'EXPIRED')) THEN 0
+            ELSE p.first_session_discount_percent END,
+       p.currency
+FROM (SELECT 1) AS one
+LEFT JOIN advisors a ON a.user_id = sqlc.narg(advisor_id)
+LEFT JOIN advisor_pricing p ON p.advisor_id = a.id AND p.session_type = sqlc.arg(pricing_type)::text
 RETURNING *;
 
 -- name: GetSessionByID :one
@@ -9,21 +22,59 @@ SELECT * FROM sessions WHERE id = $1;
 -- name: GetMessages :many
 SELECT * FROM chat_messages WHERE session_id = $1 ORDER BY created_at LIMIT $2 OFFSET $3;
 
--- name: InsertMessage :one
-INSERT INTO chat_messages (session_id, sender_type, sender_id, content)
-VALUES ($1, $2, $3, $4)
-RETURNING *;
-
 -- name: UpdateSessionStatus :exec
 UPDATE sessions SET status = $2, ended_at = NOW() WHERE id = $1;
 
 -- name: InsertMessageWithID :one
-INSERT INTO chat_messages (session_id, sender_type, sender_id, content)
-VALUES ($1, $2, $3, $4)
+INSERT INTO chat_messages (session_id, sender_type, sender_id, content, content_key_id, search_terms, client_message_id)
+VALUES (sqlc.arg(session_id), sqlc.arg(sender_type), sqlc.arg(sender_id), sqlc.arg(content), sqlc.narg(content_key_id), sqlc.arg(search_terms)::text[], sqlc.narg(client_message_id))
 RETURNING id;
 
--- name: UpdateMessageReadStatus :exec
-UPDATE chat_messages SET is_read = true, read_at = NOW() WHERE id = $1;
+-- name: GetMessageByClientID :one
+SELECT * FROM chat_messages WHERE session_id = $1 AND client_message_id = $2;
+
+-- name: GetMessageForReader :one
+-- The message, provided it belongs to the session and the reader takes part in it
+SELECT m.* FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE m.id = sqlc.arg(id) AND m.session_id = sqlc.arg(session_id)
+  AND (s.user_id = sqlc.arg(reader_id) OR s.advisor_id = sqlc.arg(reader_id));
+
+-- name: UpsertReadReceipt :one
+-- Only ever moves the watermark forward; no row is returned when it was already further along
+INSERT INTO chat_read_receipts (session_id, user_id, last_read_message_id, last_read_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (session_id, user_id) DO UPDATE SET
+    last_read_message_id = EXCLUDED.last_read_message_id,
+    last_read_at = EXCLUDED.last_read_at,
+    updated_at = NOW()
+WHERE chat_read_receipts.last_read_at < EXCLUDED.last_read_at
+RETURNING *;
+
+-- name: UpdateMessageReadStatus :execrows
+-- Marks what the other participant sent up to the reader's watermark as read
+UPDATE chat_messages SET is_read = TRUE, read_at = NOW()
+WHERE session_id = sqlc.arg(session_id)
+  AND sender_id <> sqlc.arg(reader_id)
+  AND created_at <= sqlc.arg(read_until)
+  AND NOT COALESCE(is_read, FALSE);
+
+-- name: GetSessionReadReceipts :many
+SELECT * FROM chat_read_receipts WHERE session_id = $1;
+
+-- name: GetUnreadCounts :many
+-- Sessions the user takes part in with messages from others after their watermark, most recent first
+SELECT s.id AS session_id,
+       COUNT(m.id)::int AS unread_count,
+       r.last_read_message_id
+FROM sessions s
+JOIN chat_messages m ON m.session_id = s.id AND m.sender_id <> sqlc.arg(user_id) AND m.deleted_at IS NULL
+LEFT JOIN chat_read_receipts r ON r.session_id = s.id AND r.user_id = sqlc.arg(user_id)
+WHERE (s.user_id = sqlc.arg(user_id) OR s.advisor_id = sqlc.arg(user_id))
+  AND (sqlc.narg(session_id)::uuid IS NULL OR s.id = sqlc.narg(session_id)::uuid)
+  AND (r.last_read_at IS NULL OR m.created_at > r.last_read_at)
+GROUP BY s.id, r.last_read_message_id
+ORDER BY MAX(m.created_at) DESC;
 
 -- name: GetSessionParticipants :many
 SELECT user_id, advisor_id FROM sessions WHERE id = $1;
@@ -38,4 +89,261 @@ SELECT COUNT(*) FROM sessions WHERE user_id = $1;
 SELECT COUNT(*) FROM sessions WHERE user_id = $1 AND status = 'ENDED';
 
 -- name: GetAverageSessionDuration :one
-SELECT AVG(EXTRACT(EPOCH FROM (ended_at - started_at))) FROM sessions WHERE user_id = $1 AND status = 'ENDED';
\ No newline at end of file
+SELECT AVG(EXTRACT(EPOCH FROM (ended_at - started_at))) FROM sessions WHERE user_id = $1 AND status = 'ENDED';
+
+-- name: AcceptSessionRequest :one
+-- Billing runs from acceptance, so started_at is reset. Sessions offered to a queued user
+-- are accepted by the user, through AcceptQueueOffer.
+UPDATE sessions SET status = 'ONGOING', accepted_at = NOW(), started_at = NOW()
+WHERE sessions.id = $1 AND sessions.advisor_id = $2 AND sessions.status = 'REQUESTED'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+RETURNING *;
+
+-- name: DeclineSessionRequest :one
+UPDATE sessions SET status = 'DECLINED', ended_at = NOW()
+WHERE sessions.id = $1 AND sessions.advisor_id = $2 AND sessions.status = 'REQUESTED'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+RETURNING *;
+
+-- name: WithdrawSessionRequest :one
+UPDATE sessions SET status = 'CANCELLED', ended_at = NOW()
+WHERE id = $1 AND user_id = $2 AND status = 'REQUESTED'
+RETURNING *;
+
+-- name: ListSessionRequests :many
+SELECT * FROM sessions
+WHERE sessions.advisor_id = $1 AND sessions.status = 'REQUESTED'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+ORDER BY requested_at;
+
+-- name: ExpireSessionRequests :many
+-- Queue offers expire on their own, shorter deadline
+UPDATE sessions SET status = 'EXPIRED', ended_at = NOW()
+WHERE sessions.status = 'REQUESTED' AND sessions.requested_at < sqlc.arg(requested_before)
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+RETURNING *;
+
+-- name: ListIdleSessions :many
+-- Advisor chats with no message since the cutoff, counting from when the chat started
+SELECT s.* FROM sessions s
+WHERE s.status = 'ONGOING'
+  AND s.type = 'CHAT'
+  AND s.advisor_id IS NOT NULL
+  AND s.started_at < sqlc.arg(idle_since)
+  AND NOT EXISTS (
+      SELECT 1 FROM chat_messages m WHERE m.session_id = s.id AND m.created_at >= sqlc.arg(idle_since)
+  );
+
+-- name: EndIdleSession :one
+-- Ends a chat found by ListIdleSessions unless a message arrived or it ended meanwhile
+UPDATE sessions s SET status = 'ENDED', ended_at = NOW()
+WHERE s.id = sqlc.arg(id)
+  AND s.status = 'ONGOING'
+  AND NOT EXISTS (
+      SELECT 1 FROM chat_messages m WHERE m.session_id = s.id AND m.created_at >= sqlc.arg(idle_since)
+  )
+RETURNING *;
+
+-- name: EditChatMessage :one
+-- Replaces the content of the sender's own message if it is still editable, keeping the old content
+WITH previous AS (
+    SELECT c.id, c.content, c.content_key_id FROM chat_messages c
+    WHERE c.id = sqlc.arg(id) AND c.session_id = sqlc.arg(session_id) AND c.sender_id = sqlc.arg(sender_id)
+      AND c.deleted_at IS NULL AND c.created_at >= sqlc.arg(editable_since)
+    FOR UPDATE
+), revision AS (
+    INSERT INTO chat_message_revisions (message_id, action, content, content_key_id)
+    SELECT previous.id, 'EDIT', previous.content, previous.content_key_id FROM previous
+)
+UPDATE chat_messages m SET content = sqlc.arg(content), content_key_id = sqlc.narg(content_key_id),
+    search_terms = sqlc.arg(search_terms)::text[], edited_at = NOW()
+FROM previous
+WHERE m.id = previous.id
+RETURNING m.*;
+
+-- name: DeleteChatMessage :one
+-- Turns the sender's own message into a tombstone, keeping the old content
+WITH previous AS (
+    SELECT c.id, c.content, c.content_key_id FROM chat_messages c
+    WHERE c.id = sqlc.arg(id) AND c.session_id = sqlc.arg(session_id) AND c.sender_id = sqlc.arg(sender_id)
+      AND c.deleted_at IS NULL
+    FOR UPDATE
+), revision AS (
+    INSERT INTO chat_message_revisions (message_id, action, content, content_key_id)
+    SELECT previous.id, 'DELETE', previous.content, previous.content_key_id FROM previous
+)
+UPDATE chat_messages m SET content = '', content_key_id = NULL, search_terms = NULL, deleted_at = NOW()
+FROM previous
+WHERE m.id = previous.id
+RETURNING m.*;
+
+-- name: GetChatMessageByID :one
+SELECT * FROM chat_messages WHERE id = $1;
+
+-- name: GetMessageRevisions :many
+SELECT * FROM chat_message_revisions WHERE message_id = $1 ORDER BY created_at;
+
+-- name: CreateChatAttachment :one
+INSERT INTO chat_attachments (session_id, uploader_id, kind, mime_type, size_bytes, duration_ms, storage_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetChatAttachment :one
+-- The attachment with whether the message it was sent in has since been deleted
+SELECT sqlc.embed(a), (m.deleted_at IS NOT NULL)::boolean AS message_deleted
+FROM chat_attachments a
+LEFT JOIN chat_messages m ON m.id = a.message_id
+WHERE a.id = $1;
+
+-- name: GetChatAttachmentForMember :one
+-- The attachment, provided the member takes part in its session
+SELECT sqlc.embed(a), (m.deleted_at IS NOT NULL)::boolean AS message_deleted
+FROM chat_attachments a
+JOIN sessions s ON s.id = a.session_id
+LEFT JOIN chat_messages m ON m.id = a.message_id
+WHERE a.id = sqlc.arg(id) AND (s.user_id = sqlc.arg(member_id) OR s.advisor_id = sqlc.arg(member_id));
+
+-- name: GetMessageAttachments :many
+SELECT * FROM chat_attachments WHERE message_id = ANY(sqlc.arg(message_ids)::uuid[]);
+
+-- name: InsertAttachmentMessage :one
+-- Sends an attachment the sender uploaded to the session. Claiming the attachment and
+-- inserting the message is one statement, so an attachment is only ever sent once.
+WITH claimed AS (
+    UPDATE chat_attachments a SET message_id = sqlc.arg(id)
+    WHERE a.id = sqlc.arg(attachment_id) AND a.session_id = sqlc.arg(session_id)
+      AND a.uploader_id = sqlc.arg(sender_id) AND a.message_id IS NULL
+    RETURNING a.session_id, a.uploader_id
+)
+INSERT INTO chat_messages (id, session_id, sender_type, sender_id, content, content_key_id, search_terms, kind, client_message_id)
+SELECT sqlc.arg(id), claimed.session_id, sqlc.arg(sender_type), claimed.uploader_id, sqlc.arg(content), sqlc.narg(content_key_id), sqlc.arg(search_terms)::text[], 'ATTACHMENT', sqlc.narg(client_message_id)
+FROM claimed
+RETURNING *;
+
+-- name: GetMessageCursor :one
+-- Position of a message the member can see, for paging relative to it
+SELECT m.id, m.session_id, m.created_at FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE m.id = sqlc.arg(id) AND (s.user_id = sqlc.arg(member_id) OR s.advisor_id = sqlc.arg(member_id));
+
+-- name: ListMessagesBefore :many
+-- Newest first, so the page closest to the cursor comes back; the latest page without one
+SELECT * FROM chat_messages
+WHERE session_id = sqlc.arg(session_id)
+  AND (sqlc.narg(before_id)::uuid IS NULL OR (created_at, id) < (sqlc.narg(before_at)::timestamptz, sqlc.narg(before_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(max_messages);
+
+-- name: ListMessagesAfter :many
+SELECT * FROM chat_messages
+WHERE session_id = sqlc.arg(session_id)
+  AND (created_at, id) > (sqlc.arg(after_at)::timestamptz, sqlc.arg(after_id)::uuid)
+ORDER BY created_at, id
+LIMIT sqlc.arg(max_messages);
+
+-- name: SyncMessages :many
+-- Messages in all of the member's sessions after the cursor, oldest first; everything without one
+SELECT m.* FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE (s.user_id = sqlc.arg(member_id) OR s.advisor_id = sqlc.arg(member_id))
+  AND (sqlc.narg(after_id)::uuid IS NULL OR (m.created_at, m.id) > (sqlc.narg(after_at)::timestamptz, sqlc.narg(after_id)::uuid))
+ORDER BY m.created_at, m.id
+LIMIT sqlc.arg(max_messages);
+
+-- name: SearchMessages :many
+-- Messages of the member's sessions containing every search term, newest first
+SELECT sqlc.embed(m), s.advisor_id AS session_advisor_id
+FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE (s.user_id = sqlc.arg(member_id) OR s.advisor_id = sqlc.arg(member_id))
+  AND m.deleted_at IS NULL
+  AND m.search_terms @> sqlc.arg(terms)::text[]
+  AND (sqlc.narg(session_id)::uuid IS NULL OR m.session_id = sqlc.narg(session_id)::uuid)
+  AND (sqlc.narg(advisor_id)::uuid IS NULL OR s.advisor_id = sqlc.narg(advisor_id)::uuid)
+  AND (sqlc.narg(sent_from)::timestamptz IS NULL OR m.created_at >= sqlc.narg(sent_from)::timestamptz)
+  AND (sqlc.narg(sent_to)::timestamptz IS NULL OR m.created_at < sqlc.narg(sent_to)::timestamptz)
+  AND (sqlc.narg(before_id)::uuid IS NULL OR (m.created_at, m.id) < (sqlc.narg(before_at)::timestamptz, sqlc.narg(before_id)::uuid))
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: FullTextSearchMessages :many
+-- Messages of the member's sessions matching a web-search style query, newest first, used
+-- while content is stored as plaintext. The snippet marks matches with \x02 and \x03.
+SELECT sqlc.embed(m), s.advisor_id AS session_advisor_id,
+  ts_headline('english', m.content, q.query, 'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=20, MinWords=8')::text AS snippet
+FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+CROSS JOIN websearch_to_tsquery('english', sqlc.arg(query)::text) AS q(query)
+WHERE (s.user_id = sqlc.arg(member_id) OR s.advisor_id = sqlc.arg(member_id))
+  AND m.deleted_at IS NULL
+  AND to_tsvector('english', m.content) @@ q.query
+  AND (sqlc.narg(session_id)::uuid IS NULL OR m.session_id = sqlc.narg(session_id)::uuid)
+  AND (sqlc.narg(advisor_id)::uuid IS NULL OR s.advisor_id = sqlc.narg(advisor_id)::uuid)
+  AND (sqlc.narg(sent_from)::timestamptz IS NULL OR m.created_at >= sqlc.narg(sent_from)::timestamptz)
+  AND (sqlc.narg(sent_to)::timestamptz IS NULL OR m.created_at < sqlc.narg(sent_to)::timestamptz)
+  AND (sqlc.narg(before_id)::uuid IS NULL OR (m.created_at, m.id) < (sqlc.narg(before_at)::timestamptz, sqlc.narg(before_id)::uuid))
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT sqlc.arg(max_results);
+
+-- name: StartSessionBilling :execrows
+-- Paid chats start billing with the advisor's first reply
+UPDATE sessions SET billing_started_at = NOW()
+WHERE id = $1
+  AND status = 'ONGOING'
+  AND type = 'CHAT'
+  AND billing_started_at IS NULL
+  AND price_per_minute > 0
+  AND COALESCE(discount_percent, 0) < 100;
+
+-- name: ListDueSessionCharges :many
+-- Metered chats with started minutes not charged yet, at least the minimum billable minutes
+SELECT d.*, COALESCE(b.balance, 0)::text AS balance
+FROM (
+    SELECT s.id, s.user_id, s.advisor_id, s.currency, s.billed_minutes, s.billed_amount::text AS billed_amount,
+           ROUND(s.price_per_minute * (100 - COALESCE(s.discount_percent, 0)) / 100, 2)::text AS minute_price,
+           GREATEST(CEIL(EXTRACT(EPOCH FROM (NOW() - s.billing_started_at)) / 60), COALESCE(s.min_billable_minutes, 0))::INTEGER AS due_minutes
+    FROM sessions s
+    WHERE s.status = 'ONGOING'
+      AND s.billing_started_at IS NOT NULL
+      AND (sqlc.narg(session_id)::uuid IS NULL OR s.id = sqlc.narg(session_id))
+) d
+LEFT JOIN user_balances b ON b.user_id = d.user_id
+WHERE d.due_minutes > d.billed_minutes;
+
+-- name: ChargeSessionMinute :one
+-- Charges the minute after billed_minutes if nobody charged it yet and the balance covers it.
+-- The session and the balance are locked first, so a concurrent charge of either is seen
+-- and the minute is claimed only together with its charge.
+WITH due AS (
+    SELECT s.id FROM sessions s
+    JOIN user_balances b ON b.user_id = s.user_id
+    WHERE s.id = sqlc.arg(session_id)
+      AND s.status = 'ONGOING'
+      AND s.billed_minutes = sqlc.arg(billed_minutes)
+      AND b.balance >= sqlc.arg(amount)::numeric
+    FOR UPDATE OF s, b
+), claimed AS (
+    UPDATE sessions
+    SET billed_minutes = billed_minutes + 1, billed_amount = billed_amount + sqlc.arg(amount)::numeric
+    FROM due
+    WHERE sessions.id = due.id
+    RETURNING sessions.id, sessions.user_id, sessions.billed_minutes, sessions.billed_amount
+), charged AS (
+    UPDATE user_balances
+    SET balance = balance - sqlc.arg(amount)::numeric, updated_at = NOW()
+    FROM claimed
+    WHERE user_balances.user_id = claimed.user_id
+      AND user_balances.balance >= sqlc.arg(amount)::numeric
+    RETURNING user_balances.user_id, user_balances.balance
+), recorded AS (
+    INSERT INTO balance_transactions (user_id, kind, amount, balance_after, session_id)
+    SELECT charged.user_id, 'CHARGE', -sqlc.arg(amount)::numeric, charged.balance, claimed.id
+    FROM charged, claimed
+)
+SELECT claimed.billed_minutes, claimed.billed_amount::text AS billed_amount, charged.balance::text AS balance
+FROM claimed, charged;
+
+-- name: EndSessionForFunds :one
+UPDATE sessions SET status = 'ENDED', ended_at = NOW()
+WHERE id = $1 AND status = 'ONGOING'
+RETURNING *;
@@ -0,0 +1,110 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"loveguru/internal/cache"
+)
+
+const (
+	// redisEventsChannel carries the events of every session; each instance delivers
+	// the ones for sessions it has clients in
+	redisEventsChannel = "chat:events"
+	// redisPresencePrefix prefixes a sorted set per session of "userID|connID" members
+	// scored by their last heartbeat
+	redisPresencePrefix = "chat:presence:"
+)
+
+// RedisBackend fans events out over Redis pub/sub and keeps presence in Redis so
+// several server instances can share chat sessions
+type RedisBackend struct {
+	cache *cache.Cache
+}
+
+func NewRedisBackend(cache *cache.Cache) *RedisBackend {
+	return &RedisBackend{cache: cache}
+}
+
+func (b *RedisBackend) Publish(ctx context.Context, event Event) error {
+	return b.cache.Publish(ctx, redisEventsChannel, event)
+}
+
+func (b *RedisBackend) Subscribe(ctx context.Context) <-chan Event {
+	out := make(chan Event, 256)
+	pubsub := b.cache.Subscribe(ctx, redisEventsChannel)
+
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				var event Event
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					log.Printf("Error decoding chat event: %v", err)
+					continue
+				}
+
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+func (b *RedisBackend) Join(ctx context.Context, sessionID, userID, connID string) error {
+	key := redisPresencePrefix + sessionID
+	if err := b.cache.ZAdd(ctx, key, userID+"|"+connID, float64(time.Now().Unix())); err != nil {
+		return err
+	}
+	// The whole set disappears once every connection to the session has gone quiet
+	return b.cache.Expire(ctx, key, 2*presenceTTL)
+}
+
+func (b *RedisBackend) Leave(ctx context.Context, sessionID, userID, connID string) error {
+	return b.cache.ZRem(ctx, redisPresencePrefix+sessionID, userID+"|"+connID)
+}
+
+func (b *RedisBackend) Present(ctx context.Context, sessionID string) ([]string, error) {
+	key := redisPresencePrefix + sessionID
+	cutoff := strconv.FormatInt(time.Now().Add(-presenceTTL).Unix(), 10)
+
+	// Drop connections of instances that stopped sending heartbeats
+	if err := b.cache.ZRemRangeByScore(ctx, key, "-inf", "("+cutoff); err != nil {
+		return nil, err
+	}
+
+	members, err := b.cache.ZRangeByScore(ctx, key, cutoff, "+inf")
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	var users []string
+	for _, m := range members {
+		userID, _, _ := strings.Cut(m, "|")
+		if _, ok := seen[userID]; !ok {
+			seen[userID] = struct{}{}
+			users = append(users, userID)
+		}
+	}
+	return users, nil
+}
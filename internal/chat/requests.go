@@ -0,0 +1,242 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"loveguru/internal/advisor"
+	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+	"loveguru/internal/grpc/middleware"
+	"loveguru/proto/chat"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+// lifecycleInterval is how often unanswered requests, paid chats and idle chats are checked
+const lifecycleInterval = 15 * time.Second
+
+func (s *Service) ListSessionRequests(ctx context.Context, req *chat.ListSessionRequestsRequest) (*chat.ListSessionRequestsResponse, error) {
+	aid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.ListSessionRequests(ctx, uuid.NullUUID{UUID: aid, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &chat.ListSessionRequestsResponse{}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, mapSession(session))
+	}
+	return resp, nil
+}
+
+func (s *Service) AcceptSession(ctx context.Context, req *chat.AcceptSessionRequest) (*chat.AcceptSessionResponse, error) {
+	aid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sid, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.AcceptSessionRequest(ctx, db.AcceptSessionRequestParams{
+		ID:        sid,
+		AdvisorID: uuid.NullUUID{UUID: aid, Valid: true},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("no pending request, it may have expired or been withdrawn")
+		}
+		return nil, err
+	}
+
+	s.pushSessionUpdate(ctx, session, "accepted")
+
+	return &chat.AcceptSessionResponse{Session: mapSession(session)}, nil
+}
+
+func (s *Service) DeclineSession(ctx context.Context, req *chat.DeclineSessionRequest) (*chat.DeclineSessionResponse, error) {
+	aid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sid, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.DeclineSessionRequest(ctx, db.DeclineSessionRequestParams{
+		ID:        sid,
+		AdvisorID: uuid.NullUUID{UUID: aid, Valid: true},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("no pending request, it may have expired or been withdrawn")
+		}
+		return nil, err
+	}
+
+	s.capacity.Sync(ctx, aid)
+	s.pushSessionUpdate(ctx, session, "rejected")
+
+	return &chat.DeclineSessionResponse{Success: true}, nil
+}
+
+// Run expires unanswered session requests, charges paid chats and ends idle chats until
+// ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(lifecycleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.expireRequests(ctx)
+			s.meterSessions(ctx)
+			s.endIdleSessions(ctx)
+		}
+	}
+}
+
+// expireRequests frees users whose advisor did not answer within the request timeout
+func (s *Service) expireRequests(ctx context.Context) {
+	expired, err := s.repo.ExpireSessionRequests(ctx, sql.NullTime{Time: time.Now().Add(-s.requestTimeout), Valid: true})
+	if err != nil {
+		log.Printf("Error expiring session requests: %v", err)
+		return
+	}
+
+	for _, session := range expired {
+		s.capacity.Sync(ctx, session.AdvisorID.UUID)
+		s.pushSessionUpdate(ctx, session, "expired")
+	}
+}
+
+// endIdleSessions ends advisor chats in which nobody has written for the idle timeout
+func (s *Service) endIdleSessions(ctx context.Context) {
+	if s.idleTimeout <= 0 {
+		return
+	}
+
+	idleSince := sql.NullTime{Time: time.Now().Add(-s.idleTimeout), Valid: true}
+	idle, err := s.repo.ListIdleSessions(ctx, idleSince)
+	if err != nil {
+		log.Printf("Error listing idle sessions: %v", err)
+		return
+	}
+
+	for _, candidate := range idle {
+		// Paid chats are charged for the minute that is under way, which needs them ongoing
+		s.settleSession(ctx, candidate.ID)
+
+		session, err := s.repo.EndIdleSession(ctx, db.EndIdleSessionParams{ID: candidate.ID, IdleSince: idleSince})
+		if err != nil {
+			if !db.IsNotFound(err) {
+				log.Printf("Error ending idle session %s: %v", candidate.ID, err)
+			}
+			continue
+		}
+
+		log.Printf("Ended idle session %s", session.ID)
+		s.ledger.RecordSession(ctx, session.ID)
+		s.capacity.Sync(ctx, session.AdvisorID.UUID)
+		s.pushSessionUpdate(ctx, session, "ended")
+	}
+}
+
+// pushSessionRequest asks the advisor to answer a new request. Failures are logged;
+// the advisor still sees the request in ListSessionRequests.
+func (s *Service) pushSessionRequest(ctx context.Context, session db.Session) {
+	if s.notifier == nil {
+		return
+	}
+
+	deviceTokens := s.deviceTokens(ctx, session.AdvisorID.UUID)
+	if len(deviceTokens) == 0 {
+		return
+	}
+
+	userName := "A user"
+	if u, err := s.repo.GetUserByID(ctx, session.UserID); err == nil {
+		userName = u.DisplayName
+	}
+
+	err := s.notifier.SendSessionRequestNotification(deviceTokens, userName, session.ID.String(), s.requestTimeout)
+	if err != nil {
+		log.Printf("Error sending session request notification to %s: %v", session.AdvisorID.UUID, err)
+	}
+}
+
+// pushSessionUpdate tells the user what happened to their session with the advisor
+func (s *Service) pushSessionUpdate(ctx context.Context, session db.Session, action string) {
+	if s.notifier == nil {
+		return
+	}
+
+	deviceTokens := s.deviceTokens(ctx, session.UserID)
+	if len(deviceTokens) == 0 {
+		return
+	}
+
+	advisorName := "Your advisor"
+	if u, err := s.repo.GetUserByID(ctx, session.AdvisorID.UUID); err == nil {
+		advisorName = u.DisplayName
+	}
+
+	if err := s.notifier.SendSessionUpdateNotification(deviceTokens, advisorName, session.ID.String(), action); err != nil {
+		log.Printf("Error sending session notification to %s: %v", session.UserID, err)
+	}
+}
+
+func (s *Service) deviceTokens(ctx context.Context, userID uuid.UUID) []string {
+	tokens, err := s.repo.GetUserDeviceTokens(ctx, userID)
+	if err != nil {
+		log.Printf("Error loading device tokens for %s: %v", userID, err)
+		return nil
+	}
+
+	var deviceTokens []string
+	if tokens.FcmToken.Valid {
+		deviceTokens = append(deviceTokens, tokens.FcmToken.String)
+	}
+	if tokens.ApnsToken.Valid {
+		deviceTokens = append(deviceTokens, tokens.ApnsToken.String)
+	}
+	return deviceTokens
+}
+
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errors.New("unauthenticated")
+	}
+	return uuid.Parse(userInfo.ID)
+}
+
+func mapSession(session db.Session) *common.Session {
+	return &common.Session{
+		Id:            session.ID.String(),
+		UserId:        session.UserID.String(),
+		AdvisorId:     session.AdvisorID.UUID.String(),
+		Type:          common.SessionType(common.SessionType_value[session.Type]),
+		StartedAt:     session.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
+		EndedAt:       session.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
+		Status:        common.SessionStatus(common.SessionStatus_value[session.Status.String]),
+		Pricing:       advisor.MapSessionPricing(session),
+		BilledMinutes: session.BilledMinutes,
+		BilledAmount:  earnings.ParseAmount(session.BilledAmount),
+	}
+}
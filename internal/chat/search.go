@@ -0,0 +1,270 @@
+package chat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"loveguru/internal/db"
+	"loveguru/internal/encryption"
+	"loveguru/proto/chat"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSearchPage = 20
+	maxSearchPage     = 50
+	maxSearchQuery    = 200
+	maxSearchWords    = 10
+
+	// snippetContext is how many characters around the first match a snippet keeps
+	// on each side
+	snippetContext = 60
+)
+
+// Markers the full-text search query puts around matches in snippets
+const (
+	highlightStart = '\x02'
+	highlightEnd   = '\x03'
+)
+
+// SearchMessages finds messages in the caller's own sessions, as the user or as the
+// advisor, newest first. Pages continue from the last result, like history.
+//
+// When message content is encrypted, messages are found by keyed hashes of their words
+// rather than by the database's full-text search: a message matches when it contains
+// every word of the query, whole words and case-insensitive. Plaintext content is
+// searched with the full-text index.
+func (s *Service) SearchMessages(ctx context.Context, req *chat.SearchMessagesRequest) (*chat.SearchMessagesResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, errors.New("query is required")
+	}
+	if len(query) > maxSearchQuery {
+		return nil, fmt.Errorf("query must be at most %d characters", maxSearchQuery)
+	}
+
+	limit := pageSize(req.Limit, defaultSearchPage, maxSearchPage)
+	params := db.SearchMessagesParams{MemberID: uid, MaxResults: limit + 1}
+
+	if req.SessionId != "" {
+		sid, err := uuid.Parse(req.SessionId)
+		if err != nil {
+			return nil, err
+		}
+		params.SessionID = uuid.NullUUID{UUID: sid, Valid: true}
+	}
+	if req.AdvisorId != "" {
+		aid, err := uuid.Parse(req.AdvisorId)
+		if err != nil {
+			return nil, err
+		}
+		params.AdvisorID = uuid.NullUUID{UUID: aid, Valid: true}
+	}
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+		params.SentFrom = sql.NullTime{Time: from, Valid: true}
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to: %w", err)
+		}
+		params.SentTo = sql.NullTime{Time: to, Valid: true}
+	}
+	if req.PageToken != "" {
+		cursor, err := s.messageCursor(ctx, req.PageToken, uid)
+		if err != nil {
+			return nil, err
+		}
+		params.BeforeID = uuid.NullUUID{UUID: cursor.ID, Valid: true}
+		params.BeforeAt = cursor.CreatedAt
+	}
+
+	if !s.keyring.Enabled() {
+		return s.fullTextSearch(ctx, query, params)
+	}
+
+	words := encryption.Words(query)
+	if len(words) == 0 {
+		return nil, errors.New("query must contain a word")
+	}
+	if len(words) > maxSearchWords {
+		return nil, fmt.Errorf("query must have at most %d words", maxSearchWords)
+	}
+	params.Terms = s.keyring.SearchTerms(query)
+
+	rows, err := s.repo.SearchMessages(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &chat.SearchMessagesResponse{}
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		resp.NextPageToken = rows[len(rows)-1].ChatMessage.ID.String()
+	}
+
+	matching := make(map[string]bool, len(words))
+	for _, w := range words {
+		matching[w] = true
+	}
+
+	for _, row := range rows {
+		msg := row.ChatMessage
+		content, err := s.keyring.Open(ctx, msg.Content, msg.ContentKeyID)
+		if err != nil {
+			return nil, err
+		}
+		msg.Content = content
+
+		snippet, highlights := buildSnippet(content, matching)
+		resp.Results = append(resp.Results, &chat.MessageSearchResult{
+			Message:    mapMessage(msg),
+			AdvisorId:  row.SessionAdvisorID.UUID.String(),
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+
+	return resp, nil
+}
+
+// fullTextSearch runs a search with the same filters over plaintext content, with web
+// search syntax and words matched by their stem
+func (s *Service) fullTextSearch(ctx context.Context, query string, params db.SearchMessagesParams) (*chat.SearchMessagesResponse, error) {
+	rows, err := s.repo.FullTextSearchMessages(ctx, db.FullTextSearchMessagesParams{
+		Query:      query,
+		MemberID:   params.MemberID,
+		SessionID:  params.SessionID,
+		AdvisorID:  params.AdvisorID,
+		SentFrom:   params.SentFrom,
+		SentTo:     params.SentTo,
+		BeforeID:   params.BeforeID,
+		BeforeAt:   params.BeforeAt,
+		MaxResults: params.MaxResults,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &chat.SearchMessagesResponse{}
+	if limit := int(params.MaxResults) - 1; len(rows) > limit {
+		rows = rows[:limit]
+		resp.NextPageToken = rows[len(rows)-1].ChatMessage.ID.String()
+	}
+
+	for _, row := range rows {
+		snippet, highlights := parseSnippet(row.Snippet)
+		resp.Results = append(resp.Results, &chat.MessageSearchResult{
+			Message:    mapMessage(row.ChatMessage),
+			AdvisorId:  row.SessionAdvisorID.UUID.String(),
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+
+	return resp, nil
+}
+
+// parseSnippet removes the match markers from a full-text snippet and returns where they
+// were, in characters
+func parseSnippet(marked string) (string, []*chat.MessageHighlight) {
+	var sb strings.Builder
+	var highlights []*chat.MessageHighlight
+	var current *chat.MessageHighlight
+
+	var n int32
+	for _, r := range marked {
+		switch r {
+		case highlightStart:
+			current = &chat.MessageHighlight{Start: n}
+		case highlightEnd:
+			if current != nil {
+				current.End = n
+				highlights = append(highlights, current)
+				current = nil
+			}
+		default:
+			sb.WriteRune(r)
+			n++
+		}
+	}
+	return sb.String(), highlights
+}
+
+// buildSnippet cuts the part of a message around its first match and returns where the
+// matches are in it, in characters, so clients can highlight them without rendering
+// message content as markup
+func buildSnippet(content string, words map[string]bool) (string, []*chat.MessageHighlight) {
+	runes := []rune(content)
+
+	var matches [][2]int
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsNumber(runes[i])) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 && words[strings.ToLower(string(runes[start:i]))] {
+			matches = append(matches, [2]int{start, i})
+		}
+		start = -1
+	}
+	if len(matches) == 0 {
+		return content, nil
+	}
+
+	from := max(0, matches[0][0]-snippetContext)
+	to := min(len(runes), matches[0][1]+snippetContext)
+	// Do not cut words in half
+	for from > 0 && from < matches[0][0] && !unicode.IsSpace(runes[from-1]) {
+		from++
+	}
+	for to < len(runes) && to > matches[0][1] && !unicode.IsSpace(runes[to]) {
+		to--
+	}
+
+	var sb strings.Builder
+	offset := from
+	if from > 0 {
+		sb.WriteString("…")
+		offset--
+	}
+	sb.WriteString(strings.TrimSpace(string(runes[from:to])))
+	if to < len(runes) {
+		sb.WriteString("…")
+	}
+
+	// Trimming moves the text after leading spaces
+	for from < matches[0][0] && unicode.IsSpace(runes[from]) {
+		from++
+		offset++
+	}
+
+	var highlights []*chat.MessageHighlight
+	for _, m := range matches {
+		if m[0] >= from && m[1] <= to {
+			highlights = append(highlights, &chat.MessageHighlight{
+				Start: int32(m[0] - offset),
+				End:   int32(m[1] - offset),
+			})
+		}
+	}
+	return sb.String(), highlights
+}
@@ -4,11 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"log"
+	"sync"
+	"time"
 
+	"loveguru/internal/advisor"
+	"loveguru/internal/config"
 	"loveguru/internal/db"
+	"loveguru/internal/earnings"
+	"loveguru/internal/encryption"
 	"loveguru/internal/grpc/middleware"
+	"loveguru/internal/moderation"
 	"loveguru/internal/notifications"
+	"loveguru/internal/storage"
+	"loveguru/internal/transcript"
 	"loveguru/proto/chat"
 	"loveguru/proto/common"
 
@@ -16,11 +24,40 @@ import (
 )
 
 type Service struct {
-	repo *db.Queries
+	repo        *db.Queries
+	ledger      *earnings.Ledger
+	capacity    *advisor.Capacity
+	notifier    *notifications.NotificationService
+	blobs       storage.BlobStore  // nil when attachments are unavailable
+	urls        *storage.URLSigner // signs attachment download links
+	presence    Backend            // who is connected, so only offline participants are pushed; also carries balance updates
+	moderation  *moderation.Filter // screens messages before they are stored, nil to store as sent
+	transcripts *transcript.Exporter
+	keyring     *encryption.Keyring // encrypts message content, nil to store it as sent
+
+	pushLock      sync.Mutex
+	pendingPushes map[pushKey]*pendingPush
+
+	requestTimeout time.Duration
+	idleTimeout    time.Duration // zero disables ending idle chats
 }
 
-func NewService(repo *db.Queries) *Service {
-	return &Service{repo: repo}
+func NewService(repo *db.Queries, ledger *earnings.Ledger, capacity *advisor.Capacity, notifier *notifications.NotificationService, cfg *config.SessionsConfig, blobs storage.BlobStore, urls *storage.URLSigner, presence Backend, filter *moderation.Filter, keyring *encryption.Keyring) *Service {
+	return &Service{
+		repo:           repo,
+		ledger:         ledger,
+		capacity:       capacity,
+		notifier:       notifier,
+		blobs:          blobs,
+		urls:           urls,
+		presence:       presence,
+		moderation:     filter,
+		transcripts:    transcript.NewExporter(repo, keyring),
+		keyring:        keyring,
+		pendingPushes:  make(map[pushKey]*pendingPush),
+		requestTimeout: time.Duration(cfg.RequestTimeout) * time.Second,
+		idleTimeout:    time.Duration(cfg.IdleTimeout) * time.Minute,
+	}
 }
 
 func (s *Service) CreateSession(ctx context.Context, req *chat.CreateSessionRequest) (*chat.CreateSessionResponse, error) {
@@ -43,32 +80,61 @@ func (s *Service) CreateSession(ctx context.Context, req *chat.CreateSessionRequ
 		advisorID = uuid.NullUUID{UUID: aid, Valid: true}
 	}
 
-	session, err := s.repo.CreateSession(ctx, db.CreateSessionParams{
-		UserID:    uid,
-		AdvisorID: advisorID,
-		Type:      req.Type.String(),
-	})
+	// Sessions handed off from an AI chat are billed at the advisor's AI handoff price
+	pricingType := advisor.PricingChat
+	if req.HandoffSessionId != "" {
+		hid, err := uuid.Parse(req.HandoffSessionId)
+		if err != nil {
+			return nil, err
+		}
+		handoff, err := s.repo.GetSessionByID(ctx, hid)
+		if err != nil {
+			return nil, err
+		}
+		if handoff.UserID != uid || handoff.Type != common.SessionType_AI_CHAT.String() {
+			return nil, errors.New("handoff session must be one of your AI chat sessions")
+		}
+		if !advisorID.Valid {
+			return nil, errors.New("advisor_id is required for a handoff")
+		}
+		pricingType = advisor.PricingAIHandoff
+	}
+
+	// Advisor sessions wait for the advisor to accept; billing starts then
+	params := db.CreateSessionParams{
+		UserID:      uid,
+		AdvisorID:   advisorID,
+		Type:        req.Type.String(),
+		Status:      common.SessionStatus_ONGOING.String(),
+		PricingType: pricingType,
+	}
+	if advisorID.Valid {
+		params.Status = common.SessionStatus_REQUESTED.String()
+	}
+
+	var session db.Session
+	if advisorID.Valid {
+		session, err = s.capacity.Reserve(ctx, advisorID.UUID, params.Type, func(q *db.Queries) (db.Session, error) {
+			return q.CreateSession(ctx, params)
+		})
+	} else {
+		session, err = s.repo.CreateSession(ctx, params)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &chat.CreateSessionResponse{
-		Session: &common.Session{
-			Id:        session.ID.String(),
-			UserId:    session.UserID.String(),
-			AdvisorId: session.AdvisorID.UUID.String(),
-			Type:      common.SessionType(common.SessionType_value[session.Type]),
-			StartedAt: session.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
-			EndedAt:   session.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
-			Status:    common.SessionStatus(common.SessionStatus_value[session.Status.String]),
-		},
-	}, nil
+	if session.Status.String == common.SessionStatus_REQUESTED.String() {
+		s.pushSessionRequest(ctx, session)
+	}
+
+	return &chat.CreateSessionResponse{Session: mapSession(session)}, nil
 }
 
 func (s *Service) GetMessages(ctx context.Context, req *chat.GetMessagesRequest) (*chat.GetMessagesResponse, error) {
-	_, ok := middleware.GetUserFromContext(ctx)
-	if !ok {
-		return nil, errors.New("unauthenticated")
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	sid, err := uuid.Parse(req.SessionId)
@@ -76,49 +142,118 @@ func (s *Service) GetMessages(ctx context.Context, req *chat.GetMessagesRequest)
 		return nil, err
 	}
 
-	messages, err := s.repo.GetMessages(ctx, db.GetMessagesParams{
-		SessionID: sid,
-		Limit:     int32(req.Limit),
-		Offset:    int32(req.Offset),
-	})
+	// Messages carry signed attachment links, so only participants may read them
+	session, err := s.repo.GetSessionByID(ctx, sid)
 	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("session not found")
+		}
 		return nil, err
 	}
+	if session.UserID != uid && session.AdvisorID.UUID != uid {
+		return nil, errors.New("unauthorized")
+	}
+
+	limit := pageSize(req.Limit, defaultHistoryPage, maxHistoryPage)
 
-	var msgs []*common.ChatMessage
-	for _, m := range messages {
-		msgs = append(msgs, &common.ChatMessage{
-			Id:         m.ID.String(),
-			SessionId:  m.SessionID.String(),
-			SenderType: m.SenderType,
-			SenderId:   m.SenderID.String(),
-			Content:    m.Content,
-			CreatedAt:  m.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
-			IsRead:     m.IsRead.Bool,
+	var messages []db.ChatMessage
+	var hasMore bool
+	switch {
+	case req.BeforeMessageId != "" && req.AfterMessageId != "":
+		return nil, errors.New("set only one of before_message_id and after_message_id")
+	case req.BeforeMessageId != "":
+		cursor, err := s.sessionCursor(ctx, sid, req.BeforeMessageId, uid)
+		if err != nil {
+			return nil, err
+		}
+		messages, hasMore, err = s.messagesBefore(ctx, sid, &cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+	case req.AfterMessageId != "":
+		cursor, err := s.sessionCursor(ctx, sid, req.AfterMessageId, uid)
+		if err != nil {
+			return nil, err
+		}
+		messages, hasMore, err = s.messagesAfter(ctx, sid, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+	case req.Latest:
+		messages, hasMore, err = s.messagesBefore(ctx, sid, nil, limit)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		// Offset paging is kept for older clients
+		messages, err = s.repo.GetMessages(ctx, db.GetMessagesParams{
+			SessionID: sid,
+			Limit:     limit + 1,
+			Offset:    req.Offset,
 		})
+		if err != nil {
+			return nil, err
+		}
+		if hasMore = len(messages) > int(limit); hasMore {
+			messages = messages[:limit]
+		}
+		if err := s.openMessages(ctx, messages); err != nil {
+			return nil, err
+		}
 	}
 
-	return &chat.GetMessagesResponse{Messages: msgs}, nil
+	msgs, err := s.mapMessages(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chat.GetMessagesResponse{Messages: msgs, HasMore: hasMore}, nil
 }
 
-func (s *Service) InsertMessage(ctx context.Context, sessionID, senderType, senderID, content string) error {
-	sid, err := uuid.Parse(sessionID)
+func (s *Service) EndSession(ctx context.Context, req *chat.EndSessionRequest) (*chat.EndSessionResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("unauthenticated")
+	}
+
+	sid, err := uuid.Parse(req.SessionId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	senderUUID, err := uuid.Parse(senderID)
+	session, err := s.repo.GetSessionByID(ctx, sid)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = s.repo.InsertMessage(ctx, db.InsertMessageParams{
-		SessionID:  sid,
-		SenderType: senderType,
-		SenderID:   senderUUID,
-		Content:    content,
-	})
-	return err
+	if session.UserID.String() != userInfo.ID && session.AdvisorID.UUID.String() != userInfo.ID {
+		return nil, errors.New("unauthorized")
+	}
+
+	// A user may withdraw a request the advisor has not answered yet
+	if session.Status.String == common.SessionStatus_REQUESTED.String() {
+		if session.UserID.String() != userInfo.ID {
+			return nil, errors.New("use DeclineSession to decline a session request")
+		}
+		if _, err := s.repo.WithdrawSessionRequest(ctx, db.WithdrawSessionRequestParams{ID: sid, UserID: session.UserID}); err != nil {
+			if db.IsNotFound(err) {
+				return nil, errors.New("session request was already answered")
+			}
+			return nil, err
+		}
+		s.capacity.Sync(ctx, session.AdvisorID.UUID)
+		return &chat.EndSessionResponse{Success: true}, nil
+	}
+
+	if session.Status.String != "ONGOING" {
+		return nil, errors.New("session is not active")
+	}
+
+	if err := s.UpdateSessionStatus(ctx, req.SessionId); err != nil {
+		return nil, err
+	}
+
+	return &chat.EndSessionResponse{Success: true}, nil
 }
 
 func (s *Service) UpdateSessionStatus(ctx context.Context, sessionID string) error {
@@ -127,75 +262,254 @@ func (s *Service) UpdateSessionStatus(ctx context.Context, sessionID string) err
 		return err
 	}
 
-	return s.repo.UpdateSessionStatus(ctx, db.UpdateSessionStatusParams{
+	// Paid chats are charged for the minute that is under way
+	s.settleSession(ctx, sid)
+
+	err = s.repo.UpdateSessionStatus(ctx, db.UpdateSessionStatusParams{
 		ID:     sid,
 		Status: sql.NullString{String: "ENDED", Valid: true},
 	})
-}
+	if err != nil {
+		return err
+	}
 
-func (s *Service) InsertMessageWithID(ctx context.Context, sessionID, senderType, senderID, content string) (string, error) {
-	return "", errors.New("not implemented")
-}
+	s.ledger.RecordSession(ctx, sid)
 
-func (s *Service) UpdateMessageReadStatus(ctx context.Context, messageID, readerID string) error {
-	return errors.New("not implemented")
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		return err
+	}
+	if session.AdvisorID.Valid {
+		s.capacity.Sync(ctx, session.AdvisorID.UUID)
+	}
+	return nil
 }
 
-func (s *Service) GetSessionParticipants(ctx context.Context, sessionID string) ([]string, error) {
-	return nil, errors.New("not implemented")
-}
+// InsertMessageWithID stores a message and returns its ID and the content as stored,
+// which moderation may have masked. clientMessageID is the ID the sender's client gave
+// the message, if any; it is unique within the session. Only participants of an ongoing
+// session can send to it. Whether the sender is the user or the advisor is taken from the
+// session, so advisor messages start billing and are attributed to the advisor.
+func (s *Service) InsertMessageWithID(ctx context.Context, sessionID, senderID, content, clientMessageID string) (string, string, error) {
+	session, senderUUID, err := s.activeSessionFor(ctx, sessionID, senderID)
+	if err != nil {
+		return "", "", err
+	}
+	sid := session.ID
 
-func (s *Service) UpdateSessionStatusWithNotification(ctx context.Context, sessionID, status, userID string) error {
-	return errors.New("not implemented")
+	senderType := "USER"
+	if session.AdvisorID.Valid && session.AdvisorID.UUID == senderUUID {
+		senderType = "ADVISOR"
+	}
+
+	screened, err := s.moderate(content)
+	if err != nil {
+		return "", "", err
+	}
+
+	sealed, keyID, terms, err := s.sealContent(screened.Content)
+	if err != nil {
+		return "", "", err
+	}
+
+	id, err := s.repo.InsertMessageWithID(ctx, db.InsertMessageWithIDParams{
+		SessionID:       sid,
+		SenderType:      senderType,
+		SenderID:        senderUUID,
+		Content:         sealed,
+		ContentKeyID:    keyID,
+		SearchTerms:     terms,
+		ClientMessageID: sql.NullString{String: clientMessageID, Valid: clientMessageID != ""},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	s.flagMessage(ctx, db.ChatMessage{ID: id, SessionID: sid, SenderType: senderType, SenderID: senderUUID}, screened.Flagged)
+	s.startBilling(ctx, sid, senderType)
+
+	return id.String(), screened.Content, nil
 }
 
-func (s *Service) GetActiveSessions(ctx context.Context, userID string) ([]db.Session, error) {
-	return nil, errors.New("not implemented")
+// SendTemplateMessage expands one of the advisor's saved replies or a platform template
+// for the session and stores it as an advisor message. It returns the message ID and
+// the expanded content as stored.
+func (s *Service) SendTemplateMessage(ctx context.Context, sessionID, senderID, templateID, clientMessageID string) (string, string, error) {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return "", "", err
+	}
+
+	tid, err := uuid.Parse(templateID)
+	if err != nil {
+		return "", "", err
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		return "", "", err
+	}
+	if !session.AdvisorID.Valid || session.AdvisorID.UUID.String() != senderID {
+		return "", "", errors.New("only the session's advisor can send templates")
+	}
+	if session.Status.String != "ONGOING" {
+		return "", "", errors.New("session is not active")
+	}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, session.AdvisorID.UUID)
+	if err != nil {
+		return "", "", err
+	}
+
+	tmpl, err := s.repo.GetTemplateForAdvisor(ctx, db.GetTemplateForAdvisorParams{
+		ID:        tid,
+		AdvisorID: uuid.NullUUID{UUID: a.ID, Valid: true},
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return "", "", errors.New("template not found")
+		}
+		return "", "", err
+	}
+
+	userName, err := s.getUserDisplayName(ctx, session.UserID.String())
+	if err != nil {
+		return "", "", err
+	}
+	advisorName, err := s.getUserDisplayName(ctx, senderID)
+	if err != nil {
+		return "", "", err
+	}
+
+	content := advisor.RenderTemplate(tmpl.Body, map[string]string{
+		advisor.TemplateVarUserName:    userName,
+		advisor.TemplateVarAdvisorName: advisorName,
+	})
+
+	return s.SendMessageWithNotification(ctx, sessionID, senderID, content, clientMessageID)
 }
 
-func (s *Service) GetSessionAnalytics(ctx context.Context, userID string) (*SessionAnalytics, error) {
-	return nil, errors.New("not implemented")
+// UpdateMessageReadStatus moves the reader's watermark in the session up to the message
+// and marks everything the other participant sent until then as read. It reports false
+// when the reader had already read past the message.
+func (s *Service) UpdateMessageReadStatus(ctx context.Context, sessionID, messageID, readerID string) (bool, error) {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	mid, err := uuid.Parse(messageID)
+	if err != nil {
+		return false, err
+	}
+
+	rid, err := uuid.Parse(readerID)
+	if err != nil {
+		return false, err
+	}
+
+	msg, err := s.repo.GetMessageForReader(ctx, db.GetMessageForReaderParams{ID: mid, SessionID: sid, ReaderID: rid})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return false, errors.New("message not found")
+		}
+		return false, err
+	}
+
+	_, err = s.repo.UpsertReadReceipt(ctx, db.UpsertReadReceiptParams{
+		SessionID:         sid,
+		UserID:            rid,
+		LastReadMessageID: uuid.NullUUID{UUID: mid, Valid: true},
+		LastReadAt:        msg.CreatedAt.Time,
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	_, err = s.repo.UpdateMessageReadStatus(ctx, db.UpdateMessageReadStatusParams{
+		SessionID: sid,
+		ReaderID:  rid,
+		ReadUntil: msg.CreatedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
-// sendPushNotificationForMessage sends push notifications to other session participants
-func (s *Service) sendPushNotificationForMessage(ctx context.Context, sessionID, senderID, content string) {
-	// Get device tokens for other participants
-	deviceTokens, err := s.getDeviceTokensForSession(sessionID, senderID)
+func (s *Service) GetUnreadCounts(ctx context.Context, req *chat.GetUnreadCountsRequest) (*chat.GetUnreadCountsResponse, error) {
+	uid, err := currentUserID(ctx)
 	if err != nil {
-		log.Printf("Error getting device tokens: %v", err)
-		return
+		return nil, err
 	}
 
-	if len(deviceTokens) == 0 {
-		return // No devices to notify
+	var sessionID uuid.NullUUID
+	if req.SessionId != "" {
+		sid, err := uuid.Parse(req.SessionId)
+		if err != nil {
+			return nil, err
+		}
+		sessionID = uuid.NullUUID{UUID: sid, Valid: true}
 	}
 
-	// Get sender name for notification
-	senderName, err := s.getUserDisplayName(ctx, senderID)
+	counts, err := s.repo.GetUnreadCounts(ctx, db.GetUnreadCountsParams{UserID: uid, SessionID: sessionID})
 	if err != nil {
-		log.Printf("Error getting sender name: %v", err)
-		senderName = "Someone"
+		return nil, err
+	}
+
+	resp := &chat.GetUnreadCountsResponse{}
+	for _, c := range counts {
+		unread := &chat.SessionUnreadCount{
+			SessionId:   c.SessionID.String(),
+			UnreadCount: c.UnreadCount,
+		}
+		if c.LastReadMessageID.Valid {
+			unread.LastReadMessageId = c.LastReadMessageID.UUID.String()
+		}
+		resp.Sessions = append(resp.Sessions, unread)
+		resp.Total += c.UnreadCount
 	}
 
-	// Prepare message content (truncate if too long)
-	notificationContent := content
-	if len(notificationContent) > 50 {
-		notificationContent = notificationContent[:50] + "..."
+	return resp, nil
+}
+
+// GetSessionParticipants returns the user IDs taking part in the session: the user and,
+// unless it is an AI chat, the advisor
+func (s *Service) GetSessionParticipants(ctx context.Context, sessionID string) ([]string, error) {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Send push notification
-	notificationService := notifications.NewNotificationService()
-	err = notificationService.SendChatNotification(deviceTokens, senderName, notificationContent, sessionID)
+	rows, err := s.repo.GetSessionParticipants(ctx, sid)
 	if err != nil {
-		log.Printf("Error sending push notification: %v", err)
+		return nil, err
+	}
+
+	var participants []string
+	for _, r := range rows {
+		participants = append(participants, r.UserID.String())
+		if r.AdvisorID.Valid {
+			participants = append(participants, r.AdvisorID.UUID.String())
+		}
 	}
+	return participants, nil
+}
+
+func (s *Service) UpdateSessionStatusWithNotification(ctx context.Context, sessionID, status, userID string) error {
+	return errors.New("not implemented")
+}
+
+func (s *Service) GetActiveSessions(ctx context.Context, userID string) ([]db.Session, error) {
+	return nil, errors.New("not implemented")
 }
 
-// getDeviceTokensForSession gets device tokens for all participants except the sender
-func (s *Service) getDeviceTokensForSession(sessionID, excludeUserID string) ([]string, error) {
-	// This would need to be implemented with proper queries
-	// For now, return empty slice - to be implemented with the database queries
-	return []string{}, nil
+func (s *Service) GetSessionAnalytics(ctx context.Context, userID string) (*SessionAnalytics, error) {
+	return nil, errors.New("not implemented")
 }
 
 // getUserDisplayName gets the display name for a user
@@ -213,18 +527,19 @@ func (s *Service) getUserDisplayName(ctx context.Context, userID string) (string
 	return user.DisplayName, nil
 }
 
-// SendMessageWithNotification sends a message and triggers push notifications
-func (s *Service) SendMessageWithNotification(ctx context.Context, sessionID, senderType, senderID, content string) (string, error) {
+// SendMessageWithNotification sends a message and triggers push notifications. It
+// returns the message ID and the content as stored.
+func (s *Service) SendMessageWithNotification(ctx context.Context, sessionID, senderID, content, clientMessageID string) (string, string, error) {
 	// Insert message and get ID
-	messageID, err := s.InsertMessageWithID(ctx, sessionID, senderType, senderID, content)
+	messageID, stored, err := s.InsertMessageWithID(ctx, sessionID, senderID, content, clientMessageID)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
-	// Send push notification asynchronously
-	go s.sendPushNotificationForMessage(ctx, sessionID, senderID, content)
+	// Participants who are not connected are notified once the sender pauses
+	s.queueMessagePush(sessionID, senderID, stored)
 
-	return messageID, nil
+	return messageID, stored, nil
 }
 
 type SessionAnalytics struct {
@@ -0,0 +1,255 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/proto/chat"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errStreamSession = errors.New("a stream serves the session it was opened for")
+
+// HandleStream serves a gRPC ChatStream as one more client of the hub, so native clients
+// and WebSocket clients of the same session see each other's frames
+func (h *Hub) HandleStream(stream chat.ChatService_ChatStreamServer) error {
+	uid, first, err := openStream(stream)
+	if err != nil {
+		return err
+	}
+	return h.serveStream(stream, uid, first)
+}
+
+// openStream authenticates a ChatStream and reads its first frame, which names the session
+func openStream(stream chat.ChatService_ChatStreamServer) (uuid.UUID, *chat.ChatMessageRequest, error) {
+	uid, err := currentUserID(stream.Context())
+	if err != nil {
+		return uuid.Nil, nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	return uid, first, nil
+}
+
+// serveStream serves an opened ChatStream until either side closes it
+func (h *Hub) serveStream(stream chat.ChatService_ChatStreamServer, uid uuid.UUID, first *chat.ChatMessageRequest) error {
+	if err := h.service.checkParticipant(stream.Context(), first.SessionId, uid); err != nil {
+		return err
+	}
+	if err := h.accepting(); err != nil {
+		if errors.Is(err, errHubFull) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	client := newClient(uuid.New().String(), first.SessionId, uid.String(), first.ResumeAfter, latestProtocol)
+
+	client.Send <- Message{
+		Type:      "CONNECTED",
+		SessionID: client.SessionID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"protocol":      client.Protocol,
+			"connection_id": client.ID,
+		},
+	}
+
+	h.register <- client
+	h.join(client)
+
+	// Frames are read in the background while this goroutine is the only one sending
+	opening := streamFrame(first)
+	done := make(chan struct{})
+	go func() {
+		h.serveFrames(client, func() (Message, error) {
+			if opening.Type != "" {
+				msg := opening
+				opening.Type = ""
+				return msg, nil
+			}
+			for {
+				req, err := stream.Recv()
+				if err != nil {
+					return Message{}, err
+				}
+				frame := streamFrame(req)
+				if req.SessionId != "" && req.SessionId != client.SessionID {
+					h.nack(client, frame, errStreamSession)
+					continue
+				}
+				return frame, nil
+			}
+		})
+		close(done)
+		h.unregister <- client
+		h.leave(client)
+	}()
+
+	for serving := true; serving; {
+		select {
+		case message := <-client.Send:
+			if err := stream.Send(streamResponse(message)); err != nil {
+				return err
+			}
+		case <-client.done:
+			for _, message := range client.pending() {
+				if err := stream.Send(streamResponse(message)); err != nil {
+					return err
+				}
+			}
+			serving = false
+		}
+	}
+	close(client.flushed)
+
+	if client.closeCode == websocket.CloseServiceRestart {
+		return status.Error(codes.Unavailable, errDraining.Error())
+	}
+
+	// The hub closes a client it had to drop for not keeping up
+	select {
+	case <-done:
+		return nil
+	default:
+		return status.Error(codes.Unavailable, "stream closed by the server, reconnect with resume_after")
+	}
+}
+
+// checkParticipant verifies the user takes part in the session
+func (s *Service) checkParticipant(ctx context.Context, sessionID string, userID uuid.UUID) error {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "the first frame must carry a valid session_id")
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return status.Error(codes.NotFound, "session not found")
+		}
+		return err
+	}
+	if session.UserID != userID && session.AdvisorID.UUID != userID {
+		return status.Error(codes.PermissionDenied, errNotParticipant.Error())
+	}
+	return nil
+}
+
+// streamFrame turns a ChatStream request into the frame a WebSocket client would send.
+// A request with nothing to send, such as a first frame that only opens the stream,
+// has no type.
+func streamFrame(req *chat.ChatMessageRequest) Message {
+	frameType := req.Type
+	if frameType == "" {
+		switch {
+		case req.AttachmentId != "":
+			frameType = "ATTACHMENT"
+		case req.Content != "":
+			frameType = "MESSAGE"
+		}
+	}
+
+	data := map[string]interface{}{}
+	if req.MessageId != "" {
+		data["message_id"] = req.MessageId
+	}
+	if req.TemplateId != "" {
+		data["template_id"] = req.TemplateId
+	}
+	if req.AttachmentId != "" {
+		data["attachment_id"] = req.AttachmentId
+	}
+
+	return Message{
+		Type:      frameType,
+		SessionID: req.SessionId,
+		Content:   req.Content,
+		Timestamp: time.Now(),
+		Data:      data,
+		ClientID:  req.ClientId,
+	}
+}
+
+// frameData is everything the frames of the hub carry in Data. Frames relayed by
+// another instance arrive decoded from JSON, so Data is read back through JSON.
+type frameData struct {
+	MessageID    string                 `json:"message_id"`
+	Kind         string                 `json:"kind"`
+	Attachment   *common.ChatAttachment `json:"attachment"`
+	EditedAt     time.Time              `json:"edited_at"`
+	DeletedAt    time.Time              `json:"deleted_at"`
+	Duplicate    bool                   `json:"duplicate"`
+	Error        string                 `json:"error"`
+	ConnectionID string                 `json:"connection_id"`
+	Notice       string                 `json:"notice"`
+	Balance      float64                `json:"balance"`
+	MinutesLeft  int32                  `json:"minutes_left"`
+	Reason       string                 `json:"reason"`
+	RetryAfterMs int64                  `json:"retry_after_ms"`
+	UserID       string                 `json:"user_id"`   // typing indicators
+	ReaderID     string                 `json:"reader_id"` // read receipts
+}
+
+// streamResponse turns a frame of the hub into a ChatStream response
+func streamResponse(msg Message) *chat.ChatMessageResponse {
+	var data frameData
+	if msg.Data != nil {
+		if raw, err := json.Marshal(msg.Data); err == nil {
+			json.Unmarshal(raw, &data)
+		}
+	}
+
+	resp := &chat.ChatMessageResponse{
+		Type:         msg.Type,
+		SessionId:    msg.SessionID,
+		SenderId:     msg.SenderID,
+		MessageId:    data.MessageID,
+		ClientId:     msg.ClientID,
+		Timestamp:    msg.Timestamp.UTC().Format("2006-01-02T15:04:05Z"),
+		Duplicate:    data.Duplicate,
+		Error:        data.Error,
+		ConnectionId: data.ConnectionID,
+		Notice:       data.Notice,
+		Balance:      data.Balance,
+		MinutesLeft:  data.MinutesLeft,
+		Reason:       data.Reason,
+		RetryAfterMs: data.RetryAfterMs,
+	}
+	switch {
+	case data.UserID != "":
+		resp.SenderId = data.UserID
+	case data.ReaderID != "":
+		resp.SenderId = data.ReaderID
+	}
+	if !data.EditedAt.IsZero() {
+		resp.EditedAt = data.EditedAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if !data.DeletedAt.IsZero() {
+		resp.DeletedAt = data.DeletedAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+
+	if msg.Type == "MESSAGE" || msg.Type == "MESSAGE_EDITED" {
+		resp.Message = &chat.ChatMessage{
+			SessionId:  msg.SessionID,
+			Content:    msg.Content,
+			Kind:       data.Kind,
+			Attachment: data.Attachment,
+		}
+		if resp.Message.Kind == "" && msg.Type == "MESSAGE" {
+			resp.Message.Kind = "TEXT"
+		}
+	}
+	return resp
+}
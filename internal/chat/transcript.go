@@ -0,0 +1,88 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"loveguru/internal/db"
+	"loveguru/internal/transcript"
+	"loveguru/proto/chat"
+
+	"github.com/google/uuid"
+)
+
+// ExportTranscript renders one of the caller's sessions for them to keep and, on
+// request, emails it to them
+func (s *Service) ExportTranscript(ctx context.Context, req *chat.ExportTranscriptRequest) (*chat.ExportTranscriptResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sid, err := uuid.Parse(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.GetSessionByID(ctx, sid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("session not found")
+		}
+		return nil, err
+	}
+	if session.UserID != uid && session.AdvisorID.UUID != uid {
+		return nil, errors.New("unauthorized")
+	}
+
+	t, err := s.transcripts.Build(ctx, session, false)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := transcript.Render(t, transcript.Format(req.Format.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &chat.ExportTranscriptResponse{
+		Content:  doc.Content,
+		MimeType: doc.MimeType,
+		Filename: doc.Filename,
+	}
+
+	if req.Email {
+		to, err := s.emailTranscript(ctx, uid, t)
+		if err != nil {
+			return nil, err
+		}
+		resp.EmailedTo = to
+	}
+
+	return resp, nil
+}
+
+// emailTranscript sends the plain text transcript to the user's address and returns it
+func (s *Service) emailTranscript(ctx context.Context, userID uuid.UUID, t *transcript.Transcript) (string, error) {
+	if s.notifier == nil {
+		return "", errors.New("email is not available")
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if !user.Email.Valid || user.Email.String == "" {
+		return "", errors.New("your account has no email address")
+	}
+
+	doc, err := transcript.Render(t, transcript.FormatText)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.notifier.SendEmail(ctx, user.Email.String, "Your LoveGuru session transcript", string(doc.Content)); err != nil {
+		return "", err
+	}
+	return user.Email.String, nil
+}
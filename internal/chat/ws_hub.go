@@ -2,15 +2,21 @@ package chat
 
 import (
 	"context"
+	"errors"
+	"hash/fnv"
 	"log"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"loveguru/internal/db"
+	"loveguru/proto/chat"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Message struct {
@@ -20,6 +26,7 @@ type Message struct {
 	Content   string      `json:"content"`
 	Timestamp time.Time   `json:"timestamp"`
 	Data      interface{} `json:"data,omitempty"`
+	ClientID  string      `json:"client_id,omitempty"` // the sender's ID for a message it sends, echoed in ACK, NACK and the broadcast
 }
 
 type TypingIndicator struct {
@@ -40,26 +47,58 @@ type ReadReceipt struct {
 
 type Client struct {
 	ID        string
-	Conn      *websocket.Conn
+	Conn      *websocket.Conn // nil for a gRPC ChatStream
 	Send      chan Message
 	SessionID string
 	UserID    string
+	// ResumeAfter is the last message the client saw before reconnecting, if any
+	ResumeAfter string
+	// Protocol is the WebSocket protocol version the client speaks; gRPC streams speak the latest
+	Protocol int
+
+	typing      bool // guarded by the hub's clientLock
+	connectedAt time.Time
+	lastActive  atomic.Int64 // when the client last sent a frame, in Unix nanoseconds
+	// closeCode is the WebSocket close code sent once done is closed, if not a normal close.
+	// It is set before done is closed.
+	closeCode int
+	// done is closed once the hub stopped serving the client. Send is never closed, so
+	// frames queued concurrently are dropped instead of panicking.
+	done    chan struct{}
+	flushed chan struct{} // closed once everything queued on Send was written
 }
 
+// Hub serves the WebSocket clients connected to this instance. Everything it broadcasts
+// goes through the backend, so clients of the same session on other instances see it too.
 type Hub struct {
-	clients    map[string]*Client
+	clients    map[string]*Client                       // by connection ID
+	sessions   map[string]map[string]map[string]*Client // session ID -> user ID -> connection ID
 	clientLock sync.RWMutex
-	broadcast  chan Message
+	backend    Backend
 	register   chan *Client
 	unregister chan *Client
 	service    *Service
 	ctx        context.Context
+
+	metrics        *HubMetrics // nil unless the hub is an EnhancedHub
+	maxConnections int         // 0 for no limit
+	draining       atomic.Bool
+	// persisting is held for reading while a frame is handled, so Drain can wait for
+	// what clients sent to be stored
+	persisting sync.RWMutex
 }
 
+// NewHub creates a hub for a single instance
 func NewHub(service *Service) *Hub {
+	return NewHubWithBackend(service, NewMemoryBackend())
+}
+
+// NewHubWithBackend creates a hub that shares sessions with other instances through backend
+func NewHubWithBackend(service *Service, backend Backend) *Hub {
 	return &Hub{
 		clients:    make(map[string]*Client),
-		broadcast:  make(chan Message),
+		sessions:   make(map[string]map[string]map[string]*Client),
+		backend:    backend,
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 		service:    service,
@@ -71,6 +110,8 @@ func (h *Hub) Run() {
 	ticker := time.NewTicker(60 * time.Second)
 	defer ticker.Stop()
 
+	events := h.backend.Subscribe(h.ctx)
+
 	for {
 		select {
 		case client := <-h.register:
@@ -79,20 +120,36 @@ func (h *Hub) Run() {
 		case client := <-h.unregister:
 			h.unregisterClient(client)
 
-		case message := <-h.broadcast:
-			h.broadcastMessage(message)
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			h.deliver(event)
 
 		case <-ticker.C:
 			h.cleanupConnections()
+			go h.refreshPresence()
 		}
 	}
 }
 
+// Presence returns the users connected to the session on any instance
+func (h *Hub) Presence(ctx context.Context, sessionID string) ([]string, error) {
+	return h.backend.Present(ctx, sessionID)
+}
+
 func (h *Hub) registerClient(client *Client) {
 	h.clientLock.Lock()
 	defer h.clientLock.Unlock()
 
-	h.clients[client.ID] = client
+	// A client that connected just as the hub started draining is sent away at once
+	if h.draining.Load() {
+		client.closeCode = websocket.CloseServiceRestart
+		close(client.done)
+		return
+	}
+
+	h.addClient(client)
 
 	// Send recent messages to the newly connected client
 	go h.sendRecentMessages(client)
@@ -102,51 +159,253 @@ func (h *Hub) unregisterClient(client *Client) {
 	h.clientLock.Lock()
 	defer h.clientLock.Unlock()
 
-	if _, ok := h.clients[client.ID]; ok {
-		delete(h.clients, client.ID)
-		close(client.Send)
-	}
+	h.removeClient(client)
 }
 
 func (h *Hub) broadcastMessage(message Message) {
-	h.clientLock.RLock()
-	defer h.clientLock.RUnlock()
+	h.publish(Event{Message: message})
+}
 
-	for _, client := range h.clients {
-		if client.SessionID == message.SessionID {
+func (h *Hub) publish(event Event) {
+	if err := h.backend.Publish(h.ctx, event); err != nil {
+		log.Printf("Error publishing %s event for session %s: %v", event.Message.Type, event.Message.SessionID, err)
+	}
+}
+
+// deliver sends an event to this instance's clients in the session, on every device
+// of each participant
+func (h *Hub) deliver(event Event) {
+	h.clientLock.Lock()
+	defer h.clientLock.Unlock()
+
+	var dropped []*Client
+	for userID, conns := range h.sessions[event.Message.SessionID] {
+		if userID == event.ExcludeUserID || (event.UserID != "" && userID != event.UserID) {
+			continue
+		}
+		for _, client := range conns {
+			if client.ID == event.ExcludeConnID {
+				continue
+			}
 			select {
-			case client.Send <- message:
+			case client.Send <- event.Message:
+				h.metrics.sent()
 			default:
-				close(client.Send)
-				delete(h.clients, client.ID)
+				dropped = append(dropped, client)
 			}
 		}
 	}
+	for _, client := range dropped {
+		h.removeClient(client)
+	}
 }
 
-func (h *Hub) sendRecentMessages(client *Client) {
-	// Get recent messages from database
-	messages, err := h.service.repo.GetMessages(h.ctx, db.GetMessagesParams{
-		SessionID: uuid.MustParse(client.SessionID),
-		Limit:     50,
-		Offset:    0,
+// join records the client in the cluster-wide presence. The other participant is told
+// when the user connects their first device, not each one after it.
+func (h *Hub) join(client *Client) {
+	present, err := h.backend.Present(h.ctx, client.SessionID)
+	if err != nil {
+		log.Printf("Error reading presence for session %s: %v", client.SessionID, err)
+	}
+
+	if err := h.backend.Join(h.ctx, client.SessionID, client.UserID, client.ID); err != nil {
+		log.Printf("Error recording presence for %s: %v", client.UserID, err)
+	}
+
+	for _, userID := range present {
+		if userID == client.UserID {
+			return
+		}
+	}
+
+	h.publish(Event{
+		Message: Message{
+			Type:      "USER_JOINED",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Timestamp: time.Now(),
+		},
+		ExcludeUserID: client.UserID,
+	})
+}
+
+// leave removes the client from presence. USER_LEFT is only sent once the user has no
+// connection to the session left on any instance.
+func (h *Hub) leave(client *Client) {
+	if err := h.backend.Leave(h.ctx, client.SessionID, client.UserID, client.ID); err != nil {
+		log.Printf("Error removing presence for %s: %v", client.UserID, err)
+	}
+
+	present, err := h.backend.Present(h.ctx, client.SessionID)
+	if err != nil {
+		log.Printf("Error reading presence for session %s: %v", client.SessionID, err)
+		return
+	}
+	for _, userID := range present {
+		if userID == client.UserID {
+			return
+		}
+	}
+
+	h.publish(Event{
+		Message: Message{
+			Type:      "USER_LEFT",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Timestamp: time.Now(),
+		},
+		ExcludeUserID: client.UserID,
 	})
+}
+
+// refreshPresence renews the presence of this instance's clients before it expires
+func (h *Hub) refreshPresence() {
+	h.clientLock.RLock()
+	clients := make([]*Client, 0, len(h.clients))
+	for _, client := range h.clients {
+		clients = append(clients, client)
+	}
+	h.clientLock.RUnlock()
+
+	for _, client := range clients {
+		if err := h.backend.Join(h.ctx, client.SessionID, client.UserID, client.ID); err != nil {
+			log.Printf("Error refreshing presence for %s: %v", client.UserID, err)
+		}
+	}
+}
+
+// sendRecentMessages replays the latest messages to a new connection. A reconnecting
+// client that resumes after a message gets exactly the messages it missed instead.
+func (h *Hub) sendRecentMessages(client *Client) {
+	sid, err := uuid.Parse(client.SessionID)
 	if err != nil {
-		log.Printf("Error getting recent messages: %v", err)
 		return
 	}
 
+	var cursor *db.GetMessageCursorRow
+	if client.ResumeAfter != "" {
+		c, err := h.resumeCursor(client, sid)
+		if err != nil {
+			log.Printf("Cannot resume session %s after %s, replaying recent messages: %v", client.SessionID, client.ResumeAfter, err)
+		} else {
+			cursor = &c
+		}
+	}
+
+	if cursor == nil {
+		messages, _, err := h.service.messagesBefore(h.ctx, sid, nil, defaultHistoryPage)
+		if err != nil {
+			log.Printf("Error getting recent messages: %v", err)
+			return
+		}
+		if !h.replay(client, messages) {
+			return
+		}
+	} else {
+		for {
+			messages, hasMore, err := h.service.messagesAfter(h.ctx, sid, *cursor, maxHistoryPage)
+			if err != nil {
+				log.Printf("Error getting missed messages: %v", err)
+				return
+			}
+			if !h.replay(client, messages) {
+				return
+			}
+			if !hasMore {
+				break
+			}
+			last := messages[len(messages)-1]
+			cursor = &db.GetMessageCursorRow{ID: last.ID, SessionID: sid, CreatedAt: last.CreatedAt}
+		}
+	}
+
+	h.sendReadReceipts(client)
+	h.sendReadState(client)
+}
+
+func (h *Hub) resumeCursor(client *Client, sessionID uuid.UUID) (db.GetMessageCursorRow, error) {
+	uid, err := uuid.Parse(client.UserID)
+	if err != nil {
+		return db.GetMessageCursorRow{}, err
+	}
+	return h.service.sessionCursor(h.ctx, sessionID, client.ResumeAfter, uid)
+}
+
+// replay sends stored messages to the client. It reports false if the client stopped
+// accepting them.
+func (h *Hub) replay(client *Client, messages []db.ChatMessage) bool {
+	attachments, err := h.service.messageAttachments(h.ctx, messages)
+	if err != nil {
+		log.Printf("Error getting message attachments: %v", err)
+	}
+
 	for _, msg := range messages {
+		data := map[string]interface{}{
+			"message_id": msg.ID.String(),
+			"kind":       msg.Kind,
+		}
+		if attachment, ok := attachments[msg.ID]; ok {
+			data["attachment"] = attachment
+		}
+		if msg.EditedAt.Valid {
+			data["edited_at"] = msg.EditedAt.Time
+		}
+		if msg.DeletedAt.Valid {
+			data["deleted"] = true
+			data["deleted_at"] = msg.DeletedAt.Time
+		}
+
 		message := Message{
 			Type:      "MESSAGE",
 			SessionID: client.SessionID,
 			SenderID:  msg.SenderID.String(),
 			Content:   msg.Content,
 			Timestamp: msg.CreatedAt.Time,
+			ClientID:  msg.ClientMessageID.String,
+			Data:      data,
 		}
 
 		select {
 		case client.Send <- message:
+		case <-client.done:
+			return false
+		case <-time.After(5 * time.Second):
+			return false
+		}
+	}
+	return true
+}
+
+// sendReadReceipts lets the client know how far the other participant has read
+func (h *Hub) sendReadReceipts(client *Client) {
+	receipts, err := h.service.repo.GetSessionReadReceipts(h.ctx, uuid.MustParse(client.SessionID))
+	if err != nil {
+		log.Printf("Error getting read receipts: %v", err)
+		return
+	}
+
+	for _, r := range receipts {
+		if r.UserID.String() == client.UserID || !r.LastReadMessageID.Valid {
+			continue
+		}
+
+		receipt := ReadReceipt{
+			Type:      "READ_RECEIPT",
+			SessionID: client.SessionID,
+			MessageID: r.LastReadMessageID.UUID.String(),
+			ReaderID:  r.UserID.String(),
+			ReadAt:    r.UpdatedAt.Time,
+		}
+
+		select {
+		case client.Send <- Message{
+			Type:      receipt.Type,
+			SessionID: receipt.SessionID,
+			Timestamp: receipt.ReadAt,
+			Data:      receipt,
+		}:
+		case <-client.done:
+			return
 		case <-time.After(5 * time.Second):
 			return
 		}
@@ -157,32 +416,77 @@ func (h *Hub) cleanupConnections() {
 	h.clientLock.Lock()
 	defer h.clientLock.Unlock()
 
-	for id, client := range h.clients {
+	for _, client := range h.clients {
+		// gRPC streams are kept alive by the gRPC server
+		if client.Conn == nil {
+			continue
+		}
 		if err := client.Conn.WriteControl(websocket.PingMessage, []byte{}, time.Now().Add(5*time.Second)); err != nil {
-			close(client.Send)
-			delete(h.clients, id)
+			h.removeClient(client)
 		}
 	}
 }
 
-func (h *Hub) HandleWebSocket(w http.ResponseWriter, r *http.Request, sessionID, userID string) {
+// HandleWebSocket serves a client of the session. resumeAfter is the ID of the last
+// message a reconnecting client has, or empty for a new connection.
+func (h *Hub) HandleWebSocket(w http.ResponseWriter, r *http.Request, sessionID, userID, resumeAfter string) {
+	protocol, err := parseProtocolVersion(r.URL.Query().Get("v"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.accepting(); err != nil {
+		w.Header().Set("Retry-After", retryAfterSeconds)
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	// Only participants get the session's history, attachment links and a say in it
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+	if err := h.service.checkParticipant(r.Context(), sessionID, uid); err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, "Invalid session_id", http.StatusBadRequest)
+		case codes.PermissionDenied, codes.NotFound:
+			http.Error(w, errNotParticipant.Error(), http.StatusForbidden)
+		default:
+			log.Printf("Error checking participant of session %s: %v", sessionID, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		}
+		return
+	}
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		return
 	}
 
 	clientID := uuid.New().String()
-	client := &Client{
-		ID:        clientID,
-		Conn:      conn,
-		Send:      make(chan Message, 256),
-		SessionID: sessionID,
-		UserID:    userID,
+	client := newClient(clientID, sessionID, userID, resumeAfter, protocol)
+	client.Conn = conn
+
+	// Newer clients are told which protocol they got before anything is replayed
+	if protocol >= protocolV2 {
+		client.Send <- Message{
+			Type:      "CONNECTED",
+			SessionID: sessionID,
+			Timestamp: time.Now(),
+			Data: map[string]interface{}{
+				"protocol":      protocol,
+				"connection_id": clientID,
+			},
+		}
 	}
 
 	h.register <- client
+	h.join(client)
 	defer func() {
 		h.unregister <- client
+		h.leave(client)
 		conn.Close()
 	}()
 
@@ -194,20 +498,32 @@ func (h *Hub) HandleWebSocket(w http.ResponseWriter, r *http.Request, sessionID,
 func (h *Hub) writePump(client *Client) {
 	ticker := time.NewTicker(54 * time.Second)
 	defer ticker.Stop()
+	defer close(client.flushed)
 
 	for {
 		select {
-		case message, ok := <-client.Send:
+		case message := <-client.Send:
 			client.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if !ok {
-				client.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+			if err := client.Conn.WriteJSON(message); err != nil {
 				return
 			}
 
-			if err := client.Conn.WriteJSON(message); err != nil {
-				return
+		case <-client.done:
+			for _, message := range client.pending() {
+				client.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+				if err := client.Conn.WriteJSON(message); err != nil {
+					return
+				}
 			}
 
+			client.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			closing := []byte{}
+			if client.closeCode != 0 {
+				closing = websocket.FormatCloseMessage(client.closeCode, closeReason(client.closeCode))
+			}
+			client.Conn.WriteMessage(websocket.CloseMessage, closing)
+			return
+
 		case <-ticker.C:
 			client.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
 			if err := client.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
@@ -220,111 +536,243 @@ func (h *Hub) writePump(client *Client) {
 func (h *Hub) readPump(client *Client) {
 	defer client.Conn.Close()
 
+	h.serveFrames(client, func() (Message, error) {
+		var msg Message
+		err := client.Conn.ReadJSON(&msg)
+		return msg, err
+	})
+}
+
+// serveFrames handles the frames a client sends, whatever its transport, until next
+// fails because the client went away
+func (h *Hub) serveFrames(client *Client, next func() (Message, error)) {
 	// Track typing state
 	typingTimer := time.AfterFunc(3*time.Second, func() {
-		// Send typing stopped message after 3 seconds of inactivity
-		typingIndicator := TypingIndicator{
-			Type:      "TYPING_STOPPED",
-			SessionID: client.SessionID,
-			UserID:    client.UserID,
-			IsTyping:  false,
-			Timestamp: time.Now(),
-		}
-		h.broadcastTypingIndicator(typingIndicator)
+		// Typing stops after 3 seconds of inactivity
+		h.setTyping(client, false)
 	})
 
 	for {
-		var msg Message
-		if err := client.Conn.ReadJSON(&msg); err != nil {
+		msg, err := next()
+		if err != nil {
 			break
 		}
 
 		// Reset typing timer
 		typingTimer.Reset(3 * time.Second)
+		client.lastActive.Store(time.Now().UnixNano())
+		h.metrics.received()
+
+		h.persisting.RLock()
+		// Frames that arrive while draining are dropped unanswered; version 2 clients
+		// send unacknowledged messages again once they reconnected
+		if !h.draining.Load() {
+			h.handleFrame(client, msg)
+		}
+		h.persisting.RUnlock()
+	}
 
-		// Process different message types
-		switch msg.Type {
-		case "MESSAGE":
-			if msg.Content != "" {
-				// Store message in database
-				messageID, err := h.service.InsertMessageWithID(h.ctx, client.SessionID, "USER", client.UserID, msg.Content)
-				if err != nil {
-					log.Printf("Error inserting message: %v", err)
-					continue
-				}
+	// Typing stops when the connection closes, unless the user types on another device
+	typingTimer.Stop()
+	h.setTyping(client, false)
+}
 
-				// Send typing stopped when sending message
-				typingIndicator := TypingIndicator{
-					Type:      "TYPING_STOPPED",
-					SessionID: client.SessionID,
-					UserID:    client.UserID,
-					IsTyping:  false,
-					Timestamp: time.Now(),
-				}
-				h.broadcastTypingIndicator(typingIndicator)
-
-				// Broadcast message to other clients in the session
-				message := Message{
-					Type:      "MESSAGE",
-					SessionID: client.SessionID,
-					SenderID:  client.UserID,
-					Content:   msg.Content,
-					Timestamp: time.Now(),
-					Data: map[string]interface{}{
-						"message_id": messageID,
-					},
-				}
+// handleFrame processes one frame from a client
+func (h *Hub) handleFrame(client *Client, msg Message) {
+	switch msg.Type {
+	case "MESSAGE":
+		if msg.Content == "" {
+			h.nack(client, msg, errors.New("message content is required"))
+			return
+		}
+		if h.acknowledgeDuplicate(client, msg) {
+			return
+		}
 
-				h.broadcast <- message
+		// Store message in database
+		messageID, content, err := h.service.SendMessageWithNotification(h.ctx, client.SessionID, client.UserID, msg.Content, msg.ClientID)
+		if err != nil {
+			if db.IsDuplicateKey(err) && h.acknowledgeDuplicate(client, msg) {
+				return
 			}
+			h.nack(client, msg, err)
+			return
+		}
+
+		// Sending a message stops typing on this device
+		h.setTyping(client, false)
+
+		// Broadcast message to other clients in the session
+		message := Message{
+			Type:      "MESSAGE",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Content:   content,
+			Timestamp: time.Now(),
+			ClientID:  msg.ClientID,
+			Data: map[string]interface{}{
+				"message_id": messageID,
+			},
+		}
+
+		h.broadcastMessage(message)
+		h.ack(client, msg, messageID)
+
+	case "TEMPLATE":
+		// Advisors send a saved reply by ID; it is expanded and stored server-side
+		dataMap, _ := msg.Data.(map[string]interface{})
+		templateID, ok := dataMap["template_id"].(string)
+		if !ok {
+			h.nack(client, msg, errors.New("data.template_id is required"))
+			return
+		}
+		if h.acknowledgeDuplicate(client, msg) {
+			return
+		}
 
-		case "TYPING_STARTED":
-			typingIndicator := TypingIndicator{
-				Type:      "TYPING_STARTED",
-				SessionID: client.SessionID,
-				UserID:    client.UserID,
-				IsTyping:  true,
-				Timestamp: time.Now(),
+		messageID, content, err := h.service.SendTemplateMessage(h.ctx, client.SessionID, client.UserID, templateID, msg.ClientID)
+		if err != nil {
+			if db.IsDuplicateKey(err) && h.acknowledgeDuplicate(client, msg) {
+				return
 			}
-			h.broadcastTypingIndicator(typingIndicator)
-
-		case "TYPING_STOPPED":
-			typingIndicator := TypingIndicator{
-				Type:      "TYPING_STOPPED",
-				SessionID: client.SessionID,
-				UserID:    client.UserID,
-				IsTyping:  false,
-				Timestamp: time.Now(),
+			h.nack(client, msg, err)
+			return
+		}
+
+		h.broadcastMessage(Message{
+			Type:      "MESSAGE",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Content:   content,
+			Timestamp: time.Now(),
+			ClientID:  msg.ClientID,
+			Data: map[string]interface{}{
+				"message_id":  messageID,
+				"template_id": templateID,
+			},
+		})
+		h.ack(client, msg, messageID)
+
+	case "ATTACHMENT":
+		// Files are uploaded over HTTP first and then sent by ID, with content as caption
+		dataMap, _ := msg.Data.(map[string]interface{})
+		attachmentID, ok := dataMap["attachment_id"].(string)
+		if !ok {
+			h.nack(client, msg, errors.New("data.attachment_id is required"))
+			return
+		}
+		if h.acknowledgeDuplicate(client, msg) {
+			return
+		}
+
+		sent, attachment, err := h.service.SendAttachmentMessage(h.ctx, client.SessionID, client.UserID, attachmentID, msg.Content, msg.ClientID)
+		if err != nil {
+			if db.IsDuplicateKey(err) && h.acknowledgeDuplicate(client, msg) {
+				return
 			}
-			h.broadcastTypingIndicator(typingIndicator)
-
-		case "READ_RECEIPT":
-			if msg.Data != nil {
-				if dataMap, ok := msg.Data.(map[string]interface{}); ok {
-					if messageID, ok := dataMap["message_id"].(string); ok {
-						readReceipt := ReadReceipt{
-							Type:      "READ_RECEIPT",
-							SessionID: client.SessionID,
-							MessageID: messageID,
-							ReaderID:  client.UserID,
-							ReadAt:    time.Now(),
-						}
-						h.broadcastReadReceipt(readReceipt)
+			h.nack(client, msg, err)
+			return
+		}
+
+		h.broadcastMessage(Message{
+			Type:      "MESSAGE",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Content:   sent.Content,
+			Timestamp: sent.CreatedAt.Time,
+			ClientID:  msg.ClientID,
+			Data: map[string]interface{}{
+				"message_id": sent.ID.String(),
+				"kind":       sent.Kind,
+				"attachment": attachment,
+			},
+		})
+		h.ack(client, msg, sent.ID.String())
+
+	case "EDIT_MESSAGE":
+		dataMap, _ := msg.Data.(map[string]interface{})
+		messageID, ok := dataMap["message_id"].(string)
+		if !ok {
+			h.nack(client, msg, errors.New("data.message_id is required"))
+			return
+		}
+
+		edited, err := h.service.EditMessage(h.ctx, client.SessionID, messageID, client.UserID, msg.Content)
+		if err != nil {
+			h.nack(client, msg, err)
+			return
+		}
+
+		h.broadcastMessage(Message{
+			Type:      "MESSAGE_EDITED",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Content:   edited.Content,
+			Timestamp: edited.EditedAt.Time,
+			Data: map[string]interface{}{
+				"message_id": messageID,
+				"edited_at":  edited.EditedAt.Time,
+			},
+		})
+
+	case "DELETE_MESSAGE":
+		dataMap, _ := msg.Data.(map[string]interface{})
+		messageID, ok := dataMap["message_id"].(string)
+		if !ok {
+			h.nack(client, msg, errors.New("data.message_id is required"))
+			return
+		}
+
+		deleted, err := h.service.DeleteMessage(h.ctx, client.SessionID, messageID, client.UserID)
+		if err != nil {
+			h.nack(client, msg, err)
+			return
+		}
+
+		h.broadcastMessage(Message{
+			Type:      "MESSAGE_DELETED",
+			SessionID: client.SessionID,
+			SenderID:  client.UserID,
+			Timestamp: deleted.DeletedAt.Time,
+			Data: map[string]interface{}{
+				"message_id": messageID,
+				"deleted_at": deleted.DeletedAt.Time,
+			},
+		})
+
+	case "TYPING_STARTED":
+		h.setTyping(client, true)
+
+	case "TYPING_STOPPED":
+		h.setTyping(client, false)
+
+	case "READ_RECEIPT":
+		if msg.Data != nil {
+			if dataMap, ok := msg.Data.(map[string]interface{}); ok {
+				if messageID, ok := dataMap["message_id"].(string); ok {
+					advanced, err := h.service.UpdateMessageReadStatus(h.ctx, client.SessionID, messageID, client.UserID)
+					if err != nil {
+						log.Printf("Error updating read status: %v", err)
+						return
+					}
+					// Receipts for messages older than the watermark change nothing
+					if !advanced {
+						return
+					}
+
+					readReceipt := ReadReceipt{
+						Type:      "READ_RECEIPT",
+						SessionID: client.SessionID,
+						MessageID: messageID,
+						ReaderID:  client.UserID,
+						ReadAt:    time.Now(),
 					}
+					h.broadcastReadReceipt(readReceipt)
+					h.syncReadState(client, readReceipt)
 				}
 			}
 		}
 	}
-
-	// Send typing stopped when connection closes
-	typingIndicator := TypingIndicator{
-		Type:      "TYPING_STOPPED",
-		SessionID: client.SessionID,
-		UserID:    client.UserID,
-		IsTyping:  false,
-		Timestamp: time.Now(),
-	}
-	h.broadcastTypingIndicator(typingIndicator)
 }
 
 func (h *Hub) SendAIMessage(sessionID, content string) {
@@ -336,49 +784,31 @@ func (h *Hub) SendAIMessage(sessionID, content string) {
 		Timestamp: time.Now(),
 	}
 
-	h.broadcast <- message
+	h.broadcastMessage(message)
 }
 
 func (h *Hub) broadcastTypingIndicator(indicator TypingIndicator) {
-	h.clientLock.RLock()
-	defer h.clientLock.RUnlock()
-
-	for _, client := range h.clients {
-		if client.SessionID == indicator.SessionID && client.UserID != indicator.UserID {
-			select {
-			case client.Send <- Message{
-				Type:      indicator.Type,
-				SessionID: indicator.SessionID,
-				Timestamp: indicator.Timestamp,
-				Data:      indicator,
-			}:
-			default:
-				close(client.Send)
-				delete(h.clients, client.ID)
-			}
-		}
-	}
+	h.publish(Event{
+		Message: Message{
+			Type:      indicator.Type,
+			SessionID: indicator.SessionID,
+			Timestamp: indicator.Timestamp,
+			Data:      indicator,
+		},
+		ExcludeUserID: indicator.UserID,
+	})
 }
 
 func (h *Hub) broadcastReadReceipt(receipt ReadReceipt) {
-	h.clientLock.RLock()
-	defer h.clientLock.RUnlock()
-
-	for _, client := range h.clients {
-		if client.SessionID == receipt.SessionID && client.UserID != receipt.ReaderID {
-			select {
-			case client.Send <- Message{
-				Type:      receipt.Type,
-				SessionID: receipt.SessionID,
-				Timestamp: receipt.ReadAt,
-				Data:      receipt,
-			}:
-			default:
-				close(client.Send)
-				delete(h.clients, client.ID)
-			}
-		}
-	}
+	h.publish(Event{
+		Message: Message{
+			Type:      receipt.Type,
+			SessionID: receipt.SessionID,
+			Timestamp: receipt.ReadAt,
+			Data:      receipt,
+		},
+		ExcludeUserID: receipt.ReaderID,
+	})
 }
 
 // WebSocketUpgrader configuration
@@ -388,13 +818,10 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-// Enhanced Hub with metrics and better connection management
+// EnhancedHub is a hub that keeps metrics, limits its connections and watches their
+// quality
 type EnhancedHub struct {
 	*Hub
-	metrics           *HubMetrics
-	connectionLimit   int
-	activeConnections int
-	maxConnections    int
 }
 
 type HubMetrics struct {
@@ -408,13 +835,14 @@ type HubMetrics struct {
 	mu                 sync.RWMutex
 }
 
-func NewEnhancedHub(service *Service) *EnhancedHub {
-	return &EnhancedHub{
-		Hub:             NewHub(service),
-		metrics:         &HubMetrics{},
-		connectionLimit: 1000,
-		maxConnections:  1000,
-	}
+// NewEnhancedHub creates a hub sharing sessions through backend that accepts up to
+// maxConnections connections, or any number when it is 0
+func NewEnhancedHub(service *Service, backend Backend, maxConnections int) *EnhancedHub {
+	hub := NewHubWithBackend(service, backend)
+	hub.metrics = &HubMetrics{}
+	hub.maxConnections = maxConnections
+
+	return &EnhancedHub{Hub: hub}
 }
 
 // GetMetrics returns current hub metrics
@@ -433,68 +861,51 @@ func (h *EnhancedHub) GetMetrics() *HubMetrics {
 	}
 }
 
-// registerClient enhanced with metrics
-func (h *EnhancedHub) registerClient(client *Client) {
-	h.clientLock.Lock()
-	defer h.clientLock.Unlock()
-
-	// Check connection limit
-	if len(h.clients) >= h.connectionLimit {
-		log.Printf("Connection limit reached, rejecting client %s", client.ID)
+// connected counts a new connection. Metrics are optional, so a nil HubMetrics counts
+// nothing.
+func (m *HubMetrics) connected(active int) {
+	if m == nil {
 		return
 	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	h.clients[client.ID] = client
-	h.activeConnections++
-
-	// Update metrics
-	h.metrics.mu.Lock()
-	h.metrics.TotalConnections++
-	h.metrics.ActiveConnections = int64(h.activeConnections)
-	h.metrics.LastConnectionTime = time.Now()
-	h.metrics.mu.Unlock()
-
-	// Send recent messages to the newly connected client
-	go h.sendRecentMessages(client)
+	m.TotalConnections++
+	m.ActiveConnections = int64(active)
+	m.LastConnectionTime = time.Now()
 }
 
-// unregisterClient enhanced with metrics
-func (h *EnhancedHub) unregisterClient(client *Client) {
-	h.clientLock.Lock()
-	defer h.clientLock.Unlock()
+// disconnected counts a closed connection and how long it lasted
+func (m *HubMetrics) disconnected(active int, lasted time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-	if _, ok := h.clients[client.ID]; ok {
-		delete(h.clients, client.ID)
-		close(client.Send)
-		h.activeConnections--
+	m.ActiveConnections = int64(active)
+	m.Disconnections++
+	m.AverageSessionTime += (lasted - m.AverageSessionTime) / time.Duration(m.Disconnections)
+}
 
-		// Update metrics
-		h.metrics.mu.Lock()
-		h.metrics.ActiveConnections = int64(h.activeConnections)
-		h.metrics.Disconnections++
-		h.metrics.mu.Unlock()
+// sent counts a frame queued for a client
+func (m *HubMetrics) sent() {
+	if m == nil {
+		return
 	}
+	m.mu.Lock()
+	m.MessagesSent++
+	m.mu.Unlock()
 }
 
-// broadcastMessage enhanced with metrics
-func (h *EnhancedHub) broadcastMessage(message Message) {
-	h.clientLock.RLock()
-	defer h.clientLock.RUnlock()
-
-	for _, client := range h.clients {
-		if client.SessionID == message.SessionID {
-			select {
-			case client.Send <- message:
-				// Update message sent metrics
-				h.metrics.mu.Lock()
-				h.metrics.MessagesSent++
-				h.metrics.mu.Unlock()
-			default:
-				close(client.Send)
-				delete(h.clients, client.ID)
-			}
-		}
+// received counts a frame a client sent
+func (m *HubMetrics) received() {
+	if m == nil {
+		return
 	}
+	m.mu.Lock()
+	m.MessagesReceived++
+	m.mu.Unlock()
 }
 
 // Health check endpoint for monitoring
@@ -502,50 +913,44 @@ func (h *EnhancedHub) HealthCheck() map[string]interface{} {
 	h.metrics.mu.RLock()
 	defer h.metrics.mu.RUnlock()
 
-	return map[string]interface{}{
-		"status":             "healthy",
-		"active_connections": h.metrics.ActiveConnections,
-		"total_connections":  h.metrics.TotalConnections,
-		"max_connections":    h.maxConnections,
-		"connection_usage":   float64(h.metrics.ActiveConnections) / float64(h.maxConnections),
-		"messages_sent":      h.metrics.MessagesSent,
-		"messages_received":  h.metrics.MessagesReceived,
-		"disconnections":     h.metrics.Disconnections,
-		"last_connection":    h.metrics.LastConnectionTime,
+	status := "healthy"
+	if h.Draining() {
+		status = "draining"
 	}
-}
 
-// Graceful shutdown
-func (h *EnhancedHub) Shutdown(ctx context.Context) error {
-	// Close all client connections
-	h.clientLock.Lock()
-	defer h.clientLock.Unlock()
-
-	for _, client := range h.clients {
-		close(client.Send)
-		client.Conn.Close()
+	usage := 0.0
+	if h.maxConnections > 0 {
+		usage = float64(h.metrics.ActiveConnections) / float64(h.maxConnections)
 	}
 
-	// Clear clients map
-	h.clients = make(map[string]*Client)
-	h.activeConnections = 0
-
-	// Wait for shutdown or timeout
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	default:
-		return nil
+	return map[string]interface{}{
+		"status":                  status,
+		"active_connections":      h.metrics.ActiveConnections,
+		"total_connections":       h.metrics.TotalConnections,
+		"max_connections":         h.maxConnections,
+		"connection_usage":        usage,
+		"messages_sent":           h.metrics.MessagesSent,
+		"messages_received":       h.metrics.MessagesReceived,
+		"disconnections":          h.metrics.Disconnections,
+		"average_session_seconds": h.metrics.AverageSessionTime.Seconds(),
+		"last_connection":         h.metrics.LastConnectionTime,
 	}
 }
 
-// Connection quality monitoring
-func (h *EnhancedHub) monitorConnectionQuality() {
+// Shutdown drains the hub, see Drain
+func (h *EnhancedHub) Shutdown(ctx context.Context) error {
+	return h.Drain(ctx)
+}
+
+// monitorConnectionQuality logs slow and inactive connections until ctx is cancelled
+func (h *EnhancedHub) monitorConnectionQuality(ctx context.Context) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
 	for {
 		select {
+		case <-ctx.Done():
+			return
 		case <-ticker.C:
 			h.checkConnectionQuality()
 		}
@@ -553,8 +958,8 @@ func (h *EnhancedHub) monitorConnectionQuality() {
 }
 
 func (h *EnhancedHub) checkConnectionQuality() {
-	h.clientLock.Lock()
-	defer h.clientLock.Unlock()
+	h.clientLock.RLock()
+	defer h.clientLock.RUnlock()
 
 	var slowConnections int
 	var inactiveConnections int
@@ -584,62 +989,58 @@ func (h *EnhancedHub) isSlowConnection(client *Client) bool {
 }
 
 func (h *EnhancedHub) isInactiveConnection(client *Client, now time.Time) bool {
-	// Check if connection has been inactive for more than 5 minutes
-	// This would require tracking last activity time per client
-	return false // Placeholder - would need client activity tracking
+	// Inactive when the client has not sent a frame for more than 5 minutes
+	return now.Sub(time.Unix(0, client.lastActive.Load())) > 5*time.Minute
 }
 
-// Load balancing for multiple hub instances
+// HubManager spreads the sessions of this instance over several hubs, so connections
+// of different sessions do not contend for one hub's lock. Every hub subscribes to the
+// backend, so each delivers to its own clients whatever any of them publishes. Hubs are
+// added at startup; a session always goes to the same hub.
 type HubManager struct {
-	hubs     map[string]*EnhancedHub
-	hubIndex int
-	mu       sync.RWMutex
-	service  *Service
+	hubs           map[string]*EnhancedHub
+	ids            []string // hub IDs in the order they were added, for routing
+	mu             sync.RWMutex
+	service        *Service
+	backend        Backend
+	maxConnections int // per hub
 }
 
-func NewHubManager(service *Service) *HubManager {
+func NewHubManager(service *Service, backend Backend, maxConnections int) *HubManager {
 	return &HubManager{
-		hubs:    make(map[string]*EnhancedHub),
-		service: service,
+		hubs:           make(map[string]*EnhancedHub),
+		service:        service,
+		backend:        backend,
+		maxConnections: maxConnections,
 	}
 }
 
+// GetHub returns the hub serving the session
 func (hm *HubManager) GetHub(sessionID string) *EnhancedHub {
 	hm.mu.RLock()
 	defer hm.mu.RUnlock()
 
-	// Simple hash-based routing
-	hash := len(sessionID) % len(hm.hubs)
-	var hub *EnhancedHub
-	i := 0
-	for _, h := range hm.hubs {
-		if i == hash {
-			hub = h
-			break
-		}
-		i++
-	}
-
-	if hub == nil {
-		// Fallback to first available hub
-		for _, h := range hm.hubs {
-			return h
-		}
+	if len(hm.ids) == 0 {
+		return nil
 	}
 
-	return hub
+	hash := fnv.New32a()
+	hash.Write([]byte(sessionID))
+	return hm.hubs[hm.ids[hash.Sum32()%uint32(len(hm.ids))]]
 }
 
-func (hm *HubManager) AddHub(id string) *EnhancedHub {
+// AddHub starts a hub; it runs until ctx is cancelled
+func (hm *HubManager) AddHub(ctx context.Context, id string) *EnhancedHub {
 	hm.mu.Lock()
 	defer hm.mu.Unlock()
 
-	hub := NewEnhancedHub(hm.service)
+	hub := NewEnhancedHub(hm.service, hm.backend, hm.maxConnections)
 	hm.hubs[id] = hub
+	hm.ids = append(hm.ids, id)
 
 	// Start hub in background
 	go hub.Run()
-	go hub.monitorConnectionQuality()
+	go hub.monitorConnectionQuality(ctx)
 
 	return hub
 }
@@ -655,3 +1056,90 @@ func (hm *HubManager) GetAllMetrics() map[string]*HubMetrics {
 
 	return metrics
 }
+
+// HealthCheck reports the health of every hub along with totals for the instance
+func (hm *HubManager) HealthCheck() map[string]interface{} {
+	hm.mu.RLock()
+	defer hm.mu.RUnlock()
+
+	hubs := make(map[string]interface{}, len(hm.hubs))
+	var active, total, sent, received int64
+	draining := false
+	for id, hub := range hm.hubs {
+		hubs[id] = hub.HealthCheck()
+		draining = draining || hub.Draining()
+
+		m := hub.GetMetrics()
+		active += m.ActiveConnections
+		total += m.TotalConnections
+		sent += m.MessagesSent
+		received += m.MessagesReceived
+	}
+
+	status := "healthy"
+	if draining {
+		status = "draining"
+	}
+
+	return map[string]interface{}{
+		"status":             status,
+		"active_connections": active,
+		"total_connections":  total,
+		"messages_sent":      sent,
+		"messages_received":  received,
+		"hubs":               hubs,
+	}
+}
+
+// HandleWebSocket serves a WebSocket client on the hub of its session
+func (hm *HubManager) HandleWebSocket(w http.ResponseWriter, r *http.Request, sessionID, userID, resumeAfter string) {
+	hm.GetHub(sessionID).HandleWebSocket(w, r, sessionID, userID, resumeAfter)
+}
+
+// HandleStream serves a gRPC ChatStream on the hub of the session its first frame
+// names
+func (hm *HubManager) HandleStream(stream chat.ChatService_ChatStreamServer) error {
+	uid, first, err := openStream(stream)
+	if err != nil {
+		return err
+	}
+	return hm.GetHub(first.SessionId).serveStream(stream, uid, first)
+}
+
+// Draining reports whether the hubs are shutting down
+func (hm *HubManager) Draining() bool {
+	hm.mu.RLock()
+	defer hm.mu.RUnlock()
+
+	for _, hub := range hm.hubs {
+		if hub.Draining() {
+			return true
+		}
+	}
+	return false
+}
+
+// Drain drains every hub at once, see Hub.Drain
+func (hm *HubManager) Drain(ctx context.Context) error {
+	hm.mu.RLock()
+	hubs := make([]*EnhancedHub, 0, len(hm.hubs))
+	for _, hub := range hm.hubs {
+		hubs = append(hubs, hub)
+	}
+	hm.mu.RUnlock()
+
+	errs := make(chan error, len(hubs))
+	for _, hub := range hubs {
+		go func() {
+			errs <- hub.Drain(ctx)
+		}()
+	}
+
+	var err error
+	for range hubs {
+		if e := <-errs; e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
@@ -10,15 +10,19 @@ import (
 )
 
 type Config struct {
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Server   ServerConfig   `mapstructure:"server"`
-	Agora    AgoraConfig    `mapstructure:"agora"`
-	OpenAI   OpenAIConfig   `mapstructure:"openai"`
-	FCM      FCMConfig      `mapstructure:"fcm"`
-	APNS     APNSConfig     `mapstructure:"apns"`
-	Email    EmailConfig    `mapstructure:"email"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	Redis       RedisConfig       `mapstructure:"redis"`
+	JWT         JWTConfig         `mapstructure:"jwt"`
+	Server      ServerConfig      `mapstructure:"server"`
+	Agora       AgoraConfig       `mapstructure:"agora"`
+	OpenAI      OpenAIConfig      `mapstructure:"openai"`
+	FCM         FCMConfig         `mapstructure:"fcm"`
+	APNS        APNSConfig        `mapstructure:"apns"`
+	Email       EmailConfig       `mapstructure:"email"`
+	Encryption  EncryptionConfig  `mapstructure:"encryption"`
+	Sessions    SessionsConfig    `mapstructure:"sessions"`
+	Chat        ChatConfig        `mapstructure:"chat"`
+	Attachments AttachmentsConfig `mapstructure:"attachments"`
 }
 
 type DatabaseConfig struct {
@@ -80,6 +84,31 @@ type EmailConfig struct {
 	Port     string `mapstructure:"port"`
 }
 
+type EncryptionConfig struct {
+	Key          string   `mapstructure:"key"`           // hex encoded 32 byte AES key
+	KEK          string   `mapstructure:"kek"`           // hex encoded 32 byte key encrypting the keys of chat and AI content, plaintext when empty
+	PreviousKEKs []string `mapstructure:"previous_keks"` // KEKs being replaced; keys they encrypted are re-encrypted with kek on start
+	RotationDays int      `mapstructure:"rotation_days"` // days before chat and AI content gets a new key, 0 to rotate by hand only
+}
+
+type ChatConfig struct {
+	Backend              string `mapstructure:"backend"`                 // "memory" for a single instance or "redis" to share chats across instances
+	Hubs                 int    `mapstructure:"hubs"`                    // hubs the chat connections of an instance are spread over
+	MaxConnectionsPerHub int    `mapstructure:"max_connections_per_hub"` // 0 for no limit
+}
+
+type AttachmentsConfig struct {
+	Dir        string `mapstructure:"dir"`         // directory of the local blob store
+	BaseURL    string `mapstructure:"base_url"`    // public address of the HTTP server, used in download links
+	SigningKey string `mapstructure:"signing_key"` // signs download links, derived from the JWT secret when empty
+	URLTTL     int    `mapstructure:"url_ttl"`     // seconds a download link stays valid
+}
+
+type SessionsConfig struct {
+	RequestTimeout int `mapstructure:"request_timeout"` // seconds an advisor has to accept a chat request
+	IdleTimeout    int `mapstructure:"idle_timeout"`    // minutes without messages before a chat is ended, 0 to disable
+}
+
 func Load() (*Config, error) {
 	// Load .env file if it exists
 	godotenv.Load()
@@ -121,6 +150,18 @@ func Load() (*Config, error) {
 	viper.SetDefault("email.password", "")
 	viper.SetDefault("email.host", "smtp.gmail.com")
 	viper.SetDefault("email.port", "587")
+	viper.SetDefault("encryption.key", "")
+	viper.SetDefault("encryption.kek", "")
+	viper.SetDefault("encryption.rotation_days", 90)
+	viper.SetDefault("sessions.request_timeout", 120)
+	viper.SetDefault("sessions.idle_timeout", 15)
+	viper.SetDefault("chat.backend", "memory")
+	viper.SetDefault("chat.hubs", 4)
+	viper.SetDefault("chat.max_connections_per_hub", 1000)
+	viper.SetDefault("attachments.dir", "./data/attachments")
+	viper.SetDefault("attachments.base_url", "http://localhost:8080")
+	viper.SetDefault("attachments.signing_key", "")
+	viper.SetDefault("attachments.url_ttl", 900)
 
 	if err := viper.ReadInConfig(); err != nil {
 		// Use defaults if config file not found
@@ -0,0 +1,48 @@
+-- Advisor application workflow
+CREATE TABLE IF NOT EXISTS advisor_applications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advisor_id UUID NOT NULL REFERENCES advisors(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'DRAFT' CHECK (status IN ('DRAFT', 'SUBMITTED', 'UNDER_REVIEW', 'NEEDS_CHANGES', 'APPROVED', 'REJECTED')),
+    reviewer_id UUID REFERENCES users(id),
+    reviewer_notes TEXT,
+    rejection_reason TEXT,
+    submitted_at TIMESTAMPTZ,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE(advisor_id)
+);
+
+-- Audit trail of every status change
+CREATE TABLE IF NOT EXISTS advisor_application_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES advisor_applications(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    actor_id UUID NOT NULL REFERENCES users(id),
+    note TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Uploaded credentials, stored encrypted (nonce prefixed AES-GCM ciphertext)
+CREATE TABLE IF NOT EXISTS advisor_credential_documents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES advisor_applications(id) ON DELETE CASCADE,
+    file_name TEXT NOT NULL,
+    mime_type TEXT NOT NULL,
+    size_bytes INTEGER NOT NULL,
+    encrypted_content BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Existing pending advisors become submitted applications
+INSERT INTO advisor_applications (advisor_id, user_id, status, submitted_at)
+SELECT id, user_id, 'SUBMITTED', created_at FROM advisors WHERE status = 'PENDING'
+ON CONFLICT (advisor_id) DO NOTHING;
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_advisor_applications_status ON advisor_applications(status);
+CREATE INDEX IF NOT EXISTS idx_advisor_applications_user_id ON advisor_applications(user_id);
+CREATE INDEX IF NOT EXISTS idx_advisor_application_events_application ON advisor_application_events(application_id);
+CREATE INDEX IF NOT EXISTS idx_advisor_credential_documents_application ON advisor_credential_documents(application_id);
@@ -0,0 +1,55 @@
+-- Advisor tiers drive the platform commission
+ALTER TABLE advisors ADD COLUMN IF NOT EXISTS tier TEXT NOT NULL DEFAULT 'STANDARD';
+
+CREATE TABLE IF NOT EXISTS commission_tiers (
+    tier TEXT PRIMARY KEY,
+    commission_percent DECIMAL(5,2) NOT NULL CHECK (commission_percent >= 0 AND commission_percent <= 100),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO commission_tiers (tier, commission_percent) VALUES
+('STANDARD', 30.00),
+('PREMIUM', 25.00),
+('ELITE', 20.00)
+ON CONFLICT (tier) DO NOTHING;
+
+ALTER TABLE advisors ADD CONSTRAINT advisors_tier_fkey FOREIGN KEY (tier) REFERENCES commission_tiers(tier);
+
+-- Payout batches group unpaid earnings for a single advisor
+CREATE TABLE IF NOT EXISTS payout_batches (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advisor_id UUID NOT NULL REFERENCES advisors(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'PAID')),
+    period_start TIMESTAMPTZ,
+    period_end TIMESTAMPTZ NOT NULL,
+    earnings_count INTEGER NOT NULL,
+    total_amount DECIMAL(12,2) NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id),
+    paid_at TIMESTAMPTZ,
+    payment_reference TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- One ledger entry per ended paid session
+CREATE TABLE IF NOT EXISTS advisor_earnings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    advisor_id UUID NOT NULL REFERENCES advisors(id) ON DELETE CASCADE,
+    session_type TEXT NOT NULL,
+    duration_seconds INTEGER NOT NULL,
+    hourly_rate DECIMAL(10,2) NOT NULL,
+    gross_amount DECIMAL(12,2) NOT NULL,
+    commission_percent DECIMAL(5,2) NOT NULL,
+    commission_amount DECIMAL(12,2) NOT NULL,
+    net_amount DECIMAL(12,2) NOT NULL,
+    -- Deferred so a batch and its earnings can be linked in a single statement
+    payout_batch_id UUID REFERENCES payout_batches(id) DEFERRABLE INITIALLY DEFERRED,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE(session_id)
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_advisor_earnings_advisor_created ON advisor_earnings(advisor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_advisor_earnings_unpaid ON advisor_earnings(advisor_id) WHERE payout_batch_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_payout_batches_advisor_id ON payout_batches(advisor_id);
+CREATE INDEX IF NOT EXISTS idx_payout_batches_status ON payout_batches(status);
@@ -0,0 +1,37 @@
+-- Per-minute pricing per advisor and session type
+CREATE TABLE IF NOT EXISTS advisor_pricing (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advisor_id UUID NOT NULL REFERENCES advisors(id) ON DELETE CASCADE,
+    session_type TEXT NOT NULL CHECK (session_type IN ('CHAT', 'CALL', 'AI_HANDOFF')),
+    per_minute_rate DECIMAL(10,2) NOT NULL CHECK (per_minute_rate >= 0),
+    min_billable_minutes INTEGER NOT NULL DEFAULT 1 CHECK (min_billable_minutes >= 0),
+    first_session_discount_percent DECIMAL(5,2) NOT NULL DEFAULT 0 CHECK (first_session_discount_percent >= 0 AND first_session_discount_percent <= 100),
+    currency TEXT NOT NULL DEFAULT 'USD',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE(advisor_id, session_type)
+);
+
+-- Seed chat and call pricing from the legacy hourly rate
+INSERT INTO advisor_pricing (advisor_id, session_type, per_minute_rate)
+SELECT a.id, t.session_type, ROUND(a.hourly_rate / 60, 2)
+FROM advisors a
+CROSS JOIN (VALUES ('CHAT'), ('CALL')) AS t(session_type)
+WHERE a.hourly_rate IS NOT NULL
+ON CONFLICT (advisor_id, session_type) DO NOTHING;
+
+-- Price snapshot taken when a session is created so later changes do not rewrite history
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS pricing_type TEXT;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS price_per_minute DECIMAL(10,2);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS min_billable_minutes INTEGER;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS discount_percent DECIMAL(5,2);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS currency TEXT;
+
+-- Ledger entries record how the amount was derived
+ALTER TABLE advisor_earnings ADD COLUMN IF NOT EXISTS per_minute_rate DECIMAL(10,2);
+ALTER TABLE advisor_earnings ADD COLUMN IF NOT EXISTS billable_minutes INTEGER;
+ALTER TABLE advisor_earnings ADD COLUMN IF NOT EXISTS discount_percent DECIMAL(5,2);
+ALTER TABLE advisor_earnings ADD COLUMN IF NOT EXISTS currency TEXT;
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_advisor_pricing_type_rate ON advisor_pricing(session_type, per_minute_rate);
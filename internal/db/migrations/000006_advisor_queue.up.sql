@@ -0,0 +1,20 @@
+-- Waiting queue for busy advisors
+CREATE TABLE IF NOT EXISTS advisor_queue_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advisor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'WAITING' CHECK (status IN ('WAITING', 'OFFERED', 'ACCEPTED', 'LEFT', 'EXPIRED')),
+    session_id UUID REFERENCES sessions(id) ON DELETE SET NULL,
+    offered_at TIMESTAMPTZ,
+    offer_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- A user waits at most once per advisor, and an advisor has at most one outstanding offer
+CREATE UNIQUE INDEX IF NOT EXISTS idx_advisor_queue_active_user ON advisor_queue_entries(advisor_id, user_id) WHERE status IN ('WAITING', 'OFFERED');
+CREATE UNIQUE INDEX IF NOT EXISTS idx_advisor_queue_single_offer ON advisor_queue_entries(advisor_id) WHERE status = 'OFFERED';
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_advisor_queue_waiting ON advisor_queue_entries(advisor_id, created_at) WHERE status = 'WAITING';
+CREATE INDEX IF NOT EXISTS idx_advisor_queue_offer_expiry ON advisor_queue_entries(offer_expires_at) WHERE status = 'OFFERED';
@@ -0,0 +1,16 @@
+-- Saved replies for advisors and platform templates curated by admins
+CREATE TABLE IF NOT EXISTS message_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advisor_id UUID REFERENCES advisors(id) ON DELETE CASCADE, -- NULL for platform templates
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    category TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_message_templates_advisor_id ON message_templates(advisor_id);
+CREATE INDEX IF NOT EXISTS idx_message_templates_platform ON message_templates(category) WHERE advisor_id IS NULL;
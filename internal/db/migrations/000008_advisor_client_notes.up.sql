@@ -0,0 +1,13 @@
+-- Private notes advisors keep about their clients, encrypted at rest
+CREATE TABLE IF NOT EXISTS advisor_client_notes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    advisor_id UUID NOT NULL REFERENCES advisors(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_id UUID REFERENCES sessions(id) ON DELETE SET NULL,
+    encrypted_content BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_advisor_client_notes_client ON advisor_client_notes(advisor_id, user_id, created_at DESC);
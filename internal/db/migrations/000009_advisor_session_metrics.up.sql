@@ -0,0 +1,20 @@
+-- Per-session performance facts, refreshed incrementally from sessions, chat_messages and call_logs
+CREATE TABLE IF NOT EXISTS advisor_session_metrics (
+    session_id UUID PRIMARY KEY REFERENCES sessions(id) ON DELETE CASCADE,
+    advisor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    first_response_seconds INTEGER, -- chat only, NULL when the advisor never replied
+    accepted BOOLEAN NOT NULL DEFAULT FALSE,
+    repeat_client BOOLEAN NOT NULL DEFAULT FALSE,
+    missed_call BOOLEAN NOT NULL DEFAULT FALSE,
+    computed_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_advisor_session_metrics_advisor ON advisor_session_metrics(advisor_id, started_at);
+CREATE INDEX IF NOT EXISTS idx_sessions_ended_at ON sessions(ended_at);
+CREATE INDEX IF NOT EXISTS idx_ratings_advisor_created ON ratings(advisor_id, created_at);
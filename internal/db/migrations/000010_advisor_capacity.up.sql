@@ -0,0 +1,9 @@
+-- How many chats an advisor takes at once; calls are always exclusive
+ALTER TABLE advisors ADD COLUMN IF NOT EXISTS max_concurrent_chats INTEGER NOT NULL DEFAULT 3
+    CHECK (max_concurrent_chats BETWEEN 1 AND 5);
+-- TRUE while the advisor is BUSY only because they are at capacity, so the status can be
+-- restored when a session ends without overriding a BUSY the advisor set themselves
+ALTER TABLE advisors ADD COLUMN IF NOT EXISTS auto_busy BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_sessions_advisor_ongoing ON sessions(advisor_id) WHERE status = 'ONGOING';
@@ -0,0 +1,14 @@
+-- Advisor chat sessions start as requests the advisor accepts or declines.
+-- EXPIRED requests timed out without an answer.
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS sessions_status_check;
+ALTER TABLE sessions ADD CONSTRAINT sessions_status_check
+    CHECK (status IN ('REQUESTED', 'ONGOING', 'ENDED', 'CANCELLED', 'DECLINED', 'EXPIRED'));
+
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS requested_at TIMESTAMPTZ; -- NULL for sessions that never needed acceptance
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS accepted_at TIMESTAMPTZ;
+
+-- Add indexes
+DROP INDEX IF EXISTS idx_sessions_advisor_ongoing;
+CREATE INDEX IF NOT EXISTS idx_sessions_advisor_active ON sessions(advisor_id) WHERE status IN ('REQUESTED', 'ONGOING');
+CREATE INDEX IF NOT EXISTS idx_sessions_requested_at ON sessions(requested_at) WHERE status = 'REQUESTED';
+CREATE INDEX IF NOT EXISTS idx_chat_messages_session_created ON chat_messages(session_id, created_at);
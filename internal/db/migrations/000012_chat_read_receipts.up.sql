@@ -0,0 +1,15 @@
+-- Referenced by the read status query but never created
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ;
+
+-- Per-participant read watermark: everything in the session up to last_read_at has been read
+CREATE TABLE IF NOT EXISTS chat_read_receipts (
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    last_read_message_id UUID REFERENCES chat_messages(id) ON DELETE SET NULL,
+    last_read_at TIMESTAMPTZ NOT NULL, -- created_at of the last read message
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (session_id, user_id)
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_chat_read_receipts_user ON chat_read_receipts(user_id);
@@ -0,0 +1,15 @@
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+-- Deleted messages stay as tombstones: the content is cleared, everything else is kept
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Content a message had before each edit or deletion, kept for moderation
+CREATE TABLE IF NOT EXISTS chat_message_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    message_id UUID NOT NULL REFERENCES chat_messages(id) ON DELETE CASCADE,
+    action TEXT NOT NULL CHECK (action IN ('EDIT', 'DELETE')),
+    content TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_chat_message_revisions_message ON chat_message_revisions(message_id, created_at);
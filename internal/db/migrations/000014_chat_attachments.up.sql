@@ -0,0 +1,20 @@
+-- ATTACHMENT messages carry an image or voice note; their content is an optional caption
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'TEXT' CHECK (kind IN ('TEXT', 'ATTACHMENT'));
+
+-- Uploaded files are kept in the blob store; a row is linked to its message once sent
+CREATE TABLE IF NOT EXISTS chat_attachments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    uploader_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    message_id UUID REFERENCES chat_messages(id) ON DELETE SET NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('IMAGE', 'VOICE')),
+    mime_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    duration_ms INTEGER, -- voice notes, as reported by the uploading client
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_chat_attachments_message ON chat_attachments(message_id);
+CREATE INDEX IF NOT EXISTS idx_chat_attachments_session ON chat_attachments(session_id, created_at);
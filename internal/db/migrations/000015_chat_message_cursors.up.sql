@@ -0,0 +1,3 @@
+-- Message history is paged by (created_at, id) so messages sharing a timestamp keep a stable order
+CREATE INDEX IF NOT EXISTS idx_chat_messages_session_cursor ON chat_messages(session_id, created_at, id);
+DROP INDEX IF EXISTS idx_chat_messages_session_created;
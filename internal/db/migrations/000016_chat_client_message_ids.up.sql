@@ -0,0 +1,5 @@
+-- Clients name the messages they send so a retried send is recognised instead of stored twice
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS client_message_id TEXT;
+
+-- Add indexes
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_messages_client_id ON chat_messages(session_id, client_message_id) WHERE client_message_id IS NOT NULL;
@@ -0,0 +1,29 @@
+-- Chat messages are screened before they are stored. Each detector has one rule that
+-- admins change at runtime.
+CREATE TABLE IF NOT EXISTS moderation_rules (
+    detector TEXT PRIMARY KEY CHECK (detector IN ('PHONE', 'EMAIL', 'PAYMENT_HANDLE', 'URL', 'PROFANITY')),
+    action TEXT NOT NULL CHECK (action IN ('ALLOW', 'MASK', 'BLOCK', 'FLAG')),
+    notice TEXT NOT NULL DEFAULT '', -- shown to the sender of a blocked message, a default when empty
+    updated_by UUID REFERENCES users(id),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+INSERT INTO moderation_rules (detector, action) VALUES
+    ('PHONE', 'MASK'),
+    ('EMAIL', 'MASK'),
+    ('PAYMENT_HANDLE', 'BLOCK'),
+    ('URL', 'FLAG'),
+    ('PROFANITY', 'MASK')
+ON CONFLICT (detector) DO NOTHING;
+
+-- Lexicon of the PROFANITY detector, matched as whole words regardless of case
+CREATE TABLE IF NOT EXISTS moderation_terms (
+    term TEXT PRIMARY KEY CHECK (term = LOWER(term) AND term <> ''),
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Messages the FLAG action lets through are flagged for review without a reporter
+ALTER TABLE admin_flags ALTER COLUMN reported_by DROP NOT NULL;
+ALTER TABLE admin_flags ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'USER' CHECK (source IN ('USER', 'MODERATION'));
+ALTER TABLE admin_flags ADD COLUMN IF NOT EXISTS message_id UUID REFERENCES chat_messages(id) ON DELETE SET NULL;
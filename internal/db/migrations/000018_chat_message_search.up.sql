@@ -0,0 +1,3 @@
+-- Full-text search of chat history. Queries must use the same expression for the index
+-- to apply. Deleted messages have no content and are never found.
+CREATE INDEX IF NOT EXISTS idx_chat_messages_search ON chat_messages USING GIN (to_tsvector('english', content)) WHERE deleted_at IS NULL;
@@ -0,0 +1,28 @@
+-- Prepaid balance users spend on paid chats
+CREATE TABLE IF NOT EXISTS user_balances (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    balance DECIMAL(12,2) NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Every change to a balance, with the balance it left
+CREATE TABLE IF NOT EXISTS balance_transactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('CHARGE', 'CREDIT')),
+    amount DECIMAL(12,2) NOT NULL, -- negative for charges
+    balance_after DECIMAL(12,2) NOT NULL,
+    session_id UUID REFERENCES sessions(id) ON DELETE SET NULL,
+    created_by UUID REFERENCES users(id), -- the admin who credited the balance
+    note TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Paid chats are charged per started minute from the advisor's first reply
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS billing_started_at TIMESTAMPTZ;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS billed_minutes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS billed_amount DECIMAL(12,2) NOT NULL DEFAULT 0;
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_balance_transactions_user_created ON balance_transactions(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sessions_metered ON sessions(billing_started_at) WHERE status = 'ONGOING' AND billing_started_at IS NOT NULL;
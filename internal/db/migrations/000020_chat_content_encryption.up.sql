@@ -0,0 +1,26 @@
+-- Chat and AI content is encrypted with data keys, which are stored encrypted with a key
+-- encryption key (KEK) from config. The newest CONTENT key encrypts new content; older ones
+-- are kept until everything they encrypted has been re-encrypted.
+CREATE TABLE IF NOT EXISTS encryption_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    purpose TEXT NOT NULL CHECK (purpose IN ('CONTENT', 'SEARCH')),
+    wrapped_key BYTEA NOT NULL, -- the data key sealed with the KEK
+    kek_id TEXT NOT NULL,       -- fingerprint of the KEK that sealed it
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Content without a key ID is plaintext, written before encryption was configured
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS content_key_id UUID REFERENCES encryption_keys(id);
+ALTER TABLE chat_message_revisions ADD COLUMN IF NOT EXISTS content_key_id UUID REFERENCES encryption_keys(id);
+ALTER TABLE ai_interactions ADD COLUMN IF NOT EXISTS content_key_id UUID REFERENCES encryption_keys(id);
+
+-- Encrypted content cannot be indexed, so messages are searched by keyed hashes of their words.
+-- Without a KEK content stays plaintext and idx_chat_messages_search keeps serving full-text search.
+ALTER TABLE chat_messages ADD COLUMN IF NOT EXISTS search_terms TEXT[];
+
+-- Add indexes
+CREATE INDEX IF NOT EXISTS idx_encryption_keys_purpose_created ON encryption_keys(purpose, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_chat_messages_search_terms ON chat_messages USING GIN (search_terms) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_chat_messages_content_key ON chat_messages(content_key_id);
+CREATE INDEX IF NOT EXISTS idx_chat_message_revisions_content_key ON chat_message_revisions(content_key_id);
+CREATE INDEX IF NOT EXISTS idx_ai_interactions_content_key ON ai_interactions(content_key_id);
@@ -6,41 +6,162 @@ package db
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type AdminFlag struct {
 	ID                uuid.UUID      `json:"id"`
-	ReportedBy        uuid.UUID      `json:"reported_by"`
+	ReportedBy        uuid.NullUUID  `json:"reported_by"`
 	ReportedUserID    uuid.NullUUID  `json:"reported_user_id"`
 	ReportedAdvisorID uuid.NullUUID  `json:"reported_advisor_id"`
 	Reason            string         `json:"reason"`
 	SessionID         uuid.NullUUID  `json:"session_id"`
 	CreatedAt         sql.NullTime   `json:"created_at"`
 	Status            sql.NullString `json:"status"`
+	Source            string         `json:"source"`
+	MessageID         uuid.NullUUID  `json:"message_id"`
 }
 
 type Advisor struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	Bio                sql.NullString `json:"bio"`
+	ExperienceYears    sql.NullInt32  `json:"experience_years"`
+	Languages          []string       `json:"languages"`
+	Specializations    []string       `json:"specializations"`
+	IsVerified         sql.NullBool   `json:"is_verified"`
+	HourlyRate         sql.NullString `json:"hourly_rate"`
+	Status             sql.NullString `json:"status"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	Tier               string         `json:"tier"`
+	MaxConcurrentChats int32          `json:"max_concurrent_chats"`
+	AutoBusy           bool           `json:"auto_busy"`
+}
+
+type AdvisorApplication struct {
 	ID              uuid.UUID      `json:"id"`
+	AdvisorID       uuid.UUID      `json:"advisor_id"`
 	UserID          uuid.UUID      `json:"user_id"`
-	Bio             sql.NullString `json:"bio"`
-	ExperienceYears sql.NullInt32  `json:"experience_years"`
-	Languages       []string       `json:"languages"`
-	Specializations []string       `json:"specializations"`
-	IsVerified      sql.NullBool   `json:"is_verified"`
-	HourlyRate      sql.NullString `json:"hourly_rate"`
-	Status          sql.NullString `json:"status"`
+	Status          string         `json:"status"`
+	ReviewerID      uuid.NullUUID  `json:"reviewer_id"`
+	ReviewerNotes   sql.NullString `json:"reviewer_notes"`
+	RejectionReason sql.NullString `json:"rejection_reason"`
+	SubmittedAt     sql.NullTime   `json:"submitted_at"`
+	ReviewedAt      sql.NullTime   `json:"reviewed_at"`
 	CreatedAt       sql.NullTime   `json:"created_at"`
 	UpdatedAt       sql.NullTime   `json:"updated_at"`
 }
 
+type AdvisorApplicationEvent struct {
+	ID            uuid.UUID      `json:"id"`
+	ApplicationID uuid.UUID      `json:"application_id"`
+	FromStatus    string         `json:"from_status"`
+	ToStatus      string         `json:"to_status"`
+	ActorID       uuid.UUID      `json:"actor_id"`
+	Note          sql.NullString `json:"note"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+}
+
+type AdvisorClientNote struct {
+	ID               uuid.UUID     `json:"id"`
+	AdvisorID        uuid.UUID     `json:"advisor_id"`
+	UserID           uuid.UUID     `json:"user_id"`
+	SessionID        uuid.NullUUID `json:"session_id"`
+	EncryptedContent []byte        `json:"encrypted_content"`
+	CreatedAt        sql.NullTime  `json:"created_at"`
+	UpdatedAt        sql.NullTime  `json:"updated_at"`
+}
+
+type AdvisorCredentialDocument struct {
+	ID               uuid.UUID    `json:"id"`
+	ApplicationID    uuid.UUID    `json:"application_id"`
+	FileName         string       `json:"file_name"`
+	MimeType         string       `json:"mime_type"`
+	SizeBytes        int32        `json:"size_bytes"`
+	EncryptedContent []byte       `json:"encrypted_content"`
+	CreatedAt        sql.NullTime `json:"created_at"`
+}
+
+type AdvisorEarning struct {
+	ID                uuid.UUID      `json:"id"`
+	SessionID         uuid.UUID      `json:"session_id"`
+	AdvisorID         uuid.UUID      `json:"advisor_id"`
+	SessionType       string         `json:"session_type"`
+	DurationSeconds   int32          `json:"duration_seconds"`
+	HourlyRate        string         `json:"hourly_rate"`
+	GrossAmount       string         `json:"gross_amount"`
+	CommissionPercent string         `json:"commission_percent"`
+	CommissionAmount  string         `json:"commission_amount"`
+	NetAmount         string         `json:"net_amount"`
+	PayoutBatchID     uuid.NullUUID  `json:"payout_batch_id"`
+	CreatedAt         sql.NullTime   `json:"created_at"`
+	PerMinuteRate     sql.NullString `json:"per_minute_rate"`
+	BillableMinutes   sql.NullInt32  `json:"billable_minutes"`
+	DiscountPercent   sql.NullString `json:"discount_percent"`
+	Currency          sql.NullString `json:"currency"`
+}
+
+type AdvisorPricing struct {
+	ID                          uuid.UUID    `json:"id"`
+	AdvisorID                   uuid.UUID    `json:"advisor_id"`
+	SessionType                 string       `json:"session_type"`
+	PerMinuteRate               string       `json:"per_minute_rate"`
+	MinBillableMinutes          int32        `json:"min_billable_minutes"`
+	FirstSessionDiscountPercent string       `json:"first_session_discount_percent"`
+	Currency                    string       `json:"currency"`
+	CreatedAt                   sql.NullTime `json:"created_at"`
+	UpdatedAt                   sql.NullTime `json:"updated_at"`
+}
+
+type AdvisorQueueEntry struct {
+	ID             uuid.UUID     `json:"id"`
+	AdvisorID      uuid.UUID     `json:"advisor_id"`
+	UserID         uuid.UUID     `json:"user_id"`
+	Status         string        `json:"status"`
+	SessionID      uuid.NullUUID `json:"session_id"`
+	OfferedAt      sql.NullTime  `json:"offered_at"`
+	OfferExpiresAt sql.NullTime  `json:"offer_expires_at"`
+	CreatedAt      sql.NullTime  `json:"created_at"`
+	UpdatedAt      sql.NullTime  `json:"updated_at"`
+}
+
+type AdvisorSessionMetric struct {
+	SessionID            uuid.UUID     `json:"session_id"`
+	AdvisorID            uuid.UUID     `json:"advisor_id"`
+	SessionType          string        `json:"session_type"`
+	Status               string        `json:"status"`
+	StartedAt            time.Time     `json:"started_at"`
+	EndedAt              sql.NullTime  `json:"ended_at"`
+	DurationSeconds      int32         `json:"duration_seconds"`
+	FirstResponseSeconds sql.NullInt32 `json:"first_response_seconds"`
+	Accepted             bool          `json:"accepted"`
+	RepeatClient         bool          `json:"repeat_client"`
+	MissedCall           bool          `json:"missed_call"`
+	ComputedAt           sql.NullTime  `json:"computed_at"`
+}
+
 type AiInteraction struct {
-	ID        uuid.UUID    `json:"id"`
-	UserID    uuid.UUID    `json:"user_id"`
-	Prompt    string       `json:"prompt"`
-	Response  string       `json:"response"`
-	CreatedAt sql.NullTime `json:"created_at"`
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	Prompt       string        `json:"prompt"`
+	Response     string        `json:"response"`
+	CreatedAt    sql.NullTime  `json:"created_at"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+}
+
+type BalanceTransaction struct {
+	ID           uuid.UUID      `json:"id"`
+	UserID       uuid.UUID      `json:"user_id"`
+	Kind         string         `json:"kind"`
+	Amount       string         `json:"amount"`
+	BalanceAfter string         `json:"balance_after"`
+	SessionID    uuid.NullUUID  `json:"session_id"`
+	CreatedBy    uuid.NullUUID  `json:"created_by"`
+	Note         sql.NullString `json:"note"`
+	CreatedAt    sql.NullTime   `json:"created_at"`
 }
 
 type CallFeedbackPrompt struct {
@@ -66,14 +187,65 @@ type CallLog struct {
 	StatusTimestamp sql.NullTime   `json:"status_timestamp"`
 }
 
+type ChatAttachment struct {
+	ID         uuid.UUID     `json:"id"`
+	SessionID  uuid.UUID     `json:"session_id"`
+	UploaderID uuid.UUID     `json:"uploader_id"`
+	MessageID  uuid.NullUUID `json:"message_id"`
+	Kind       string        `json:"kind"`
+	MimeType   string        `json:"mime_type"`
+	SizeBytes  int64         `json:"size_bytes"`
+	DurationMs sql.NullInt32 `json:"duration_ms"`
+	StorageKey string        `json:"storage_key"`
+	CreatedAt  sql.NullTime  `json:"created_at"`
+}
+
 type ChatMessage struct {
-	ID         uuid.UUID    `json:"id"`
-	SessionID  uuid.UUID    `json:"session_id"`
-	SenderType string       `json:"sender_type"`
-	SenderID   uuid.UUID    `json:"sender_id"`
-	Content    string       `json:"content"`
-	CreatedAt  sql.NullTime `json:"created_at"`
-	IsRead     sql.NullBool `json:"is_read"`
+	ID              uuid.UUID      `json:"id"`
+	SessionID       uuid.UUID      `json:"session_id"`
+	SenderType      string         `json:"sender_type"`
+	SenderID        uuid.UUID      `json:"sender_id"`
+	Content         string         `json:"content"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	IsRead          sql.NullBool   `json:"is_read"`
+	ReadAt          sql.NullTime   `json:"read_at"`
+	EditedAt        sql.NullTime   `json:"edited_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	Kind            string         `json:"kind"`
+	ClientMessageID sql.NullString `json:"client_message_id"`
+	ContentKeyID    uuid.NullUUID  `json:"content_key_id"`
+	SearchTerms     []string       `json:"search_terms"`
+}
+
+type ChatMessageRevision struct {
+	ID           uuid.UUID     `json:"id"`
+	MessageID    uuid.UUID     `json:"message_id"`
+	Action       string        `json:"action"`
+	Content      string        `json:"content"`
+	CreatedAt    sql.NullTime  `json:"created_at"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+}
+
+type ChatReadReceipt struct {
+	SessionID         uuid.UUID     `json:"session_id"`
+	UserID            uuid.UUID     `json:"user_id"`
+	LastReadMessageID uuid.NullUUID `json:"last_read_message_id"`
+	LastReadAt        time.Time     `json:"last_read_at"`
+	UpdatedAt         sql.NullTime  `json:"updated_at"`
+}
+
+type CommissionTier struct {
+	Tier              string       `json:"tier"`
+	CommissionPercent string       `json:"commission_percent"`
+	UpdatedAt         sql.NullTime `json:"updated_at"`
+}
+
+type EncryptionKey struct {
+	ID         uuid.UUID `json:"id"`
+	Purpose    string    `json:"purpose"`
+	WrappedKey []byte    `json:"wrapped_key"`
+	KekID      string    `json:"kek_id"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type Faq struct {
@@ -86,6 +258,46 @@ type Faq struct {
 	UpdatedAt sql.NullTime `json:"updated_at"`
 }
 
+type MessageTemplate struct {
+	ID        uuid.UUID      `json:"id"`
+	AdvisorID uuid.NullUUID  `json:"advisor_id"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Category  sql.NullString `json:"category"`
+	IsActive  bool           `json:"is_active"`
+	CreatedBy uuid.NullUUID  `json:"created_by"`
+	CreatedAt sql.NullTime   `json:"created_at"`
+	UpdatedAt sql.NullTime   `json:"updated_at"`
+}
+
+type ModerationRule struct {
+	Detector  string        `json:"detector"`
+	Action    string        `json:"action"`
+	Notice    string        `json:"notice"`
+	UpdatedBy uuid.NullUUID `json:"updated_by"`
+	UpdatedAt sql.NullTime  `json:"updated_at"`
+}
+
+type ModerationTerm struct {
+	Term      string        `json:"term"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+	CreatedAt sql.NullTime  `json:"created_at"`
+}
+
+type PayoutBatch struct {
+	ID               uuid.UUID      `json:"id"`
+	AdvisorID        uuid.UUID      `json:"advisor_id"`
+	Status           string         `json:"status"`
+	PeriodStart      sql.NullTime   `json:"period_start"`
+	PeriodEnd        time.Time      `json:"period_end"`
+	EarningsCount    int32          `json:"earnings_count"`
+	TotalAmount      string         `json:"total_amount"`
+	CreatedBy        uuid.UUID      `json:"created_by"`
+	PaidAt           sql.NullTime   `json:"paid_at"`
+	PaymentReference sql.NullString `json:"payment_reference"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
+}
+
 type Rating struct {
 	ID         uuid.UUID      `json:"id"`
 	SessionID  uuid.UUID      `json:"session_id"`
@@ -97,13 +309,23 @@ type Rating struct {
 }
 
 type Session struct {
-	ID        uuid.UUID      `json:"id"`
-	UserID    uuid.UUID      `json:"user_id"`
-	AdvisorID uuid.NullUUID  `json:"advisor_id"`
-	Type      string         `json:"type"`
-	StartedAt sql.NullTime   `json:"started_at"`
-	EndedAt   sql.NullTime   `json:"ended_at"`
-	Status    sql.NullString `json:"status"`
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	AdvisorID          uuid.NullUUID  `json:"advisor_id"`
+	Type               string         `json:"type"`
+	StartedAt          sql.NullTime   `json:"started_at"`
+	EndedAt            sql.NullTime   `json:"ended_at"`
+	Status             sql.NullString `json:"status"`
+	PricingType        sql.NullString `json:"pricing_type"`
+	PricePerMinute     sql.NullString `json:"price_per_minute"`
+	MinBillableMinutes sql.NullInt32  `json:"min_billable_minutes"`
+	DiscountPercent    sql.NullString `json:"discount_percent"`
+	Currency           sql.NullString `json:"currency"`
+	RequestedAt        sql.NullTime   `json:"requested_at"`
+	AcceptedAt         sql.NullTime   `json:"accepted_at"`
+	BillingStartedAt   sql.NullTime   `json:"billing_started_at"`
+	BilledMinutes      int32          `json:"billed_minutes"`
+	BilledAmount       string         `json:"billed_amount"`
 }
 
 type Specialization struct {
@@ -132,3 +354,9 @@ type User struct {
 	ApnsToken    sql.NullString `json:"apns_token"`
 	DeviceType   sql.NullString `json:"device_type"`
 }
+
+type UserBalance struct {
+	UserID    uuid.UUID    `json:"user_id"`
+	Balance   string       `json:"balance"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+}
@@ -302,11 +302,8 @@ func Transaction(ctx context.Context, db *sql.DB, txFunc func(*Queries) error) e
 		return err
 	}
 
-	// Create a temporary Queries instance bound to this transaction
-	queries := New(db)
-	// Note: In a real implementation, you would need to modify the Queries
-	// struct to work with transactions. For now, we'll execute the function
-	// directly and handle rollback/commit
+	// Queries bound to this transaction
+	queries := New(tx)
 
 	if err := txFunc(queries); err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
@@ -7,34 +7,98 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Queued sessions are REQUESTED when the offer is made and start once the user accepts,
+	// so billing runs from acceptance
+	AcceptQueueOffer(ctx context.Context, arg AcceptQueueOfferParams) (Session, error)
+	// Billing runs from acceptance, so started_at is reset. Sessions offered to a queued user
+	// are accepted by the user, through AcceptQueueOffer.
+	AcceptSessionRequest(ctx context.Context, arg AcceptSessionRequestParams) (Session, error)
+	// Adds a CONTENT key unless one was added after the cutoff, so instances rotating on a
+	// schedule add a single key between them
+	AddContentKey(ctx context.Context, arg AddContentKeyParams) (EncryptionKey, error)
+	AddModerationTerms(ctx context.Context, arg AddModerationTermsParams) (int64, error)
 	ApproveAdvisor(ctx context.Context, id uuid.UUID) error
 	BlockUser(ctx context.Context, id uuid.UUID) error
+	CancelSession(ctx context.Context, id uuid.UUID) error
+	// Charges the minute after billed_minutes if nobody charged it yet and the balance covers it.
+	// The session and the balance are locked first, so a concurrent charge of either is seen
+	// and the minute is claimed only together with its charge.
+	ChargeSessionMinute(ctx context.Context, arg ChargeSessionMinuteParams) (ChargeSessionMinuteRow, error)
 	CountCompletedSessions(ctx context.Context, userID uuid.UUID) (int64, error)
+	// How much content each key encrypts; plaintext has no key
+	CountContentByKey(ctx context.Context) ([]CountContentByKeyRow, error)
+	CountOngoingAdvisorSessions(ctx context.Context, advisorID uuid.NullUUID) (CountOngoingAdvisorSessionsRow, error)
 	CountPendingReports(ctx context.Context) (int64, error)
 	CountResolvedReports(ctx context.Context) (int64, error)
+	CountSessionsBetween(ctx context.Context, arg CountSessionsBetweenParams) (int32, error)
 	CountTotalReports(ctx context.Context) (int64, error)
+	CountUnindexedMessages(ctx context.Context) (int32, error)
 	CountUserSessions(ctx context.Context, userID uuid.UUID) (int64, error)
 	CreateAdminFlag(ctx context.Context, arg CreateAdminFlagParams) (AdminFlag, error)
 	CreateAdvisor(ctx context.Context, arg CreateAdvisorParams) (Advisor, error)
+	CreateAdvisorApplication(ctx context.Context, arg CreateAdvisorApplicationParams) (AdvisorApplication, error)
 	CreateCallSession(ctx context.Context, arg CreateCallSessionParams) (Session, error)
+	CreateChatAttachment(ctx context.Context, arg CreateChatAttachmentParams) (ChatAttachment, error)
+	CreateClientNote(ctx context.Context, arg CreateClientNoteParams) (AdvisorClientNote, error)
+	CreateEncryptionKey(ctx context.Context, arg CreateEncryptionKeyParams) (EncryptionKey, error)
 	CreateFAQ(ctx context.Context, arg CreateFAQParams) (uuid.UUID, error)
 	CreateFeedbackPrompt(ctx context.Context, arg CreateFeedbackPromptParams) (uuid.UUID, error)
+	CreateMessageTemplate(ctx context.Context, arg CreateMessageTemplateParams) (MessageTemplate, error)
+	CreateModerationFlag(ctx context.Context, arg CreateModerationFlagParams) (AdminFlag, error)
+	CreatePayoutBatch(ctx context.Context, arg CreatePayoutBatchParams) (PayoutBatch, error)
 	CreateRating(ctx context.Context, arg CreateRatingParams) (Rating, error)
+	// Snapshots the advisor's current price for the pricing type; the first-session discount
+	// only applies when the user has never had a session with this advisor. Sessions created as
+	// REQUESTED wait for the advisor to accept them.
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateSpecialization(ctx context.Context, arg CreateSpecializationParams) (uuid.UUID, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreditUserBalance(ctx context.Context, arg CreditUserBalanceParams) (BalanceTransaction, error)
+	DeclineSessionRequest(ctx context.Context, arg DeclineSessionRequestParams) (Session, error)
+	DeleteAdvisorTemplate(ctx context.Context, arg DeleteAdvisorTemplateParams) (int64, error)
+	// Turns the sender's own message into a tombstone, keeping the old content
+	DeleteChatMessage(ctx context.Context, arg DeleteChatMessageParams) (ChatMessage, error)
+	DeleteClientNote(ctx context.Context, arg DeleteClientNoteParams) (int64, error)
 	DeleteFAQ(ctx context.Context, id uuid.UUID) error
+	DeletePlatformTemplate(ctx context.Context, id uuid.UUID) (int64, error)
+	// CONTENT keys superseded before the cutoff that nothing is encrypted with anymore. The
+	// cutoff leaves every instance time to start using the newer key.
+	DeleteRetiredEncryptionKeys(ctx context.Context, supersededBefore time.Time) (int64, error)
 	DeleteSpecialization(ctx context.Context, id uuid.UUID) error
+	// Replaces the content of the sender's own message if it is still editable, keeping the old content
+	EditChatMessage(ctx context.Context, arg EditChatMessageParams) (ChatMessage, error)
 	EndCall(ctx context.Context, id uuid.UUID) error
+	// Ends a chat found by ListIdleSessions unless a message arrived or it ended meanwhile
+	EndIdleSession(ctx context.Context, arg EndIdleSessionParams) (Session, error)
+	EndSessionForFunds(ctx context.Context, id uuid.UUID) (Session, error)
+	// Offers also expire once their session is no longer waiting, e.g. after the request
+	// timeout or when the user withdrew it
+	ExpireQueueOffers(ctx context.Context) ([]AdvisorQueueEntry, error)
+	// Queue offers expire on their own, shorter deadline
+	ExpireSessionRequests(ctx context.Context, requestedBefore sql.NullTime) ([]Session, error)
+	// Messages of the member's sessions matching a web-search style query, newest first, used
+	// while content is stored as plaintext. The snippet marks matches with \x02 and \x03.
+	FullTextSearchMessages(ctx context.Context, arg FullTextSearchMessagesParams) ([]FullTextSearchMessagesRow, error)
+	GetActiveQueueEntry(ctx context.Context, arg GetActiveQueueEntryParams) (AdvisorQueueEntry, error)
 	GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]Session, error)
 	GetActiveSpecializationsByCategory(ctx context.Context, category string) ([]GetActiveSpecializationsByCategoryRow, error)
+	GetAdminFlag(ctx context.Context, id uuid.UUID) (AdminFlag, error)
+	GetAdvisorApplicationByAdvisorID(ctx context.Context, advisorID uuid.UUID) (AdvisorApplication, error)
+	GetAdvisorApplicationByID(ctx context.Context, id uuid.UUID) (AdvisorApplication, error)
+	GetAdvisorApplicationByUserID(ctx context.Context, userID uuid.UUID) (AdvisorApplication, error)
 	GetAdvisorByID(ctx context.Context, id uuid.UUID) (GetAdvisorByIDRow, error)
 	GetAdvisorByUserID(ctx context.Context, userID uuid.UUID) (Advisor, error)
+	// Serializes session creation per advisor
+	GetAdvisorCapacityForUpdate(ctx context.Context, userID uuid.UUID) (GetAdvisorCapacityForUpdateRow, error)
+	GetAdvisorPeriodStats(ctx context.Context, arg GetAdvisorPeriodStatsParams) (GetAdvisorPeriodStatsRow, error)
+	GetAdvisorRatingStats(ctx context.Context, arg GetAdvisorRatingStatsParams) (GetAdvisorRatingStatsRow, error)
+	GetAdvisorRatingTrend(ctx context.Context, arg GetAdvisorRatingTrendParams) ([]GetAdvisorRatingTrendRow, error)
 	GetAdvisorRatings(ctx context.Context, arg GetAdvisorRatingsParams) ([]Rating, error)
 	GetAdvisorRatingsWithReviewer(ctx context.Context, advisorID uuid.UUID) ([]GetAdvisorRatingsWithReviewerRow, error)
 	// FAQ Management
@@ -42,21 +106,53 @@ type Querier interface {
 	// Specializations Management
 	GetAllSpecializations(ctx context.Context) ([]GetAllSpecializationsRow, error)
 	GetAverageSessionDuration(ctx context.Context, userID uuid.UUID) (float64, error)
-	GetCallSessionByID(ctx context.Context, id uuid.UUID) (Session, error)
+	// Average length of the advisor's most recent ended sessions, used to estimate waits
+	GetAverageSessionSeconds(ctx context.Context, advisorID uuid.NullUUID) (float64, error)
+	GetCallSessionByID(ctx context.Context, id uuid.UUID) (GetCallSessionByIDRow, error)
 	GetCallStatus(ctx context.Context, sessionID uuid.UUID) (GetCallStatusRow, error)
+	// The attachment with whether the message it was sent in has since been deleted
+	GetChatAttachment(ctx context.Context, id uuid.UUID) (GetChatAttachmentRow, error)
+	// The attachment, provided the member takes part in its session
+	GetChatAttachmentForMember(ctx context.Context, arg GetChatAttachmentForMemberParams) (GetChatAttachmentForMemberRow, error)
+	GetChatMessageByID(ctx context.Context, id uuid.UUID) (ChatMessage, error)
+	// Advisors rated 4+ by users who also rated 4+ an advisor the given user rated 4+
+	GetCollaborativeAdvisorScores(ctx context.Context, userID uuid.UUID) ([]GetCollaborativeAdvisorScoresRow, error)
+	GetCredentialDocument(ctx context.Context, id uuid.UUID) (AdvisorCredentialDocument, error)
+	GetEarningsByPeriod(ctx context.Context, arg GetEarningsByPeriodParams) ([]GetEarningsByPeriodRow, error)
 	GetFAQsByCategory(ctx context.Context, category string) ([]GetFAQsByCategoryRow, error)
 	GetFeedbackPromptBySession(ctx context.Context, sessionID uuid.UUID) (CallFeedbackPrompt, error)
 	GetFlags(ctx context.Context, arg GetFlagsParams) ([]AdminFlag, error)
+	GetMessageAttachments(ctx context.Context, messageIds []uuid.UUID) ([]ChatAttachment, error)
+	GetMessageByClientID(ctx context.Context, arg GetMessageByClientIDParams) (ChatMessage, error)
+	// Position of a message the member can see, for paging relative to it
+	GetMessageCursor(ctx context.Context, arg GetMessageCursorParams) (GetMessageCursorRow, error)
+	// The message, provided it belongs to the session and the reader takes part in it
+	GetMessageForReader(ctx context.Context, arg GetMessageForReaderParams) (ChatMessage, error)
+	GetMessageRevisions(ctx context.Context, messageID uuid.UUID) ([]ChatMessageRevision, error)
 	GetMessages(ctx context.Context, arg GetMessagesParams) ([]ChatMessage, error)
 	GetPendingAdvisors(ctx context.Context, arg GetPendingAdvisorsParams) ([]GetPendingAdvisorsRow, error)
 	GetPendingFeedbackPrompts(ctx context.Context) ([]GetPendingFeedbackPromptsRow, error)
+	GetQueueEntryByID(ctx context.Context, id uuid.UUID) (AdvisorQueueEntry, error)
+	GetQueueLength(ctx context.Context, advisorID uuid.UUID) (int32, error)
+	// 1-based position among entries still waiting or holding an offer for the advisor
+	GetQueuePosition(ctx context.Context, arg GetQueuePositionParams) (int32, error)
+	GetRecentAIPrompts(ctx context.Context, arg GetRecentAIPromptsParams) ([]GetRecentAIPromptsRow, error)
 	GetRecentAdminFlags(ctx context.Context) ([]AdminFlag, error)
-	GetRecentEndedSessions(ctx context.Context) ([]Session, error)
+	GetRecentEndedSessions(ctx context.Context) ([]GetRecentEndedSessionsRow, error)
+	// Candidate pool for recommendations, most popular first, with the rating signals used for scoring
 	GetRecommendedAdvisors(ctx context.Context, arg GetRecommendedAdvisorsParams) ([]GetRecommendedAdvisorsRow, error)
 	GetReportsByStatus(ctx context.Context, status sql.NullString) ([]AdminFlag, error)
 	GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error)
 	GetSessionParticipantDeviceTokens(ctx context.Context, arg GetSessionParticipantDeviceTokensParams) ([]GetSessionParticipantDeviceTokensRow, error)
 	GetSessionParticipants(ctx context.Context, id uuid.UUID) ([]GetSessionParticipantsRow, error)
+	GetSessionReadReceipts(ctx context.Context, sessionID uuid.UUID) ([]ChatReadReceipt, error)
+	GetTemplateForAdvisor(ctx context.Context, arg GetTemplateForAdvisorParams) (MessageTemplate, error)
+	GetUnpaidEarningsTotal(ctx context.Context, advisorID uuid.UUID) (string, error)
+	// Sessions the user takes part in with messages from others after their watermark, most recent first
+	GetUnreadCounts(ctx context.Context, arg GetUnreadCountsParams) ([]GetUnreadCountsRow, error)
+	// Sessions the user had with each advisor and the ratings they gave
+	GetUserAdvisorHistory(ctx context.Context, userID uuid.UUID) ([]GetUserAdvisorHistoryRow, error)
+	GetUserBalance(ctx context.Context, userID uuid.UUID) (string, error)
 	GetUserByEmail(ctx context.Context, email sql.NullString) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserByPhone(ctx context.Context, phone sql.NullString) (User, error)
@@ -66,19 +162,93 @@ type Querier interface {
 	GetUserSessions(ctx context.Context, arg GetUserSessionsParams) ([]Session, error)
 	GetUserSpecializations(ctx context.Context, userID uuid.UUID) ([]GetUserSpecializationsRow, error)
 	InsertAIInteraction(ctx context.Context, arg InsertAIInteractionParams) (AiInteraction, error)
+	InsertAdvisorApplicationEvent(ctx context.Context, arg InsertAdvisorApplicationEventParams) error
+	// Sends an attachment the sender uploaded to the session. Claiming the attachment and
+	// inserting the message is one statement, so an attachment is only ever sent once.
+	InsertAttachmentMessage(ctx context.Context, arg InsertAttachmentMessageParams) (ChatMessage, error)
 	InsertCallLog(ctx context.Context, arg InsertCallLogParams) (CallLog, error)
-	InsertMessage(ctx context.Context, arg InsertMessageParams) (ChatMessage, error)
+	InsertCredentialDocument(ctx context.Context, arg InsertCredentialDocumentParams) (InsertCredentialDocumentRow, error)
 	InsertMessageWithID(ctx context.Context, arg InsertMessageWithIDParams) (uuid.UUID, error)
+	JoinAdvisorQueue(ctx context.Context, arg JoinAdvisorQueueParams) (AdvisorQueueEntry, error)
+	LeaveAdvisorQueue(ctx context.Context, arg LeaveAdvisorQueueParams) (AdvisorQueueEntry, error)
+	ListAIInteractionsToReencrypt(ctx context.Context, arg ListAIInteractionsToReencryptParams) ([]ListAIInteractionsToReencryptRow, error)
+	ListAdvisorApplicationsByStatus(ctx context.Context, arg ListAdvisorApplicationsByStatusParams) ([]AdvisorApplication, error)
+	ListAdvisorPricing(ctx context.Context, advisorID uuid.UUID) ([]AdvisorPricing, error)
 	ListAdvisors(ctx context.Context, arg ListAdvisorsParams) ([]ListAdvisorsRow, error)
+	ListBalanceTransactions(ctx context.Context, arg ListBalanceTransactionsParams) ([]BalanceTransaction, error)
+	ListClientNotes(ctx context.Context, arg ListClientNotesParams) ([]AdvisorClientNote, error)
+	ListCommissionTiers(ctx context.Context) ([]CommissionTier, error)
+	ListCredentialDocuments(ctx context.Context, applicationID uuid.UUID) ([]ListCredentialDocumentsRow, error)
+	// Metered chats with started minutes not charged yet, at least the minimum billable minutes
+	ListDueSessionCharges(ctx context.Context, sessionID uuid.NullUUID) ([]ListDueSessionChargesRow, error)
+	ListEarnings(ctx context.Context, arg ListEarningsParams) ([]AdvisorEarning, error)
+	ListEncryptionKeys(ctx context.Context) ([]EncryptionKey, error)
+	// Advisor chats with no message since the cutoff, counting from when the chat started
+	ListIdleSessions(ctx context.Context, idleSince sql.NullTime) ([]Session, error)
+	ListMessagesAfter(ctx context.Context, arg ListMessagesAfterParams) ([]ChatMessage, error)
+	// Newest first, so the page closest to the cursor comes back; the latest page without one
+	ListMessagesBefore(ctx context.Context, arg ListMessagesBeforeParams) ([]ChatMessage, error)
+	// Messages not encrypted with the active key or not indexed for search yet
+	ListMessagesToReencrypt(ctx context.Context, arg ListMessagesToReencryptParams) ([]ListMessagesToReencryptRow, error)
+	ListModerationRules(ctx context.Context) ([]ModerationRule, error)
+	ListModerationTerms(ctx context.Context) ([]string, error)
+	ListPayoutBatches(ctx context.Context, arg ListPayoutBatchesParams) ([]PayoutBatch, error)
+	ListPlatformTemplates(ctx context.Context) ([]MessageTemplate, error)
+	ListPricingForAdvisors(ctx context.Context, advisorIds []uuid.UUID) ([]AdvisorPricing, error)
+	// Front of each queue whose advisor is online, below chat capacity, not on a call and has no outstanding offer
+	ListQueueHeadsReadyForOffer(ctx context.Context) ([]AdvisorQueueEntry, error)
+	ListRevisionsToReencrypt(ctx context.Context, arg ListRevisionsToReencryptParams) ([]ListRevisionsToReencryptRow, error)
+	ListSessionCallLogs(ctx context.Context, sessionID uuid.UUID) ([]CallLog, error)
+	ListSessionRatings(ctx context.Context, sessionID uuid.UUID) ([]Rating, error)
+	ListSessionRequests(ctx context.Context, advisorID uuid.NullUUID) ([]Session, error)
+	// The advisor's own saved replies followed by active platform templates
+	ListTemplatesForAdvisor(ctx context.Context, advisorID uuid.NullUUID) ([]MessageTemplate, error)
+	ListTranscriptAttachments(ctx context.Context, sessionID uuid.UUID) ([]ChatAttachment, error)
+	ListTranscriptMessages(ctx context.Context, sessionID uuid.UUID) ([]ChatMessage, error)
+	// Edit and delete history of the session's messages, for admin exports
+	ListTranscriptRevisions(ctx context.Context, sessionID uuid.UUID) ([]ChatMessageRevision, error)
+	MarkPayoutBatchPaid(ctx context.Context, arg MarkPayoutBatchPaidParams) (PayoutBatch, error)
+	OfferQueueEntry(ctx context.Context, arg OfferQueueEntryParams) (AdvisorQueueEntry, error)
+	// Sessions with a price snapshot are billed per started minute (at least the minimum billable
+	// minutes, less any first-session discount); older sessions fall back to the hourly rate.
+	// Paid chats are metered while they run, so they earn what the user was charged.
+	RecordSessionEarning(ctx context.Context, id uuid.UUID) error
+	ReencryptAIInteraction(ctx context.Context, arg ReencryptAIInteractionParams) error
+	// Skips messages edited or deleted since they were read
+	ReencryptMessage(ctx context.Context, arg ReencryptMessageParams) (int64, error)
+	ReencryptRevision(ctx context.Context, arg ReencryptRevisionParams) error
+	// Recomputes metrics for advisor sessions that finished since the given time. Declined and expired
+	// requests count as not accepted. Sessions cancelled because a queued user never accepted the offer,
+	// or requests the user withdrew before the advisor answered, are not held against the advisor.
+	RefreshSessionMetrics(ctx context.Context, since sql.NullTime) (int64, error)
+	RemoveModerationTerms(ctx context.Context, terms []string) (int64, error)
+	RewrapEncryptionKey(ctx context.Context, arg RewrapEncryptionKeyParams) error
 	SearchFAQs(ctx context.Context, dollar_1 sql.NullString) ([]SearchFAQsRow, error)
+	// Messages of the member's sessions containing every search term, newest first
+	SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]SearchMessagesRow, error)
+	SetAdvisorAutoBusy(ctx context.Context, arg SetAdvisorAutoBusyParams) error
+	SetAdvisorChatCapacity(ctx context.Context, arg SetAdvisorChatCapacityParams) (Advisor, error)
+	SetAdvisorTier(ctx context.Context, arg SetAdvisorTierParams) error
+	// Paid chats start billing with the advisor's first reply
+	StartSessionBilling(ctx context.Context, id uuid.UUID) (int64, error)
 	SubmitFeedback(ctx context.Context, arg SubmitFeedbackParams) error
+	// Messages in all of the member's sessions after the cursor, oldest first; everything without one
+	SyncMessages(ctx context.Context, arg SyncMessagesParams) ([]ChatMessage, error)
+	TransitionAdvisorApplication(ctx context.Context, arg TransitionAdvisorApplicationParams) (AdvisorApplication, error)
 	UpdateAdminFlagStatus(ctx context.Context, arg UpdateAdminFlagStatusParams) error
 	UpdateAdvisor(ctx context.Context, arg UpdateAdvisorParams) (Advisor, error)
+	// Advisor Application Workflow
+	UpdateAdvisorApplicationProfile(ctx context.Context, arg UpdateAdvisorApplicationProfileParams) (Advisor, error)
 	UpdateAdvisorStatus(ctx context.Context, arg UpdateAdvisorStatusParams) error
+	UpdateAdvisorTemplate(ctx context.Context, arg UpdateAdvisorTemplateParams) (MessageTemplate, error)
 	// Call Status and Feedback
 	UpdateCallStatus(ctx context.Context, arg UpdateCallStatusParams) error
+	UpdateClientNote(ctx context.Context, arg UpdateClientNoteParams) (AdvisorClientNote, error)
 	UpdateFAQ(ctx context.Context, arg UpdateFAQParams) error
-	UpdateMessageReadStatus(ctx context.Context, id uuid.UUID) error
+	// Marks what the other participant sent up to the reader's watermark as read
+	UpdateMessageReadStatus(ctx context.Context, arg UpdateMessageReadStatusParams) (int64, error)
+	UpdateModerationRule(ctx context.Context, arg UpdateModerationRuleParams) (ModerationRule, error)
+	UpdatePlatformTemplate(ctx context.Context, arg UpdatePlatformTemplateParams) (MessageTemplate, error)
 	UpdateSessionStatus(ctx context.Context, arg UpdateSessionStatusParams) error
 	UpdateSpecialization(ctx context.Context, arg UpdateSpecializationParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
@@ -86,6 +256,11 @@ type Querier interface {
 	UpdateUserCredentials(ctx context.Context, arg UpdateUserCredentialsParams) (UpdateUserCredentialsRow, error)
 	UpdateUserFCMToken(ctx context.Context, arg UpdateUserFCMTokenParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpsertAdvisorPricing(ctx context.Context, arg UpsertAdvisorPricingParams) (AdvisorPricing, error)
+	UpsertCommissionTier(ctx context.Context, arg UpsertCommissionTierParams) (CommissionTier, error)
+	// Only ever moves the watermark forward; no row is returned when it was already further along
+	UpsertReadReceipt(ctx context.Context, arg UpsertReadReceiptParams) (ChatReadReceipt, error)
+	WithdrawSessionRequest(ctx context.Context, arg WithdrawSessionRequestParams) (Session, error)
 }
 
 var _ Querier = (*Queries)(nil)
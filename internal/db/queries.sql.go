@@ -8,11 +8,145 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const acceptQueueOffer = `-- name: AcceptQueueOffer :one
+WITH accepted AS (
+    UPDATE advisor_queue_entries q
+    SET status = 'ACCEPTED', updated_at = NOW()
+    FROM sessions s
+    WHERE q.id = $1 AND q.user_id = $2 AND q.status = 'OFFERED' AND q.offer_expires_at > NOW()
+      AND s.id = q.session_id AND s.status = 'REQUESTED'
+    RETURNING q.session_id
+)
+UPDATE sessions SET status = 'ONGOING', accepted_at = NOW(), started_at = NOW()
+FROM accepted
+WHERE sessions.id = accepted.session_id AND sessions.status = 'REQUESTED'
+RETURNING sessions.id, sessions.user_id, sessions.advisor_id, sessions.type, sessions.started_at, sessions.ended_at, sessions.status, sessions.pricing_type, sessions.price_per_minute, sessions.min_billable_minutes, sessions.discount_percent, sessions.currency, sessions.requested_at, sessions.accepted_at, sessions.billing_started_at, sessions.billed_minutes, sessions.billed_amount
+`
+
+type AcceptQueueOfferParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Queued sessions are REQUESTED when the offer is made and start once the user accepts,
+// so billing runs from acceptance
+func (q *Queries) AcceptQueueOffer(ctx context.Context, arg AcceptQueueOfferParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, acceptQueueOffer, arg.ID, arg.UserID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const acceptSessionRequest = `-- name: AcceptSessionRequest :one
+UPDATE sessions SET status = 'ONGOING', accepted_at = NOW(), started_at = NOW()
+WHERE sessions.id = $1 AND sessions.advisor_id = $2 AND sessions.status = 'REQUESTED'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
+`
+
+type AcceptSessionRequestParams struct {
+	ID        uuid.UUID     `json:"id"`
+	AdvisorID uuid.NullUUID `json:"advisor_id"`
+}
+
+// Billing runs from acceptance, so started_at is reset. Sessions offered to a queued user
+// are accepted by the user, through AcceptQueueOffer.
+func (q *Queries) AcceptSessionRequest(ctx context.Context, arg AcceptSessionRequestParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, acceptSessionRequest, arg.ID, arg.AdvisorID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const addContentKey = `-- name: AddContentKey :one
+INSERT INTO encryption_keys (purpose, wrapped_key, kek_id)
+SELECT 'CONTENT', $1::bytea, $2::text
+WHERE NOT EXISTS (SELECT 1 FROM encryption_keys WHERE purpose = 'CONTENT' AND created_at > $3::timestamptz)
+RETURNING id, purpose, wrapped_key, kek_id, created_at
+`
+
+type AddContentKeyParams struct {
+	WrappedKey   []byte    `json:"wrapped_key"`
+	KekID        string    `json:"kek_id"`
+	CreatedAfter time.Time `json:"created_after"`
+}
+
+// Adds a CONTENT key unless one was added after the cutoff, so instances rotating on a
+// schedule add a single key between them
+func (q *Queries) AddContentKey(ctx context.Context, arg AddContentKeyParams) (EncryptionKey, error) {
+	row := q.db.QueryRowContext(ctx, addContentKey, arg.WrappedKey, arg.KekID, arg.CreatedAfter)
+	var i EncryptionKey
+	err := row.Scan(
+		&i.ID,
+		&i.Purpose,
+		&i.WrappedKey,
+		&i.KekID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const addModerationTerms = `-- name: AddModerationTerms :execrows
+INSERT INTO moderation_terms (term, created_by)
+SELECT UNNEST($1::text[]), $2
+ON CONFLICT (term) DO NOTHING
+`
+
+type AddModerationTermsParams struct {
+	Terms     []string      `json:"terms"`
+	CreatedBy uuid.NullUUID `json:"created_by"`
+}
+
+func (q *Queries) AddModerationTerms(ctx context.Context, arg AddModerationTermsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addModerationTerms, pq.Array(arg.Terms), arg.CreatedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const approveAdvisor = `-- name: ApproveAdvisor :exec
 UPDATE advisors SET is_verified = TRUE, status = 'OFFLINE' WHERE id = $1
 `
@@ -31,6 +165,69 @@ func (q *Queries) BlockUser(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const cancelSession = `-- name: CancelSession :exec
+UPDATE sessions SET status = 'CANCELLED', ended_at = NOW()
+WHERE id = $1 AND status = 'REQUESTED'
+`
+
+func (q *Queries) CancelSession(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, cancelSession, id)
+	return err
+}
+
+const chargeSessionMinute = `-- name: ChargeSessionMinute :one
+WITH due AS (
+    SELECT s.id FROM sessions s
+    JOIN user_balances b ON b.user_id = s.user_id
+    WHERE s.id = $1
+      AND s.status = 'ONGOING'
+      AND s.billed_minutes = $2
+      AND b.balance >= $3::numeric
+    FOR UPDATE OF s, b
+), claimed AS (
+    UPDATE sessions
+    SET billed_minutes = billed_minutes + 1, billed_amount = billed_amount + $3::numeric
+    FROM due
+    WHERE sessions.id = due.id
+    RETURNING sessions.id, sessions.user_id, sessions.billed_minutes, sessions.billed_amount
+), charged AS (
+    UPDATE user_balances
+    SET balance = balance - $3::numeric, updated_at = NOW()
+    FROM claimed
+    WHERE user_balances.user_id = claimed.user_id
+      AND user_balances.balance >= $3::numeric
+    RETURNING user_balances.user_id, user_balances.balance
+), recorded AS (
+    INSERT INTO balance_transactions (user_id, kind, amount, balance_after, session_id)
+    SELECT charged.user_id, 'CHARGE', -$3::numeric, charged.balance, claimed.id
+    FROM charged, claimed
+)
+SELECT claimed.billed_minutes, claimed.billed_amount::text AS billed_amount, charged.balance::text AS balance
+FROM claimed, charged
+`
+
+type ChargeSessionMinuteParams struct {
+	SessionID     uuid.UUID `json:"session_id"`
+	BilledMinutes int32     `json:"billed_minutes"`
+	Amount        string    `json:"amount"`
+}
+
+type ChargeSessionMinuteRow struct {
+	BilledMinutes int32  `json:"billed_minutes"`
+	BilledAmount  string `json:"billed_amount"`
+	Balance       string `json:"balance"`
+}
+
+// Charges the minute after billed_minutes if nobody charged it yet and the balance covers it.
+// The session and the balance are locked first, so a concurrent charge of either is seen
+// and the minute is claimed only together with its charge.
+func (q *Queries) ChargeSessionMinute(ctx context.Context, arg ChargeSessionMinuteParams) (ChargeSessionMinuteRow, error) {
+	row := q.db.QueryRowContext(ctx, chargeSessionMinute, arg.SessionID, arg.BilledMinutes, arg.Amount)
+	var i ChargeSessionMinuteRow
+	err := row.Scan(&i.BilledMinutes, &i.BilledAmount, &i.Balance)
+	return i, err
+}
+
 const countCompletedSessions = `-- name: CountCompletedSessions :one
 SELECT COUNT(*) FROM sessions WHERE user_id = $1 AND status = 'ENDED'
 `
@@ -42,6 +239,64 @@ func (q *Queries) CountCompletedSessions(ctx context.Context, userID uuid.UUID)
 	return count, err
 }
 
+const countContentByKey = `-- name: CountContentByKey :many
+SELECT c.content_key_id, COUNT(*)::int AS content_count FROM (
+    SELECT content_key_id FROM chat_messages WHERE content <> ''
+    UNION ALL
+    SELECT content_key_id FROM chat_message_revisions WHERE content <> ''
+    UNION ALL
+    SELECT content_key_id FROM ai_interactions
+) c
+GROUP BY c.content_key_id
+`
+
+type CountContentByKeyRow struct {
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+	ContentCount int32         `json:"content_count"`
+}
+
+// How much content each key encrypts; plaintext has no key
+func (q *Queries) CountContentByKey(ctx context.Context) ([]CountContentByKeyRow, error) {
+	rows, err := q.db.QueryContext(ctx, countContentByKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountContentByKeyRow
+	for rows.Next() {
+		var i CountContentByKeyRow
+		if err := rows.Scan(&i.ContentKeyID, &i.ContentCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countOngoingAdvisorSessions = `-- name: CountOngoingAdvisorSessions :one
+SELECT COUNT(*) FILTER (WHERE type = 'CALL')::int AS calls,
+       COUNT(*) FILTER (WHERE type <> 'CALL')::int AS chats
+FROM sessions WHERE advisor_id = $1 AND status IN ('REQUESTED', 'ONGOING')
+`
+
+type CountOngoingAdvisorSessionsRow struct {
+	Calls int32 `json:"calls"`
+	Chats int32 `json:"chats"`
+}
+
+func (q *Queries) CountOngoingAdvisorSessions(ctx context.Context, advisorID uuid.NullUUID) (CountOngoingAdvisorSessionsRow, error) {
+	row := q.db.QueryRowContext(ctx, countOngoingAdvisorSessions, advisorID)
+	var i CountOngoingAdvisorSessionsRow
+	err := row.Scan(&i.Calls, &i.Chats)
+	return i, err
+}
+
 const countPendingReports = `-- name: CountPendingReports :one
 SELECT COUNT(*) FROM admin_flags WHERE status = 'PENDING'
 `
@@ -64,6 +319,22 @@ func (q *Queries) CountResolvedReports(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countSessionsBetween = `-- name: CountSessionsBetween :one
+SELECT COUNT(*)::INTEGER FROM sessions WHERE advisor_id = $1 AND user_id = $2
+`
+
+type CountSessionsBetweenParams struct {
+	AdvisorUserID uuid.NullUUID `json:"advisor_user_id"`
+	UserID        uuid.UUID     `json:"user_id"`
+}
+
+func (q *Queries) CountSessionsBetween(ctx context.Context, arg CountSessionsBetweenParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countSessionsBetween, arg.AdvisorUserID, arg.UserID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const countTotalReports = `-- name: CountTotalReports :one
 SELECT COUNT(*) FROM admin_flags
 `
@@ -75,6 +346,17 @@ func (q *Queries) CountTotalReports(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countUnindexedMessages = `-- name: CountUnindexedMessages :one
+SELECT COUNT(*)::int FROM chat_messages WHERE content <> '' AND search_terms IS NULL
+`
+
+func (q *Queries) CountUnindexedMessages(ctx context.Context) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countUnindexedMessages)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const countUserSessions = `-- name: CountUserSessions :one
 SELECT COUNT(*) FROM sessions WHERE user_id = $1
 `
@@ -89,11 +371,11 @@ func (q *Queries) CountUserSessions(ctx context.Context, userID uuid.UUID) (int6
 const createAdminFlag = `-- name: CreateAdminFlag :one
 INSERT INTO admin_flags (reported_by, reported_user_id, reported_advisor_id, session_id, reason)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status
+RETURNING id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id
 `
 
 type CreateAdminFlagParams struct {
-	ReportedBy        uuid.UUID     `json:"reported_by"`
+	ReportedBy        uuid.NullUUID `json:"reported_by"`
 	ReportedUserID    uuid.NullUUID `json:"reported_user_id"`
 	ReportedAdvisorID uuid.NullUUID `json:"reported_advisor_id"`
 	SessionID         uuid.NullUUID `json:"session_id"`
@@ -118,6 +400,8 @@ func (q *Queries) CreateAdminFlag(ctx context.Context, arg CreateAdminFlagParams
 		&i.SessionID,
 		&i.CreatedAt,
 		&i.Status,
+		&i.Source,
+		&i.MessageID,
 	)
 	return i, err
 }
@@ -125,7 +409,7 @@ func (q *Queries) CreateAdminFlag(ctx context.Context, arg CreateAdminFlagParams
 const createAdvisor = `-- name: CreateAdvisor :one
 INSERT INTO advisors (user_id, bio, experience_years, languages, specializations, hourly_rate)
 VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at
+RETURNING id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at, tier, max_concurrent_chats, auto_busy
 `
 
 type CreateAdvisorParams struct {
@@ -159,14 +443,61 @@ func (q *Queries) CreateAdvisor(ctx context.Context, arg CreateAdvisorParams) (A
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Tier,
+		&i.MaxConcurrentChats,
+		&i.AutoBusy,
+	)
+	return i, err
+}
+
+const createAdvisorApplication = `-- name: CreateAdvisorApplication :one
+INSERT INTO advisor_applications (advisor_id, user_id, status, submitted_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, advisor_id, user_id, status, reviewer_id, reviewer_notes, rejection_reason, submitted_at, reviewed_at, created_at, updated_at
+`
+
+type CreateAdvisorApplicationParams struct {
+	AdvisorID   uuid.UUID    `json:"advisor_id"`
+	UserID      uuid.UUID    `json:"user_id"`
+	Status      string       `json:"status"`
+	SubmittedAt sql.NullTime `json:"submitted_at"`
+}
+
+func (q *Queries) CreateAdvisorApplication(ctx context.Context, arg CreateAdvisorApplicationParams) (AdvisorApplication, error) {
+	row := q.db.QueryRowContext(ctx, createAdvisorApplication,
+		arg.AdvisorID,
+		arg.UserID,
+		arg.Status,
+		arg.SubmittedAt,
+	)
+	var i AdvisorApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.ReviewerID,
+		&i.ReviewerNotes,
+		&i.RejectionReason,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const createCallSession = `-- name: CreateCallSession :one
-INSERT INTO sessions (user_id, advisor_id, type)
-VALUES ($1, $2, 'CALL')
-RETURNING id, user_id, advisor_id, type, started_at, ended_at, status
+INSERT INTO sessions (user_id, advisor_id, type, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency)
+SELECT $1::uuid, $2::uuid, 'CALL', p.session_type, p.per_minute_rate, p.min_billable_minutes,
+       CASE WHEN p.id IS NULL THEN NULL
+            WHEN EXISTS (SELECT 1 FROM sessions prev WHERE prev.user_id = $1 AND prev.advisor_id = $2) THEN 0
+            ELSE p.first_session_discount_percent END,
+       p.currency
+FROM (SELECT 1) AS one
+LEFT JOIN advisors a ON a.user_id = $2
+LEFT JOIN advisor_pricing p ON p.advisor_id = a.id AND p.session_type = 'CALL'
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
 `
 
 type CreateCallSessionParams struct {
@@ -185,6 +516,114 @@ func (q *Queries) CreateCallSession(ctx context.Context, arg CreateCallSessionPa
 		&i.StartedAt,
 		&i.EndedAt,
 		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const createChatAttachment = `-- name: CreateChatAttachment :one
+INSERT INTO chat_attachments (session_id, uploader_id, kind, mime_type, size_bytes, duration_ms, storage_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, session_id, uploader_id, message_id, kind, mime_type, size_bytes, duration_ms, storage_key, created_at
+`
+
+type CreateChatAttachmentParams struct {
+	SessionID  uuid.UUID     `json:"session_id"`
+	UploaderID uuid.UUID     `json:"uploader_id"`
+	Kind       string        `json:"kind"`
+	MimeType   string        `json:"mime_type"`
+	SizeBytes  int64         `json:"size_bytes"`
+	DurationMs sql.NullInt32 `json:"duration_ms"`
+	StorageKey string        `json:"storage_key"`
+}
+
+func (q *Queries) CreateChatAttachment(ctx context.Context, arg CreateChatAttachmentParams) (ChatAttachment, error) {
+	row := q.db.QueryRowContext(ctx, createChatAttachment,
+		arg.SessionID,
+		arg.UploaderID,
+		arg.Kind,
+		arg.MimeType,
+		arg.SizeBytes,
+		arg.DurationMs,
+		arg.StorageKey,
+	)
+	var i ChatAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.UploaderID,
+		&i.MessageID,
+		&i.Kind,
+		&i.MimeType,
+		&i.SizeBytes,
+		&i.DurationMs,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createClientNote = `-- name: CreateClientNote :one
+INSERT INTO advisor_client_notes (advisor_id, user_id, session_id, encrypted_content)
+VALUES ($1, $2, $3, $4)
+RETURNING id, advisor_id, user_id, session_id, encrypted_content, created_at, updated_at
+`
+
+type CreateClientNoteParams struct {
+	AdvisorID        uuid.UUID     `json:"advisor_id"`
+	UserID           uuid.UUID     `json:"user_id"`
+	SessionID        uuid.NullUUID `json:"session_id"`
+	EncryptedContent []byte        `json:"encrypted_content"`
+}
+
+func (q *Queries) CreateClientNote(ctx context.Context, arg CreateClientNoteParams) (AdvisorClientNote, error) {
+	row := q.db.QueryRowContext(ctx, createClientNote,
+		arg.AdvisorID,
+		arg.UserID,
+		arg.SessionID,
+		arg.EncryptedContent,
+	)
+	var i AdvisorClientNote
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.SessionID,
+		&i.EncryptedContent,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createEncryptionKey = `-- name: CreateEncryptionKey :one
+INSERT INTO encryption_keys (purpose, wrapped_key, kek_id) VALUES ($1, $2, $3) RETURNING id, purpose, wrapped_key, kek_id, created_at
+`
+
+type CreateEncryptionKeyParams struct {
+	Purpose    string `json:"purpose"`
+	WrappedKey []byte `json:"wrapped_key"`
+	KekID      string `json:"kek_id"`
+}
+
+func (q *Queries) CreateEncryptionKey(ctx context.Context, arg CreateEncryptionKeyParams) (EncryptionKey, error) {
+	row := q.db.QueryRowContext(ctx, createEncryptionKey, arg.Purpose, arg.WrappedKey, arg.KekID)
+	var i EncryptionKey
+	err := row.Scan(
+		&i.ID,
+		&i.Purpose,
+		&i.WrappedKey,
+		&i.KekID,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -223,6 +662,126 @@ func (q *Queries) CreateFeedbackPrompt(ctx context.Context, arg CreateFeedbackPr
 	return id, err
 }
 
+const createMessageTemplate = `-- name: CreateMessageTemplate :one
+INSERT INTO message_templates (advisor_id, title, body, category, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, advisor_id, title, body, category, is_active, created_by, created_at, updated_at
+`
+
+type CreateMessageTemplateParams struct {
+	AdvisorID uuid.NullUUID  `json:"advisor_id"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Category  sql.NullString `json:"category"`
+	CreatedBy uuid.NullUUID  `json:"created_by"`
+}
+
+func (q *Queries) CreateMessageTemplate(ctx context.Context, arg CreateMessageTemplateParams) (MessageTemplate, error) {
+	row := q.db.QueryRowContext(ctx, createMessageTemplate,
+		arg.AdvisorID,
+		arg.Title,
+		arg.Body,
+		arg.Category,
+		arg.CreatedBy,
+	)
+	var i MessageTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.Title,
+		&i.Body,
+		&i.Category,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createModerationFlag = `-- name: CreateModerationFlag :one
+INSERT INTO admin_flags (reported_user_id, reported_advisor_id, session_id, message_id, reason, source)
+VALUES ($1, $2, $3, $4, $5, 'MODERATION')
+RETURNING id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id
+`
+
+type CreateModerationFlagParams struct {
+	ReportedUserID    uuid.NullUUID `json:"reported_user_id"`
+	ReportedAdvisorID uuid.NullUUID `json:"reported_advisor_id"`
+	SessionID         uuid.NullUUID `json:"session_id"`
+	MessageID         uuid.NullUUID `json:"message_id"`
+	Reason            string        `json:"reason"`
+}
+
+func (q *Queries) CreateModerationFlag(ctx context.Context, arg CreateModerationFlagParams) (AdminFlag, error) {
+	row := q.db.QueryRowContext(ctx, createModerationFlag,
+		arg.ReportedUserID,
+		arg.ReportedAdvisorID,
+		arg.SessionID,
+		arg.MessageID,
+		arg.Reason,
+	)
+	var i AdminFlag
+	err := row.Scan(
+		&i.ID,
+		&i.ReportedBy,
+		&i.ReportedUserID,
+		&i.ReportedAdvisorID,
+		&i.Reason,
+		&i.SessionID,
+		&i.CreatedAt,
+		&i.Status,
+		&i.Source,
+		&i.MessageID,
+	)
+	return i, err
+}
+
+const createPayoutBatch = `-- name: CreatePayoutBatch :one
+WITH assigned AS (
+    UPDATE advisor_earnings
+    SET payout_batch_id = $1
+    WHERE advisor_earnings.advisor_id = $2 AND payout_batch_id IS NULL AND created_at < $3
+    RETURNING net_amount, created_at
+)
+INSERT INTO payout_batches (id, advisor_id, period_start, period_end, earnings_count, total_amount, created_by)
+SELECT $1, $2, MIN(assigned.created_at), $3, COUNT(*), COALESCE(SUM(assigned.net_amount), 0), $4
+FROM assigned
+HAVING COUNT(*) > 0
+RETURNING id, advisor_id, status, period_start, period_end, earnings_count, total_amount, created_by, paid_at, payment_reference, created_at
+`
+
+type CreatePayoutBatchParams struct {
+	ID        uuid.UUID `json:"id"`
+	AdvisorID uuid.UUID `json:"advisor_id"`
+	PeriodEnd time.Time `json:"period_end"`
+	CreatedBy uuid.UUID `json:"created_by"`
+}
+
+func (q *Queries) CreatePayoutBatch(ctx context.Context, arg CreatePayoutBatchParams) (PayoutBatch, error) {
+	row := q.db.QueryRowContext(ctx, createPayoutBatch,
+		arg.ID,
+		arg.AdvisorID,
+		arg.PeriodEnd,
+		arg.CreatedBy,
+	)
+	var i PayoutBatch
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.Status,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.EarningsCount,
+		&i.TotalAmount,
+		&i.CreatedBy,
+		&i.PaidAt,
+		&i.PaymentReference,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createRating = `-- name: CreateRating :one
 INSERT INTO ratings (session_id, user_id, advisor_id, rating, review_text)
 VALUES ($1, $2, $3, $4, $5)
@@ -259,19 +818,40 @@ func (q *Queries) CreateRating(ctx context.Context, arg CreateRatingParams) (Rat
 }
 
 const createSession = `-- name: CreateSession :one
-INSERT INTO sessions (user_id, advisor_id, type)
-VALUES ($1, $2, $3)
-RETURNING id, user_id, advisor_id, type, started_at, ended_at, status
+INSERT INTO sessions (user_id, advisor_id, type, status, requested_at, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency)
+SELECT $1::uuid, $2::uuid, $3::text, $4::text,
+       CASE WHEN $4::text = 'REQUESTED' THEN NOW() END,
+       p.session_type, p.per_minute_rate, p.min_billable_minutes,
+       CASE WHEN p.id IS NULL THEN NULL
+            WHEN EXISTS (SELECT 1 FROM sessions prev WHERE prev.user_id = $1 AND prev.advisor_id = $2
+                         AND prev.status NOT IN ('REQUESTED', 'DECLINED', 'EXPIRED')) THEN 0
+            ELSE p.first_session_discount_percent END,
+       p.currency
+FROM (SELECT 1) AS one
+LEFT JOIN advisors a ON a.user_id = $2
+LEFT JOIN advisor_pricing p ON p.advisor_id = a.id AND p.session_type = $5::text
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
 `
 
 type CreateSessionParams struct {
-	UserID    uuid.UUID     `json:"user_id"`
-	AdvisorID uuid.NullUUID `json:"advisor_id"`
-	Type      string        `json:"type"`
+	UserID      uuid.UUID     `json:"user_id"`
+	AdvisorID   uuid.NullUUID `json:"advisor_id"`
+	Type        string        `json:"type"`
+	Status      string        `json:"status"`
+	PricingType string        `json:"pricing_type"`
 }
 
+// Snapshots the advisor's current price for the pricing type; the first-session discount
+// only applies when the user has never had a session with this advisor. Sessions created as
+// REQUESTED wait for the advisor to accept them.
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
-	row := q.db.QueryRowContext(ctx, createSession, arg.UserID, arg.AdvisorID, arg.Type)
+	row := q.db.QueryRowContext(ctx, createSession,
+		arg.UserID,
+		arg.AdvisorID,
+		arg.Type,
+		arg.Status,
+		arg.PricingType,
+	)
 	var i Session
 	err := row.Scan(
 		&i.ID,
@@ -281,6 +861,16 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) (S
 		&i.StartedAt,
 		&i.EndedAt,
 		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
 	)
 	return i, err
 }
@@ -344,24 +934,271 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
-const deleteFAQ = `-- name: DeleteFAQ :exec
-DELETE FROM faqs WHERE id = $1
+const creditUserBalance = `-- name: CreditUserBalance :one
+WITH credited AS (
+    INSERT INTO user_balances (user_id, balance) VALUES ($4, $1::numeric)
+    ON CONFLICT (user_id) DO UPDATE SET balance = user_balances.balance + EXCLUDED.balance, updated_at = NOW()
+    RETURNING user_id, balance
+)
+INSERT INTO balance_transactions (user_id, kind, amount, balance_after, created_by, note)
+SELECT credited.user_id, 'CREDIT', $1::numeric, credited.balance, $2, $3
+FROM credited
+RETURNING id, user_id, kind, amount, balance_after, session_id, created_by, note, created_at
 `
 
-func (q *Queries) DeleteFAQ(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteFAQ, id)
-	return err
+type CreditUserBalanceParams struct {
+	Amount    string         `json:"amount"`
+	CreatedBy uuid.NullUUID  `json:"created_by"`
+	Note      sql.NullString `json:"note"`
+	UserID    uuid.UUID      `json:"user_id"`
 }
 
-const deleteSpecialization = `-- name: DeleteSpecialization :exec
-DELETE FROM specializations WHERE id = $1
-`
-
-func (q *Queries) DeleteSpecialization(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteSpecialization, id)
+func (q *Queries) CreditUserBalance(ctx context.Context, arg CreditUserBalanceParams) (BalanceTransaction, error) {
+	row := q.db.QueryRowContext(ctx, creditUserBalance,
+		arg.Amount,
+		arg.CreatedBy,
+		arg.Note,
+		arg.UserID,
+	)
+	var i BalanceTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Kind,
+		&i.Amount,
+		&i.BalanceAfter,
+		&i.SessionID,
+		&i.CreatedBy,
+		&i.Note,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const declineSessionRequest = `-- name: DeclineSessionRequest :one
+UPDATE sessions SET status = 'DECLINED', ended_at = NOW()
+WHERE sessions.id = $1 AND sessions.advisor_id = $2 AND sessions.status = 'REQUESTED'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
+`
+
+type DeclineSessionRequestParams struct {
+	ID        uuid.UUID     `json:"id"`
+	AdvisorID uuid.NullUUID `json:"advisor_id"`
+}
+
+func (q *Queries) DeclineSessionRequest(ctx context.Context, arg DeclineSessionRequestParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, declineSessionRequest, arg.ID, arg.AdvisorID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const deleteAdvisorTemplate = `-- name: DeleteAdvisorTemplate :execrows
+DELETE FROM message_templates WHERE id = $1 AND advisor_id = $2
+`
+
+type DeleteAdvisorTemplateParams struct {
+	ID        uuid.UUID     `json:"id"`
+	AdvisorID uuid.NullUUID `json:"advisor_id"`
+}
+
+func (q *Queries) DeleteAdvisorTemplate(ctx context.Context, arg DeleteAdvisorTemplateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAdvisorTemplate, arg.ID, arg.AdvisorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteChatMessage = `-- name: DeleteChatMessage :one
+WITH previous AS (
+    SELECT c.id, c.content, c.content_key_id FROM chat_messages c
+    WHERE c.id = $1 AND c.session_id = $2 AND c.sender_id = $3
+      AND c.deleted_at IS NULL
+    FOR UPDATE
+), revision AS (
+    INSERT INTO chat_message_revisions (message_id, action, content, content_key_id)
+    SELECT previous.id, 'DELETE', previous.content, previous.content_key_id FROM previous
+)
+UPDATE chat_messages m SET content = '', content_key_id = NULL, search_terms = NULL, deleted_at = NOW()
+FROM previous
+WHERE m.id = previous.id
+RETURNING m.id, m.session_id, m.sender_type, m.sender_id, m.content, m.created_at, m.is_read, m.read_at, m.edited_at, m.deleted_at, m.kind, m.client_message_id, m.content_key_id, m.search_terms
+`
+
+type DeleteChatMessageParams struct {
+	ID        uuid.UUID `json:"id"`
+	SessionID uuid.UUID `json:"session_id"`
+	SenderID  uuid.UUID `json:"sender_id"`
+}
+
+// Turns the sender's own message into a tombstone, keeping the old content
+func (q *Queries) DeleteChatMessage(ctx context.Context, arg DeleteChatMessageParams) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, deleteChatMessage, arg.ID, arg.SessionID, arg.SenderID)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.SenderType,
+		&i.SenderID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.IsRead,
+		&i.ReadAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Kind,
+		&i.ClientMessageID,
+		&i.ContentKeyID,
+		pq.Array(&i.SearchTerms),
+	)
+	return i, err
+}
+
+const deleteClientNote = `-- name: DeleteClientNote :execrows
+DELETE FROM advisor_client_notes WHERE id = $1 AND advisor_id = $2
+`
+
+type DeleteClientNoteParams struct {
+	ID        uuid.UUID `json:"id"`
+	AdvisorID uuid.UUID `json:"advisor_id"`
+}
+
+func (q *Queries) DeleteClientNote(ctx context.Context, arg DeleteClientNoteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteClientNote, arg.ID, arg.AdvisorID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteFAQ = `-- name: DeleteFAQ :exec
+DELETE FROM faqs WHERE id = $1
+`
+
+func (q *Queries) DeleteFAQ(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteFAQ, id)
+	return err
+}
+
+const deletePlatformTemplate = `-- name: DeletePlatformTemplate :execrows
+DELETE FROM message_templates WHERE id = $1 AND advisor_id IS NULL
+`
+
+func (q *Queries) DeletePlatformTemplate(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePlatformTemplate, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteRetiredEncryptionKeys = `-- name: DeleteRetiredEncryptionKeys :execrows
+DELETE FROM encryption_keys k
+WHERE k.purpose = 'CONTENT'
+  AND EXISTS (SELECT 1 FROM encryption_keys newer
+              WHERE newer.purpose = 'CONTENT' AND newer.created_at > k.created_at AND newer.created_at < $1)
+  AND NOT EXISTS (SELECT 1 FROM chat_messages m WHERE m.content_key_id = k.id)
+  AND NOT EXISTS (SELECT 1 FROM chat_message_revisions r WHERE r.content_key_id = k.id)
+  AND NOT EXISTS (SELECT 1 FROM ai_interactions a WHERE a.content_key_id = k.id)
+`
+
+// CONTENT keys superseded before the cutoff that nothing is encrypted with anymore. The
+// cutoff leaves every instance time to start using the newer key.
+func (q *Queries) DeleteRetiredEncryptionKeys(ctx context.Context, supersededBefore time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteRetiredEncryptionKeys, supersededBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteSpecialization = `-- name: DeleteSpecialization :exec
+DELETE FROM specializations WHERE id = $1
+`
+
+func (q *Queries) DeleteSpecialization(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSpecialization, id)
 	return err
 }
 
+const editChatMessage = `-- name: EditChatMessage :one
+WITH previous AS (
+    SELECT c.id, c.content, c.content_key_id FROM chat_messages c
+    WHERE c.id = $4 AND c.session_id = $5 AND c.sender_id = $6
+      AND c.deleted_at IS NULL AND c.created_at >= $7
+    FOR UPDATE
+), revision AS (
+    INSERT INTO chat_message_revisions (message_id, action, content, content_key_id)
+    SELECT previous.id, 'EDIT', previous.content, previous.content_key_id FROM previous
+)
+UPDATE chat_messages m SET content = $1, content_key_id = $2,
+    search_terms = $3::text[], edited_at = NOW()
+FROM previous
+WHERE m.id = previous.id
+RETURNING m.id, m.session_id, m.sender_type, m.sender_id, m.content, m.created_at, m.is_read, m.read_at, m.edited_at, m.deleted_at, m.kind, m.client_message_id, m.content_key_id, m.search_terms
+`
+
+type EditChatMessageParams struct {
+	Content       string        `json:"content"`
+	ContentKeyID  uuid.NullUUID `json:"content_key_id"`
+	SearchTerms   []string      `json:"search_terms"`
+	ID            uuid.UUID     `json:"id"`
+	SessionID     uuid.UUID     `json:"session_id"`
+	SenderID      uuid.UUID     `json:"sender_id"`
+	EditableSince sql.NullTime  `json:"editable_since"`
+}
+
+// Replaces the content of the sender's own message if it is still editable, keeping the old content
+func (q *Queries) EditChatMessage(ctx context.Context, arg EditChatMessageParams) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, editChatMessage,
+		arg.Content,
+		arg.ContentKeyID,
+		pq.Array(arg.SearchTerms),
+		arg.ID,
+		arg.SessionID,
+		arg.SenderID,
+		arg.EditableSince,
+	)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.SenderType,
+		&i.SenderID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.IsRead,
+		&i.ReadAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Kind,
+		&i.ClientMessageID,
+		&i.ContentKeyID,
+		pq.Array(&i.SearchTerms),
+	)
+	return i, err
+}
+
 const endCall = `-- name: EndCall :exec
 UPDATE sessions SET status = 'ENDED', ended_at = NOW() WHERE id = $1
 `
@@ -371,8 +1208,288 @@ func (q *Queries) EndCall(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const endIdleSession = `-- name: EndIdleSession :one
+UPDATE sessions s SET status = 'ENDED', ended_at = NOW()
+WHERE s.id = $1
+  AND s.status = 'ONGOING'
+  AND NOT EXISTS (
+      SELECT 1 FROM chat_messages m WHERE m.session_id = s.id AND m.created_at >= $2
+  )
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
+`
+
+type EndIdleSessionParams struct {
+	ID        uuid.UUID    `json:"id"`
+	IdleSince sql.NullTime `json:"idle_since"`
+}
+
+// Ends a chat found by ListIdleSessions unless a message arrived or it ended meanwhile
+func (q *Queries) EndIdleSession(ctx context.Context, arg EndIdleSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, endIdleSession, arg.ID, arg.IdleSince)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const endSessionForFunds = `-- name: EndSessionForFunds :one
+UPDATE sessions SET status = 'ENDED', ended_at = NOW()
+WHERE id = $1 AND status = 'ONGOING'
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
+`
+
+func (q *Queries) EndSessionForFunds(ctx context.Context, id uuid.UUID) (Session, error) {
+	row := q.db.QueryRowContext(ctx, endSessionForFunds, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const expireQueueOffers = `-- name: ExpireQueueOffers :many
+UPDATE advisor_queue_entries q
+SET status = 'EXPIRED', updated_at = NOW()
+WHERE q.status = 'OFFERED'
+  AND (q.offer_expires_at <= NOW()
+       OR NOT EXISTS (SELECT 1 FROM sessions s WHERE s.id = q.session_id AND s.status = 'REQUESTED'))
+RETURNING q.id, q.advisor_id, q.user_id, q.status, q.session_id, q.offered_at, q.offer_expires_at, q.created_at, q.updated_at
+`
+
+// Offers also expire once their session is no longer waiting, e.g. after the request
+// timeout or when the user withdrew it
+func (q *Queries) ExpireQueueOffers(ctx context.Context) ([]AdvisorQueueEntry, error) {
+	rows, err := q.db.QueryContext(ctx, expireQueueOffers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdvisorQueueEntry
+	for rows.Next() {
+		var i AdvisorQueueEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AdvisorID,
+			&i.UserID,
+			&i.Status,
+			&i.SessionID,
+			&i.OfferedAt,
+			&i.OfferExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expireSessionRequests = `-- name: ExpireSessionRequests :many
+UPDATE sessions SET status = 'EXPIRED', ended_at = NOW()
+WHERE sessions.status = 'REQUESTED' AND sessions.requested_at < $1
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
+`
+
+// Queue offers expire on their own, shorter deadline
+func (q *Queries) ExpireSessionRequests(ctx context.Context, requestedBefore sql.NullTime) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, expireSessionRequests, requestedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+			&i.PricingType,
+			&i.PricePerMinute,
+			&i.MinBillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
+			&i.RequestedAt,
+			&i.AcceptedAt,
+			&i.BillingStartedAt,
+			&i.BilledMinutes,
+			&i.BilledAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fullTextSearchMessages = `-- name: FullTextSearchMessages :many
+SELECT m.id, m.session_id, m.sender_type, m.sender_id, m.content, m.created_at, m.is_read, m.read_at, m.edited_at, m.deleted_at, m.kind, m.client_message_id, m.content_key_id, m.search_terms, s.advisor_id AS session_advisor_id,
+  ts_headline('english', m.content, q.query, 'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=20, MinWords=8')::text AS snippet
+FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+CROSS JOIN websearch_to_tsquery('english', $1::text) AS q(query)
+WHERE (s.user_id = $2 OR s.advisor_id = $2)
+  AND m.deleted_at IS NULL
+  AND to_tsvector('english', m.content) @@ q.query
+  AND ($3::uuid IS NULL OR m.session_id = $3::uuid)
+  AND ($4::uuid IS NULL OR s.advisor_id = $4::uuid)
+  AND ($5::timestamptz IS NULL OR m.created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR m.created_at < $6::timestamptz)
+  AND ($7::uuid IS NULL OR (m.created_at, m.id) < ($8::timestamptz, $7::uuid))
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT $9
+`
+
+type FullTextSearchMessagesParams struct {
+	Query      string        `json:"query"`
+	MemberID   uuid.UUID     `json:"member_id"`
+	SessionID  uuid.NullUUID `json:"session_id"`
+	AdvisorID  uuid.NullUUID `json:"advisor_id"`
+	SentFrom   sql.NullTime  `json:"sent_from"`
+	SentTo     sql.NullTime  `json:"sent_to"`
+	BeforeID   uuid.NullUUID `json:"before_id"`
+	BeforeAt   sql.NullTime  `json:"before_at"`
+	MaxResults int32         `json:"max_results"`
+}
+
+type FullTextSearchMessagesRow struct {
+	ChatMessage      ChatMessage   `json:"chat_message"`
+	SessionAdvisorID uuid.NullUUID `json:"session_advisor_id"`
+	Snippet          string        `json:"snippet"`
+}
+
+// Messages of the member's sessions matching a web-search style query, newest first, used
+// while content is stored as plaintext. The snippet marks matches with \x02 and \x03.
+func (q *Queries) FullTextSearchMessages(ctx context.Context, arg FullTextSearchMessagesParams) ([]FullTextSearchMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fullTextSearchMessages,
+		arg.Query,
+		arg.MemberID,
+		arg.SessionID,
+		arg.AdvisorID,
+		arg.SentFrom,
+		arg.SentTo,
+		arg.BeforeID,
+		arg.BeforeAt,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FullTextSearchMessagesRow
+	for rows.Next() {
+		var i FullTextSearchMessagesRow
+		if err := rows.Scan(
+			&i.ChatMessage.ID,
+			&i.ChatMessage.SessionID,
+			&i.ChatMessage.SenderType,
+			&i.ChatMessage.SenderID,
+			&i.ChatMessage.Content,
+			&i.ChatMessage.CreatedAt,
+			&i.ChatMessage.IsRead,
+			&i.ChatMessage.ReadAt,
+			&i.ChatMessage.EditedAt,
+			&i.ChatMessage.DeletedAt,
+			&i.ChatMessage.Kind,
+			&i.ChatMessage.ClientMessageID,
+			&i.ChatMessage.ContentKeyID,
+			pq.Array(&i.ChatMessage.SearchTerms),
+			&i.SessionAdvisorID,
+			&i.Snippet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActiveQueueEntry = `-- name: GetActiveQueueEntry :one
+SELECT id, advisor_id, user_id, status, session_id, offered_at, offer_expires_at, created_at, updated_at FROM advisor_queue_entries
+WHERE advisor_id = $1 AND user_id = $2 AND status IN ('WAITING', 'OFFERED')
+`
+
+type GetActiveQueueEntryParams struct {
+	AdvisorID uuid.UUID `json:"advisor_id"`
+	UserID    uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetActiveQueueEntry(ctx context.Context, arg GetActiveQueueEntryParams) (AdvisorQueueEntry, error) {
+	row := q.db.QueryRowContext(ctx, getActiveQueueEntry, arg.AdvisorID, arg.UserID)
+	var i AdvisorQueueEntry
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.SessionID,
+		&i.OfferedAt,
+		&i.OfferExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getActiveSessions = `-- name: GetActiveSessions :many
-SELECT id, user_id, advisor_id, type, started_at, ended_at, status FROM sessions WHERE user_id = $1 AND status != 'ENDED' ORDER BY started_at DESC
+SELECT id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount FROM sessions WHERE user_id = $1 AND status != 'ENDED' ORDER BY started_at DESC
 `
 
 func (q *Queries) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
@@ -387,11 +1504,2803 @@ func (q *Queries) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]Se
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
-			&i.AdvisorID,
-			&i.Type,
-			&i.StartedAt,
-			&i.EndedAt,
-			&i.Status,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+			&i.PricingType,
+			&i.PricePerMinute,
+			&i.MinBillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
+			&i.RequestedAt,
+			&i.AcceptedAt,
+			&i.BillingStartedAt,
+			&i.BilledMinutes,
+			&i.BilledAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActiveSpecializationsByCategory = `-- name: GetActiveSpecializationsByCategory :many
+SELECT id, name, description, category FROM specializations WHERE category = $1 AND is_active = true ORDER BY name
+`
+
+type GetActiveSpecializationsByCategoryRow struct {
+	ID          uuid.UUID      `json:"id"`
+	Name        string         `json:"name"`
+	Description sql.NullString `json:"description"`
+	Category    string         `json:"category"`
+}
+
+func (q *Queries) GetActiveSpecializationsByCategory(ctx context.Context, category string) ([]GetActiveSpecializationsByCategoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getActiveSpecializationsByCategory, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActiveSpecializationsByCategoryRow
+	for rows.Next() {
+		var i GetActiveSpecializationsByCategoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Category,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAdminFlag = `-- name: GetAdminFlag :one
+SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id FROM admin_flags WHERE id = $1
+`
+
+func (q *Queries) GetAdminFlag(ctx context.Context, id uuid.UUID) (AdminFlag, error) {
+	row := q.db.QueryRowContext(ctx, getAdminFlag, id)
+	var i AdminFlag
+	err := row.Scan(
+		&i.ID,
+		&i.ReportedBy,
+		&i.ReportedUserID,
+		&i.ReportedAdvisorID,
+		&i.Reason,
+		&i.SessionID,
+		&i.CreatedAt,
+		&i.Status,
+		&i.Source,
+		&i.MessageID,
+	)
+	return i, err
+}
+
+const getAdvisorApplicationByAdvisorID = `-- name: GetAdvisorApplicationByAdvisorID :one
+SELECT id, advisor_id, user_id, status, reviewer_id, reviewer_notes, rejection_reason, submitted_at, reviewed_at, created_at, updated_at FROM advisor_applications WHERE advisor_id = $1
+`
+
+func (q *Queries) GetAdvisorApplicationByAdvisorID(ctx context.Context, advisorID uuid.UUID) (AdvisorApplication, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorApplicationByAdvisorID, advisorID)
+	var i AdvisorApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.ReviewerID,
+		&i.ReviewerNotes,
+		&i.RejectionReason,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAdvisorApplicationByID = `-- name: GetAdvisorApplicationByID :one
+SELECT id, advisor_id, user_id, status, reviewer_id, reviewer_notes, rejection_reason, submitted_at, reviewed_at, created_at, updated_at FROM advisor_applications WHERE id = $1
+`
+
+func (q *Queries) GetAdvisorApplicationByID(ctx context.Context, id uuid.UUID) (AdvisorApplication, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorApplicationByID, id)
+	var i AdvisorApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.ReviewerID,
+		&i.ReviewerNotes,
+		&i.RejectionReason,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAdvisorApplicationByUserID = `-- name: GetAdvisorApplicationByUserID :one
+SELECT id, advisor_id, user_id, status, reviewer_id, reviewer_notes, rejection_reason, submitted_at, reviewed_at, created_at, updated_at FROM advisor_applications WHERE user_id = $1
+`
+
+func (q *Queries) GetAdvisorApplicationByUserID(ctx context.Context, userID uuid.UUID) (AdvisorApplication, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorApplicationByUserID, userID)
+	var i AdvisorApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.ReviewerID,
+		&i.ReviewerNotes,
+		&i.RejectionReason,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAdvisorByID = `-- name: GetAdvisorByID :one
+SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, a.tier, a.max_concurrent_chats, a.auto_busy, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type FROM advisors a JOIN users u ON a.user_id = u.id WHERE a.id = $1
+`
+
+type GetAdvisorByIDRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	Bio                sql.NullString `json:"bio"`
+	ExperienceYears    sql.NullInt32  `json:"experience_years"`
+	Languages          []string       `json:"languages"`
+	Specializations    []string       `json:"specializations"`
+	IsVerified         sql.NullBool   `json:"is_verified"`
+	HourlyRate         sql.NullString `json:"hourly_rate"`
+	Status             sql.NullString `json:"status"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	Tier               string         `json:"tier"`
+	MaxConcurrentChats int32          `json:"max_concurrent_chats"`
+	AutoBusy           bool           `json:"auto_busy"`
+	ID_2               uuid.UUID      `json:"id_2"`
+	Email              sql.NullString `json:"email"`
+	Phone              sql.NullString `json:"phone"`
+	PasswordHash       string         `json:"password_hash"`
+	DisplayName        string         `json:"display_name"`
+	Role               string         `json:"role"`
+	Gender             sql.NullString `json:"gender"`
+	Dob                sql.NullTime   `json:"dob"`
+	CreatedAt_2        sql.NullTime   `json:"created_at_2"`
+	UpdatedAt_2        sql.NullTime   `json:"updated_at_2"`
+	IsActive           sql.NullBool   `json:"is_active"`
+	FcmToken           sql.NullString `json:"fcm_token"`
+	ApnsToken          sql.NullString `json:"apns_token"`
+	DeviceType         sql.NullString `json:"device_type"`
+}
+
+func (q *Queries) GetAdvisorByID(ctx context.Context, id uuid.UUID) (GetAdvisorByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorByID, id)
+	var i GetAdvisorByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Bio,
+		&i.ExperienceYears,
+		pq.Array(&i.Languages),
+		pq.Array(&i.Specializations),
+		&i.IsVerified,
+		&i.HourlyRate,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
+		&i.MaxConcurrentChats,
+		&i.AutoBusy,
+		&i.ID_2,
+		&i.Email,
+		&i.Phone,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.Role,
+		&i.Gender,
+		&i.Dob,
+		&i.CreatedAt_2,
+		&i.UpdatedAt_2,
+		&i.IsActive,
+		&i.FcmToken,
+		&i.ApnsToken,
+		&i.DeviceType,
+	)
+	return i, err
+}
+
+const getAdvisorByUserID = `-- name: GetAdvisorByUserID :one
+SELECT id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at, tier, max_concurrent_chats, auto_busy FROM advisors WHERE user_id = $1
+`
+
+func (q *Queries) GetAdvisorByUserID(ctx context.Context, userID uuid.UUID) (Advisor, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorByUserID, userID)
+	var i Advisor
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Bio,
+		&i.ExperienceYears,
+		pq.Array(&i.Languages),
+		pq.Array(&i.Specializations),
+		&i.IsVerified,
+		&i.HourlyRate,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
+		&i.MaxConcurrentChats,
+		&i.AutoBusy,
+	)
+	return i, err
+}
+
+const getAdvisorCapacityForUpdate = `-- name: GetAdvisorCapacityForUpdate :one
+SELECT id, status, auto_busy, max_concurrent_chats FROM advisors WHERE user_id = $1 FOR UPDATE
+`
+
+type GetAdvisorCapacityForUpdateRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	Status             sql.NullString `json:"status"`
+	AutoBusy           bool           `json:"auto_busy"`
+	MaxConcurrentChats int32          `json:"max_concurrent_chats"`
+}
+
+// Serializes session creation per advisor
+func (q *Queries) GetAdvisorCapacityForUpdate(ctx context.Context, userID uuid.UUID) (GetAdvisorCapacityForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorCapacityForUpdate, userID)
+	var i GetAdvisorCapacityForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.AutoBusy,
+		&i.MaxConcurrentChats,
+	)
+	return i, err
+}
+
+const getAdvisorPeriodStats = `-- name: GetAdvisorPeriodStats :one
+SELECT COUNT(*)::INTEGER AS session_count,
+       COUNT(*) FILTER (WHERE accepted)::INTEGER AS accepted_count,
+       COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY first_response_seconds), 0)::float8 AS median_first_response_seconds,
+       COALESCE(AVG(duration_seconds) FILTER (WHERE accepted AND status = 'ENDED'), 0)::float8 AS average_session_seconds,
+       COUNT(*) FILTER (WHERE repeat_client)::INTEGER AS repeat_client_count,
+       COUNT(*) FILTER (WHERE session_type = 'CALL')::INTEGER AS call_count,
+       COUNT(*) FILTER (WHERE missed_call)::INTEGER AS missed_calls
+FROM advisor_session_metrics
+WHERE advisor_id = $1
+  AND started_at >= $2
+  AND started_at < $3
+`
+
+type GetAdvisorPeriodStatsParams struct {
+	AdvisorID uuid.UUID `json:"advisor_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+type GetAdvisorPeriodStatsRow struct {
+	SessionCount               int32   `json:"session_count"`
+	AcceptedCount              int32   `json:"accepted_count"`
+	MedianFirstResponseSeconds float64 `json:"median_first_response_seconds"`
+	AverageSessionSeconds      float64 `json:"average_session_seconds"`
+	RepeatClientCount          int32   `json:"repeat_client_count"`
+	CallCount                  int32   `json:"call_count"`
+	MissedCalls                int32   `json:"missed_calls"`
+}
+
+func (q *Queries) GetAdvisorPeriodStats(ctx context.Context, arg GetAdvisorPeriodStatsParams) (GetAdvisorPeriodStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorPeriodStats, arg.AdvisorID, arg.FromTime, arg.ToTime)
+	var i GetAdvisorPeriodStatsRow
+	err := row.Scan(
+		&i.SessionCount,
+		&i.AcceptedCount,
+		&i.MedianFirstResponseSeconds,
+		&i.AverageSessionSeconds,
+		&i.RepeatClientCount,
+		&i.CallCount,
+		&i.MissedCalls,
+	)
+	return i, err
+}
+
+const getAdvisorRatingStats = `-- name: GetAdvisorRatingStats :one
+SELECT COALESCE(AVG(rating), 0)::float8 AS average_rating,
+       COUNT(*)::INTEGER AS rating_count
+FROM ratings
+WHERE advisor_id = $1
+  AND created_at >= $2
+  AND created_at < $3
+`
+
+type GetAdvisorRatingStatsParams struct {
+	AdvisorID uuid.UUID    `json:"advisor_id"`
+	FromTime  sql.NullTime `json:"from_time"`
+	ToTime    sql.NullTime `json:"to_time"`
+}
+
+type GetAdvisorRatingStatsRow struct {
+	AverageRating float64 `json:"average_rating"`
+	RatingCount   int32   `json:"rating_count"`
+}
+
+func (q *Queries) GetAdvisorRatingStats(ctx context.Context, arg GetAdvisorRatingStatsParams) (GetAdvisorRatingStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getAdvisorRatingStats, arg.AdvisorID, arg.FromTime, arg.ToTime)
+	var i GetAdvisorRatingStatsRow
+	err := row.Scan(&i.AverageRating, &i.RatingCount)
+	return i, err
+}
+
+const getAdvisorRatingTrend = `-- name: GetAdvisorRatingTrend :many
+SELECT date_trunc('week', created_at)::timestamptz AS week_start,
+       AVG(rating)::float8 AS average_rating,
+       COUNT(*)::INTEGER AS rating_count
+FROM ratings
+WHERE advisor_id = $1
+  AND created_at >= $2
+  AND created_at < $3
+GROUP BY week_start
+ORDER BY week_start
+`
+
+type GetAdvisorRatingTrendParams struct {
+	AdvisorID uuid.UUID    `json:"advisor_id"`
+	FromTime  sql.NullTime `json:"from_time"`
+	ToTime    sql.NullTime `json:"to_time"`
+}
+
+type GetAdvisorRatingTrendRow struct {
+	WeekStart     time.Time `json:"week_start"`
+	AverageRating float64   `json:"average_rating"`
+	RatingCount   int32     `json:"rating_count"`
+}
+
+func (q *Queries) GetAdvisorRatingTrend(ctx context.Context, arg GetAdvisorRatingTrendParams) ([]GetAdvisorRatingTrendRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAdvisorRatingTrend, arg.AdvisorID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAdvisorRatingTrendRow
+	for rows.Next() {
+		var i GetAdvisorRatingTrendRow
+		if err := rows.Scan(&i.WeekStart, &i.AverageRating, &i.RatingCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAdvisorRatings = `-- name: GetAdvisorRatings :many
+SELECT id, session_id, user_id, advisor_id, rating, review_text, created_at FROM ratings WHERE advisor_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3
+`
+
+type GetAdvisorRatingsParams struct {
+	AdvisorID uuid.UUID `json:"advisor_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+func (q *Queries) GetAdvisorRatings(ctx context.Context, arg GetAdvisorRatingsParams) ([]Rating, error) {
+	rows, err := q.db.QueryContext(ctx, getAdvisorRatings, arg.AdvisorID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Rating
+	for rows.Next() {
+		var i Rating
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Rating,
+			&i.ReviewText,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAdvisorRatingsWithReviewer = `-- name: GetAdvisorRatingsWithReviewer :many
+SELECT r.id, r.session_id, r.user_id, r.advisor_id, r.rating, r.review_text, r.created_at, u.display_name as reviewer_name
+FROM ratings r
+JOIN users u ON r.user_id = u.id
+WHERE r.advisor_id = $1
+ORDER BY r.created_at DESC
+`
+
+type GetAdvisorRatingsWithReviewerRow struct {
+	ID           uuid.UUID      `json:"id"`
+	SessionID    uuid.UUID      `json:"session_id"`
+	UserID       uuid.UUID      `json:"user_id"`
+	AdvisorID    uuid.UUID      `json:"advisor_id"`
+	Rating       int32          `json:"rating"`
+	ReviewText   sql.NullString `json:"review_text"`
+	CreatedAt    sql.NullTime   `json:"created_at"`
+	ReviewerName string         `json:"reviewer_name"`
+}
+
+func (q *Queries) GetAdvisorRatingsWithReviewer(ctx context.Context, advisorID uuid.UUID) ([]GetAdvisorRatingsWithReviewerRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAdvisorRatingsWithReviewer, advisorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAdvisorRatingsWithReviewerRow
+	for rows.Next() {
+		var i GetAdvisorRatingsWithReviewerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Rating,
+			&i.ReviewText,
+			&i.CreatedAt,
+			&i.ReviewerName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllFAQs = `-- name: GetAllFAQs :many
+
+SELECT id, question, answer, category, is_active FROM faqs ORDER BY category, question
+`
+
+type GetAllFAQsRow struct {
+	ID       uuid.UUID    `json:"id"`
+	Question string       `json:"question"`
+	Answer   string       `json:"answer"`
+	Category string       `json:"category"`
+	IsActive sql.NullBool `json:"is_active"`
+}
+
+// FAQ Management
+func (q *Queries) GetAllFAQs(ctx context.Context) ([]GetAllFAQsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllFAQs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllFAQsRow
+	for rows.Next() {
+		var i GetAllFAQsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Question,
+			&i.Answer,
+			&i.Category,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllSpecializations = `-- name: GetAllSpecializations :many
+
+SELECT id, name, description, category, is_active FROM specializations ORDER BY category, name
+`
+
+type GetAllSpecializationsRow struct {
+	ID          uuid.UUID      `json:"id"`
+	Name        string         `json:"name"`
+	Description sql.NullString `json:"description"`
+	Category    string         `json:"category"`
+	IsActive    sql.NullBool   `json:"is_active"`
+}
+
+// Specializations Management
+func (q *Queries) GetAllSpecializations(ctx context.Context) ([]GetAllSpecializationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllSpecializations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllSpecializationsRow
+	for rows.Next() {
+		var i GetAllSpecializationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Category,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAverageSessionDuration = `-- name: GetAverageSessionDuration :one
+SELECT AVG(EXTRACT(EPOCH FROM (ended_at - started_at))) FROM sessions WHERE user_id = $1 AND status = 'ENDED'
+`
+
+func (q *Queries) GetAverageSessionDuration(ctx context.Context, userID uuid.UUID) (float64, error) {
+	row := q.db.QueryRowContext(ctx, getAverageSessionDuration, userID)
+	var avg float64
+	err := row.Scan(&avg)
+	return avg, err
+}
+
+const getAverageSessionSeconds = `-- name: GetAverageSessionSeconds :one
+SELECT COALESCE(AVG(EXTRACT(EPOCH FROM (s.ended_at - s.started_at))), 0)::float8 AS average_seconds
+FROM (
+    SELECT started_at, ended_at FROM sessions
+    WHERE advisor_id = $1 AND status = 'ENDED' AND ended_at IS NOT NULL
+    ORDER BY ended_at DESC
+    LIMIT 20
+) s
+`
+
+// Average length of the advisor's most recent ended sessions, used to estimate waits
+func (q *Queries) GetAverageSessionSeconds(ctx context.Context, advisorID uuid.NullUUID) (float64, error) {
+	row := q.db.QueryRowContext(ctx, getAverageSessionSeconds, advisorID)
+	var average_seconds float64
+	err := row.Scan(&average_seconds)
+	return average_seconds, err
+}
+
+const getCallSessionByID = `-- name: GetCallSessionByID :one
+SELECT id, user_id, advisor_id, type, started_at, ended_at, status FROM sessions WHERE id = $1
+`
+
+type GetCallSessionByIDRow struct {
+	ID        uuid.UUID      `json:"id"`
+	UserID    uuid.UUID      `json:"user_id"`
+	AdvisorID uuid.NullUUID  `json:"advisor_id"`
+	Type      string         `json:"type"`
+	StartedAt sql.NullTime   `json:"started_at"`
+	EndedAt   sql.NullTime   `json:"ended_at"`
+	Status    sql.NullString `json:"status"`
+}
+
+func (q *Queries) GetCallSessionByID(ctx context.Context, id uuid.UUID) (GetCallSessionByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getCallSessionByID, id)
+	var i GetCallSessionByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getCallStatus = `-- name: GetCallStatus :one
+SELECT status_update, status_timestamp FROM call_logs WHERE session_id = $1 ORDER BY status_timestamp DESC LIMIT 1
+`
+
+type GetCallStatusRow struct {
+	StatusUpdate    sql.NullString `json:"status_update"`
+	StatusTimestamp sql.NullTime   `json:"status_timestamp"`
+}
+
+func (q *Queries) GetCallStatus(ctx context.Context, sessionID uuid.UUID) (GetCallStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, getCallStatus, sessionID)
+	var i GetCallStatusRow
+	err := row.Scan(&i.StatusUpdate, &i.StatusTimestamp)
+	return i, err
+}
+
+const getChatAttachment = `-- name: GetChatAttachment :one
+SELECT a.id, a.session_id, a.uploader_id, a.message_id, a.kind, a.mime_type, a.size_bytes, a.duration_ms, a.storage_key, a.created_at, (m.deleted_at IS NOT NULL)::boolean AS message_deleted
+FROM chat_attachments a
+LEFT JOIN chat_messages m ON m.id = a.message_id
+WHERE a.id = $1
+`
+
+type GetChatAttachmentRow struct {
+	ChatAttachment ChatAttachment `json:"chat_attachment"`
+	MessageDeleted bool           `json:"message_deleted"`
+}
+
+// The attachment with whether the message it was sent in has since been deleted
+func (q *Queries) GetChatAttachment(ctx context.Context, id uuid.UUID) (GetChatAttachmentRow, error) {
+	row := q.db.QueryRowContext(ctx, getChatAttachment, id)
+	var i GetChatAttachmentRow
+	err := row.Scan(
+		&i.ChatAttachment.ID,
+		&i.ChatAttachment.SessionID,
+		&i.ChatAttachment.UploaderID,
+		&i.ChatAttachment.MessageID,
+		&i.ChatAttachment.Kind,
+		&i.ChatAttachment.MimeType,
+		&i.ChatAttachment.SizeBytes,
+		&i.ChatAttachment.DurationMs,
+		&i.ChatAttachment.StorageKey,
+		&i.ChatAttachment.CreatedAt,
+		&i.MessageDeleted,
+	)
+	return i, err
+}
+
+const getChatAttachmentForMember = `-- name: GetChatAttachmentForMember :one
+SELECT a.id, a.session_id, a.uploader_id, a.message_id, a.kind, a.mime_type, a.size_bytes, a.duration_ms, a.storage_key, a.created_at, (m.deleted_at IS NOT NULL)::boolean AS message_deleted
+FROM chat_attachments a
+JOIN sessions s ON s.id = a.session_id
+LEFT JOIN chat_messages m ON m.id = a.message_id
+WHERE a.id = $1 AND (s.user_id = $2 OR s.advisor_id = $2)
+`
+
+type GetChatAttachmentForMemberParams struct {
+	ID       uuid.UUID `json:"id"`
+	MemberID uuid.UUID `json:"member_id"`
+}
+
+type GetChatAttachmentForMemberRow struct {
+	ChatAttachment ChatAttachment `json:"chat_attachment"`
+	MessageDeleted bool           `json:"message_deleted"`
+}
+
+// The attachment, provided the member takes part in its session
+func (q *Queries) GetChatAttachmentForMember(ctx context.Context, arg GetChatAttachmentForMemberParams) (GetChatAttachmentForMemberRow, error) {
+	row := q.db.QueryRowContext(ctx, getChatAttachmentForMember, arg.ID, arg.MemberID)
+	var i GetChatAttachmentForMemberRow
+	err := row.Scan(
+		&i.ChatAttachment.ID,
+		&i.ChatAttachment.SessionID,
+		&i.ChatAttachment.UploaderID,
+		&i.ChatAttachment.MessageID,
+		&i.ChatAttachment.Kind,
+		&i.ChatAttachment.MimeType,
+		&i.ChatAttachment.SizeBytes,
+		&i.ChatAttachment.DurationMs,
+		&i.ChatAttachment.StorageKey,
+		&i.ChatAttachment.CreatedAt,
+		&i.MessageDeleted,
+	)
+	return i, err
+}
+
+const getChatMessageByID = `-- name: GetChatMessageByID :one
+SELECT id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms FROM chat_messages WHERE id = $1
+`
+
+func (q *Queries) GetChatMessageByID(ctx context.Context, id uuid.UUID) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, getChatMessageByID, id)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.SenderType,
+		&i.SenderID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.IsRead,
+		&i.ReadAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Kind,
+		&i.ClientMessageID,
+		&i.ContentKeyID,
+		pq.Array(&i.SearchTerms),
+	)
+	return i, err
+}
+
+const getCollaborativeAdvisorScores = `-- name: GetCollaborativeAdvisorScores :many
+SELECT r2.advisor_id, COUNT(DISTINCT r2.user_id)::INTEGER AS similar_users
+FROM ratings mine
+JOIN ratings r1 ON r1.advisor_id = mine.advisor_id AND r1.user_id <> mine.user_id AND r1.rating >= 4
+JOIN ratings r2 ON r2.user_id = r1.user_id AND r2.advisor_id <> mine.advisor_id AND r2.rating >= 4
+WHERE mine.user_id = $1 AND mine.rating >= 4
+GROUP BY r2.advisor_id
+`
+
+type GetCollaborativeAdvisorScoresRow struct {
+	AdvisorID    uuid.UUID `json:"advisor_id"`
+	SimilarUsers int32     `json:"similar_users"`
+}
+
+// Advisors rated 4+ by users who also rated 4+ an advisor the given user rated 4+
+func (q *Queries) GetCollaborativeAdvisorScores(ctx context.Context, userID uuid.UUID) ([]GetCollaborativeAdvisorScoresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCollaborativeAdvisorScores, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCollaborativeAdvisorScoresRow
+	for rows.Next() {
+		var i GetCollaborativeAdvisorScoresRow
+		if err := rows.Scan(&i.AdvisorID, &i.SimilarUsers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCredentialDocument = `-- name: GetCredentialDocument :one
+SELECT id, application_id, file_name, mime_type, size_bytes, encrypted_content, created_at FROM advisor_credential_documents WHERE id = $1
+`
+
+func (q *Queries) GetCredentialDocument(ctx context.Context, id uuid.UUID) (AdvisorCredentialDocument, error) {
+	row := q.db.QueryRowContext(ctx, getCredentialDocument, id)
+	var i AdvisorCredentialDocument
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.FileName,
+		&i.MimeType,
+		&i.SizeBytes,
+		&i.EncryptedContent,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getEarningsByPeriod = `-- name: GetEarningsByPeriod :many
+SELECT date_trunc($1::text, created_at)::timestamptz AS period_start,
+       COUNT(*) AS session_count,
+       SUM(duration_seconds)::bigint AS total_seconds,
+       SUM(gross_amount)::text AS gross_amount,
+       SUM(commission_amount)::text AS commission_amount,
+       SUM(net_amount)::text AS net_amount
+FROM advisor_earnings
+WHERE advisor_id = $2 AND created_at >= $3 AND created_at < $4
+GROUP BY 1
+ORDER BY 1 DESC
+`
+
+type GetEarningsByPeriodParams struct {
+	Period    string       `json:"period"`
+	AdvisorID uuid.UUID    `json:"advisor_id"`
+	FromTime  sql.NullTime `json:"from_time"`
+	ToTime    sql.NullTime `json:"to_time"`
+}
+
+type GetEarningsByPeriodRow struct {
+	PeriodStart      time.Time `json:"period_start"`
+	SessionCount     int64     `json:"session_count"`
+	TotalSeconds     int64     `json:"total_seconds"`
+	GrossAmount      string    `json:"gross_amount"`
+	CommissionAmount string    `json:"commission_amount"`
+	NetAmount        string    `json:"net_amount"`
+}
+
+func (q *Queries) GetEarningsByPeriod(ctx context.Context, arg GetEarningsByPeriodParams) ([]GetEarningsByPeriodRow, error) {
+	rows, err := q.db.QueryContext(ctx, getEarningsByPeriod,
+		arg.Period,
+		arg.AdvisorID,
+		arg.FromTime,
+		arg.ToTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEarningsByPeriodRow
+	for rows.Next() {
+		var i GetEarningsByPeriodRow
+		if err := rows.Scan(
+			&i.PeriodStart,
+			&i.SessionCount,
+			&i.TotalSeconds,
+			&i.GrossAmount,
+			&i.CommissionAmount,
+			&i.NetAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFAQsByCategory = `-- name: GetFAQsByCategory :many
+SELECT id, question, answer, category, is_active FROM faqs WHERE category = $1 AND is_active = true ORDER BY question
+`
+
+type GetFAQsByCategoryRow struct {
+	ID       uuid.UUID    `json:"id"`
+	Question string       `json:"question"`
+	Answer   string       `json:"answer"`
+	Category string       `json:"category"`
+	IsActive sql.NullBool `json:"is_active"`
+}
+
+func (q *Queries) GetFAQsByCategory(ctx context.Context, category string) ([]GetFAQsByCategoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFAQsByCategory, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFAQsByCategoryRow
+	for rows.Next() {
+		var i GetFAQsByCategoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Question,
+			&i.Answer,
+			&i.Category,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFeedbackPromptBySession = `-- name: GetFeedbackPromptBySession :one
+SELECT id, session_id, user_id, advisor_id, prompt_sent_at, response_received_at, rating, feedback_text
+FROM call_feedback_prompts
+WHERE session_id = $1
+ORDER BY prompt_sent_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetFeedbackPromptBySession(ctx context.Context, sessionID uuid.UUID) (CallFeedbackPrompt, error) {
+	row := q.db.QueryRowContext(ctx, getFeedbackPromptBySession, sessionID)
+	var i CallFeedbackPrompt
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.PromptSentAt,
+		&i.ResponseReceivedAt,
+		&i.Rating,
+		&i.FeedbackText,
+	)
+	return i, err
+}
+
+const getFlags = `-- name: GetFlags :many
+SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id FROM admin_flags ORDER BY created_at DESC LIMIT $1 OFFSET $2
+`
+
+type GetFlagsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) GetFlags(ctx context.Context, arg GetFlagsParams) ([]AdminFlag, error) {
+	rows, err := q.db.QueryContext(ctx, getFlags, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdminFlag
+	for rows.Next() {
+		var i AdminFlag
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportedBy,
+			&i.ReportedUserID,
+			&i.ReportedAdvisorID,
+			&i.Reason,
+			&i.SessionID,
+			&i.CreatedAt,
+			&i.Status,
+			&i.Source,
+			&i.MessageID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessageAttachments = `-- name: GetMessageAttachments :many
+SELECT id, session_id, uploader_id, message_id, kind, mime_type, size_bytes, duration_ms, storage_key, created_at FROM chat_attachments WHERE message_id = ANY($1::uuid[])
+`
+
+func (q *Queries) GetMessageAttachments(ctx context.Context, messageIds []uuid.UUID) ([]ChatAttachment, error) {
+	rows, err := q.db.QueryContext(ctx, getMessageAttachments, pq.Array(messageIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatAttachment
+	for rows.Next() {
+		var i ChatAttachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.UploaderID,
+			&i.MessageID,
+			&i.Kind,
+			&i.MimeType,
+			&i.SizeBytes,
+			&i.DurationMs,
+			&i.StorageKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessageByClientID = `-- name: GetMessageByClientID :one
+SELECT id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms FROM chat_messages WHERE session_id = $1 AND client_message_id = $2
+`
+
+type GetMessageByClientIDParams struct {
+	SessionID       uuid.UUID      `json:"session_id"`
+	ClientMessageID sql.NullString `json:"client_message_id"`
+}
+
+func (q *Queries) GetMessageByClientID(ctx context.Context, arg GetMessageByClientIDParams) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, getMessageByClientID, arg.SessionID, arg.ClientMessageID)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.SenderType,
+		&i.SenderID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.IsRead,
+		&i.ReadAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Kind,
+		&i.ClientMessageID,
+		&i.ContentKeyID,
+		pq.Array(&i.SearchTerms),
+	)
+	return i, err
+}
+
+const getMessageCursor = `-- name: GetMessageCursor :one
+SELECT m.id, m.session_id, m.created_at FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE m.id = $1 AND (s.user_id = $2 OR s.advisor_id = $2)
+`
+
+type GetMessageCursorParams struct {
+	ID       uuid.UUID `json:"id"`
+	MemberID uuid.UUID `json:"member_id"`
+}
+
+type GetMessageCursorRow struct {
+	ID        uuid.UUID    `json:"id"`
+	SessionID uuid.UUID    `json:"session_id"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+// Position of a message the member can see, for paging relative to it
+func (q *Queries) GetMessageCursor(ctx context.Context, arg GetMessageCursorParams) (GetMessageCursorRow, error) {
+	row := q.db.QueryRowContext(ctx, getMessageCursor, arg.ID, arg.MemberID)
+	var i GetMessageCursorRow
+	err := row.Scan(&i.ID, &i.SessionID, &i.CreatedAt)
+	return i, err
+}
+
+const getMessageForReader = `-- name: GetMessageForReader :one
+SELECT m.id, m.session_id, m.sender_type, m.sender_id, m.content, m.created_at, m.is_read, m.read_at, m.edited_at, m.deleted_at, m.kind, m.client_message_id, m.content_key_id, m.search_terms FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE m.id = $1 AND m.session_id = $2
+  AND (s.user_id = $3 OR s.advisor_id = $3)
+`
+
+type GetMessageForReaderParams struct {
+	ID        uuid.UUID `json:"id"`
+	SessionID uuid.UUID `json:"session_id"`
+	ReaderID  uuid.UUID `json:"reader_id"`
+}
+
+// The message, provided it belongs to the session and the reader takes part in it
+func (q *Queries) GetMessageForReader(ctx context.Context, arg GetMessageForReaderParams) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, getMessageForReader, arg.ID, arg.SessionID, arg.ReaderID)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.SenderType,
+		&i.SenderID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.IsRead,
+		&i.ReadAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Kind,
+		&i.ClientMessageID,
+		&i.ContentKeyID,
+		pq.Array(&i.SearchTerms),
+	)
+	return i, err
+}
+
+const getMessageRevisions = `-- name: GetMessageRevisions :many
+SELECT id, message_id, action, content, created_at, content_key_id FROM chat_message_revisions WHERE message_id = $1 ORDER BY created_at
+`
+
+func (q *Queries) GetMessageRevisions(ctx context.Context, messageID uuid.UUID) ([]ChatMessageRevision, error) {
+	rows, err := q.db.QueryContext(ctx, getMessageRevisions, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessageRevision
+	for rows.Next() {
+		var i ChatMessageRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.Action,
+			&i.Content,
+			&i.CreatedAt,
+			&i.ContentKeyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMessages = `-- name: GetMessages :many
+SELECT id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms FROM chat_messages WHERE session_id = $1 ORDER BY created_at LIMIT $2 OFFSET $3
+`
+
+type GetMessagesParams struct {
+	SessionID uuid.UUID `json:"session_id"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, getMessages, arg.SessionID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.SenderType,
+			&i.SenderID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.IsRead,
+			&i.ReadAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Kind,
+			&i.ClientMessageID,
+			&i.ContentKeyID,
+			pq.Array(&i.SearchTerms),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingAdvisors = `-- name: GetPendingAdvisors :many
+SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, a.tier, a.max_concurrent_chats, a.auto_busy, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type FROM advisors a JOIN users u ON a.user_id = u.id WHERE a.status = 'PENDING' LIMIT $1 OFFSET $2
+`
+
+type GetPendingAdvisorsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type GetPendingAdvisorsRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	Bio                sql.NullString `json:"bio"`
+	ExperienceYears    sql.NullInt32  `json:"experience_years"`
+	Languages          []string       `json:"languages"`
+	Specializations    []string       `json:"specializations"`
+	IsVerified         sql.NullBool   `json:"is_verified"`
+	HourlyRate         sql.NullString `json:"hourly_rate"`
+	Status             sql.NullString `json:"status"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	Tier               string         `json:"tier"`
+	MaxConcurrentChats int32          `json:"max_concurrent_chats"`
+	AutoBusy           bool           `json:"auto_busy"`
+	ID_2               uuid.UUID      `json:"id_2"`
+	Email              sql.NullString `json:"email"`
+	Phone              sql.NullString `json:"phone"`
+	PasswordHash       string         `json:"password_hash"`
+	DisplayName        string         `json:"display_name"`
+	Role               string         `json:"role"`
+	Gender             sql.NullString `json:"gender"`
+	Dob                sql.NullTime   `json:"dob"`
+	CreatedAt_2        sql.NullTime   `json:"created_at_2"`
+	UpdatedAt_2        sql.NullTime   `json:"updated_at_2"`
+	IsActive           sql.NullBool   `json:"is_active"`
+	FcmToken           sql.NullString `json:"fcm_token"`
+	ApnsToken          sql.NullString `json:"apns_token"`
+	DeviceType         sql.NullString `json:"device_type"`
+}
+
+func (q *Queries) GetPendingAdvisors(ctx context.Context, arg GetPendingAdvisorsParams) ([]GetPendingAdvisorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingAdvisors, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPendingAdvisorsRow
+	for rows.Next() {
+		var i GetPendingAdvisorsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Bio,
+			&i.ExperienceYears,
+			pq.Array(&i.Languages),
+			pq.Array(&i.Specializations),
+			&i.IsVerified,
+			&i.HourlyRate,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Tier,
+			&i.MaxConcurrentChats,
+			&i.AutoBusy,
+			&i.ID_2,
+			&i.Email,
+			&i.Phone,
+			&i.PasswordHash,
+			&i.DisplayName,
+			&i.Role,
+			&i.Gender,
+			&i.Dob,
+			&i.CreatedAt_2,
+			&i.UpdatedAt_2,
+			&i.IsActive,
+			&i.FcmToken,
+			&i.ApnsToken,
+			&i.DeviceType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingFeedbackPrompts = `-- name: GetPendingFeedbackPrompts :many
+SELECT cfp.id, cfp.session_id, u.display_name as user_name, a.display_name as advisor_name, cfp.prompt_sent_at
+FROM call_feedback_prompts cfp
+JOIN users u ON u.id = cfp.user_id
+JOIN users a ON a.id = cfp.advisor_id
+WHERE cfp.response_received_at IS NULL
+ORDER BY cfp.prompt_sent_at DESC
+`
+
+type GetPendingFeedbackPromptsRow struct {
+	ID           uuid.UUID    `json:"id"`
+	SessionID    uuid.UUID    `json:"session_id"`
+	UserName     string       `json:"user_name"`
+	AdvisorName  string       `json:"advisor_name"`
+	PromptSentAt sql.NullTime `json:"prompt_sent_at"`
+}
+
+func (q *Queries) GetPendingFeedbackPrompts(ctx context.Context) ([]GetPendingFeedbackPromptsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingFeedbackPrompts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPendingFeedbackPromptsRow
+	for rows.Next() {
+		var i GetPendingFeedbackPromptsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.UserName,
+			&i.AdvisorName,
+			&i.PromptSentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQueueEntryByID = `-- name: GetQueueEntryByID :one
+SELECT id, advisor_id, user_id, status, session_id, offered_at, offer_expires_at, created_at, updated_at FROM advisor_queue_entries WHERE id = $1
+`
+
+func (q *Queries) GetQueueEntryByID(ctx context.Context, id uuid.UUID) (AdvisorQueueEntry, error) {
+	row := q.db.QueryRowContext(ctx, getQueueEntryByID, id)
+	var i AdvisorQueueEntry
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.SessionID,
+		&i.OfferedAt,
+		&i.OfferExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getQueueLength = `-- name: GetQueueLength :one
+SELECT COUNT(*)::INTEGER AS length
+FROM advisor_queue_entries
+WHERE advisor_id = $1 AND status IN ('WAITING', 'OFFERED')
+`
+
+func (q *Queries) GetQueueLength(ctx context.Context, advisorID uuid.UUID) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getQueueLength, advisorID)
+	var length int32
+	err := row.Scan(&length)
+	return length, err
+}
+
+const getQueuePosition = `-- name: GetQueuePosition :one
+SELECT COUNT(*)::INTEGER AS position
+FROM advisor_queue_entries q
+WHERE q.advisor_id = $1
+  AND q.status IN ('WAITING', 'OFFERED')
+  AND q.created_at <= $2
+`
+
+type GetQueuePositionParams struct {
+	AdvisorID uuid.UUID    `json:"advisor_id"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+// 1-based position among entries still waiting or holding an offer for the advisor
+func (q *Queries) GetQueuePosition(ctx context.Context, arg GetQueuePositionParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getQueuePosition, arg.AdvisorID, arg.CreatedAt)
+	var position int32
+	err := row.Scan(&position)
+	return position, err
+}
+
+const getRecentAIPrompts = `-- name: GetRecentAIPrompts :many
+SELECT prompt, content_key_id FROM ai_interactions
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type GetRecentAIPromptsParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Limit  int32     `json:"limit"`
+}
+
+type GetRecentAIPromptsRow struct {
+	Prompt       string        `json:"prompt"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+}
+
+func (q *Queries) GetRecentAIPrompts(ctx context.Context, arg GetRecentAIPromptsParams) ([]GetRecentAIPromptsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentAIPrompts, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecentAIPromptsRow
+	for rows.Next() {
+		var i GetRecentAIPromptsRow
+		if err := rows.Scan(&i.Prompt, &i.ContentKeyID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentAdminFlags = `-- name: GetRecentAdminFlags :many
+SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id FROM admin_flags WHERE created_at >= NOW() - INTERVAL '%s days' ORDER BY created_at DESC
+`
+
+func (q *Queries) GetRecentAdminFlags(ctx context.Context) ([]AdminFlag, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentAdminFlags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdminFlag
+	for rows.Next() {
+		var i AdminFlag
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportedBy,
+			&i.ReportedUserID,
+			&i.ReportedAdvisorID,
+			&i.Reason,
+			&i.SessionID,
+			&i.CreatedAt,
+			&i.Status,
+			&i.Source,
+			&i.MessageID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentEndedSessions = `-- name: GetRecentEndedSessions :many
+SELECT id, user_id, advisor_id, type, started_at, ended_at, status
+FROM sessions
+WHERE status = 'ENDED'
+AND ended_at >= NOW() - INTERVAL '24 hours'
+ORDER BY ended_at DESC
+`
+
+type GetRecentEndedSessionsRow struct {
+	ID        uuid.UUID      `json:"id"`
+	UserID    uuid.UUID      `json:"user_id"`
+	AdvisorID uuid.NullUUID  `json:"advisor_id"`
+	Type      string         `json:"type"`
+	StartedAt sql.NullTime   `json:"started_at"`
+	EndedAt   sql.NullTime   `json:"ended_at"`
+	Status    sql.NullString `json:"status"`
+}
+
+func (q *Queries) GetRecentEndedSessions(ctx context.Context) ([]GetRecentEndedSessionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentEndedSessions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecentEndedSessionsRow
+	for rows.Next() {
+		var i GetRecentEndedSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecommendedAdvisors = `-- name: GetRecommendedAdvisors :many
+SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, a.tier, a.max_concurrent_chats, a.auto_busy, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type,
+       COALESCE(AVG(r.rating), 0)::float8 AS average_rating,
+       COUNT(r.id)::INTEGER AS rating_count
+FROM advisors a
+JOIN users u ON a.user_id = u.id
+LEFT JOIN ratings r ON a.user_id = r.advisor_id
+WHERE a.status IN ('ONLINE', 'BUSY')
+AND a.is_verified = true
+AND a.user_id <> $1
+GROUP BY a.id, u.id
+ORDER BY average_rating DESC, rating_count DESC, a.experience_years DESC NULLS LAST
+LIMIT $2
+`
+
+type GetRecommendedAdvisorsParams struct {
+	UserID   uuid.UUID `json:"user_id"`
+	RowLimit int32     `json:"row_limit"`
+}
+
+type GetRecommendedAdvisorsRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	Bio                sql.NullString `json:"bio"`
+	ExperienceYears    sql.NullInt32  `json:"experience_years"`
+	Languages          []string       `json:"languages"`
+	Specializations    []string       `json:"specializations"`
+	IsVerified         sql.NullBool   `json:"is_verified"`
+	HourlyRate         sql.NullString `json:"hourly_rate"`
+	Status             sql.NullString `json:"status"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	Tier               string         `json:"tier"`
+	MaxConcurrentChats int32          `json:"max_concurrent_chats"`
+	AutoBusy           bool           `json:"auto_busy"`
+	ID_2               uuid.UUID      `json:"id_2"`
+	Email              sql.NullString `json:"email"`
+	Phone              sql.NullString `json:"phone"`
+	PasswordHash       string         `json:"password_hash"`
+	DisplayName        string         `json:"display_name"`
+	Role               string         `json:"role"`
+	Gender             sql.NullString `json:"gender"`
+	Dob                sql.NullTime   `json:"dob"`
+	CreatedAt_2        sql.NullTime   `json:"created_at_2"`
+	UpdatedAt_2        sql.NullTime   `json:"updated_at_2"`
+	IsActive           sql.NullBool   `json:"is_active"`
+	FcmToken           sql.NullString `json:"fcm_token"`
+	ApnsToken          sql.NullString `json:"apns_token"`
+	DeviceType         sql.NullString `json:"device_type"`
+	AverageRating      float64        `json:"average_rating"`
+	RatingCount        int32          `json:"rating_count"`
+}
+
+// Candidate pool for recommendations, most popular first, with the rating signals used for scoring
+func (q *Queries) GetRecommendedAdvisors(ctx context.Context, arg GetRecommendedAdvisorsParams) ([]GetRecommendedAdvisorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRecommendedAdvisors, arg.UserID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRecommendedAdvisorsRow
+	for rows.Next() {
+		var i GetRecommendedAdvisorsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Bio,
+			&i.ExperienceYears,
+			pq.Array(&i.Languages),
+			pq.Array(&i.Specializations),
+			&i.IsVerified,
+			&i.HourlyRate,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Tier,
+			&i.MaxConcurrentChats,
+			&i.AutoBusy,
+			&i.ID_2,
+			&i.Email,
+			&i.Phone,
+			&i.PasswordHash,
+			&i.DisplayName,
+			&i.Role,
+			&i.Gender,
+			&i.Dob,
+			&i.CreatedAt_2,
+			&i.UpdatedAt_2,
+			&i.IsActive,
+			&i.FcmToken,
+			&i.ApnsToken,
+			&i.DeviceType,
+			&i.AverageRating,
+			&i.RatingCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReportsByStatus = `-- name: GetReportsByStatus :many
+SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id FROM admin_flags WHERE status = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) GetReportsByStatus(ctx context.Context, status sql.NullString) ([]AdminFlag, error) {
+	rows, err := q.db.QueryContext(ctx, getReportsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdminFlag
+	for rows.Next() {
+		var i AdminFlag
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportedBy,
+			&i.ReportedUserID,
+			&i.ReportedAdvisorID,
+			&i.Reason,
+			&i.SessionID,
+			&i.CreatedAt,
+			&i.Status,
+			&i.Source,
+			&i.MessageID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessionByID = `-- name: GetSessionByID :one
+SELECT id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount FROM sessions WHERE id = $1
+`
+
+func (q *Queries) GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSessionByID, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
+
+const getSessionParticipantDeviceTokens = `-- name: GetSessionParticipantDeviceTokens :many
+SELECT DISTINCT u.fcm_token, u.apns_token, u.device_type
+FROM users u
+JOIN sessions s ON (s.user_id = u.id OR s.advisor_id = u.id)
+WHERE s.id = $1
+  AND u.id != $2
+  AND (u.fcm_token IS NOT NULL OR u.apns_token IS NOT NULL)
+`
+
+type GetSessionParticipantDeviceTokensParams struct {
+	ID   uuid.UUID `json:"id"`
+	ID_2 uuid.UUID `json:"id_2"`
+}
+
+type GetSessionParticipantDeviceTokensRow struct {
+	FcmToken   sql.NullString `json:"fcm_token"`
+	ApnsToken  sql.NullString `json:"apns_token"`
+	DeviceType sql.NullString `json:"device_type"`
+}
+
+func (q *Queries) GetSessionParticipantDeviceTokens(ctx context.Context, arg GetSessionParticipantDeviceTokensParams) ([]GetSessionParticipantDeviceTokensRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionParticipantDeviceTokens, arg.ID, arg.ID_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSessionParticipantDeviceTokensRow
+	for rows.Next() {
+		var i GetSessionParticipantDeviceTokensRow
+		if err := rows.Scan(&i.FcmToken, &i.ApnsToken, &i.DeviceType); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessionParticipants = `-- name: GetSessionParticipants :many
+SELECT user_id, advisor_id FROM sessions WHERE id = $1
+`
+
+type GetSessionParticipantsRow struct {
+	UserID    uuid.UUID     `json:"user_id"`
+	AdvisorID uuid.NullUUID `json:"advisor_id"`
+}
+
+func (q *Queries) GetSessionParticipants(ctx context.Context, id uuid.UUID) ([]GetSessionParticipantsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionParticipants, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSessionParticipantsRow
+	for rows.Next() {
+		var i GetSessionParticipantsRow
+		if err := rows.Scan(&i.UserID, &i.AdvisorID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessionReadReceipts = `-- name: GetSessionReadReceipts :many
+SELECT session_id, user_id, last_read_message_id, last_read_at, updated_at FROM chat_read_receipts WHERE session_id = $1
+`
+
+func (q *Queries) GetSessionReadReceipts(ctx context.Context, sessionID uuid.UUID) ([]ChatReadReceipt, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionReadReceipts, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatReadReceipt
+	for rows.Next() {
+		var i ChatReadReceipt
+		if err := rows.Scan(
+			&i.SessionID,
+			&i.UserID,
+			&i.LastReadMessageID,
+			&i.LastReadAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTemplateForAdvisor = `-- name: GetTemplateForAdvisor :one
+SELECT id, advisor_id, title, body, category, is_active, created_by, created_at, updated_at FROM message_templates
+WHERE id = $1 AND is_active AND (advisor_id = $2 OR advisor_id IS NULL)
+`
+
+type GetTemplateForAdvisorParams struct {
+	ID        uuid.UUID     `json:"id"`
+	AdvisorID uuid.NullUUID `json:"advisor_id"`
+}
+
+func (q *Queries) GetTemplateForAdvisor(ctx context.Context, arg GetTemplateForAdvisorParams) (MessageTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getTemplateForAdvisor, arg.ID, arg.AdvisorID)
+	var i MessageTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.Title,
+		&i.Body,
+		&i.Category,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUnpaidEarningsTotal = `-- name: GetUnpaidEarningsTotal :one
+SELECT COALESCE(SUM(net_amount), 0)::text FROM advisor_earnings WHERE advisor_id = $1 AND payout_batch_id IS NULL
+`
+
+func (q *Queries) GetUnpaidEarningsTotal(ctx context.Context, advisorID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUnpaidEarningsTotal, advisorID)
+	var column_1 string
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getUnreadCounts = `-- name: GetUnreadCounts :many
+SELECT s.id AS session_id,
+       COUNT(m.id)::int AS unread_count,
+       r.last_read_message_id
+FROM sessions s
+JOIN chat_messages m ON m.session_id = s.id AND m.sender_id <> $1 AND m.deleted_at IS NULL
+LEFT JOIN chat_read_receipts r ON r.session_id = s.id AND r.user_id = $1
+WHERE (s.user_id = $1 OR s.advisor_id = $1)
+  AND ($2::uuid IS NULL OR s.id = $2::uuid)
+  AND (r.last_read_at IS NULL OR m.created_at > r.last_read_at)
+GROUP BY s.id, r.last_read_message_id
+ORDER BY MAX(m.created_at) DESC
+`
+
+type GetUnreadCountsParams struct {
+	UserID    uuid.UUID     `json:"user_id"`
+	SessionID uuid.NullUUID `json:"session_id"`
+}
+
+type GetUnreadCountsRow struct {
+	SessionID         uuid.UUID     `json:"session_id"`
+	UnreadCount       int32         `json:"unread_count"`
+	LastReadMessageID uuid.NullUUID `json:"last_read_message_id"`
+}
+
+// Sessions the user takes part in with messages from others after their watermark, most recent first
+func (q *Queries) GetUnreadCounts(ctx context.Context, arg GetUnreadCountsParams) ([]GetUnreadCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUnreadCounts, arg.UserID, arg.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUnreadCountsRow
+	for rows.Next() {
+		var i GetUnreadCountsRow
+		if err := rows.Scan(&i.SessionID, &i.UnreadCount, &i.LastReadMessageID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserAdvisorHistory = `-- name: GetUserAdvisorHistory :many
+SELECT s.advisor_id::uuid AS advisor_id,
+       COUNT(DISTINCT s.id)::INTEGER AS session_count,
+       COUNT(r.id)::INTEGER AS rating_count,
+       COALESCE(AVG(r.rating), 0)::float8 AS average_given_rating
+FROM sessions s
+LEFT JOIN ratings r ON r.session_id = s.id AND r.user_id = s.user_id
+WHERE s.user_id = $1 AND s.advisor_id IS NOT NULL AND s.status = 'ENDED'
+GROUP BY s.advisor_id
+`
+
+type GetUserAdvisorHistoryRow struct {
+	AdvisorID          uuid.UUID `json:"advisor_id"`
+	SessionCount       int32     `json:"session_count"`
+	RatingCount        int32     `json:"rating_count"`
+	AverageGivenRating float64   `json:"average_given_rating"`
+}
+
+// Sessions the user had with each advisor and the ratings they gave
+func (q *Queries) GetUserAdvisorHistory(ctx context.Context, userID uuid.UUID) ([]GetUserAdvisorHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserAdvisorHistory, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserAdvisorHistoryRow
+	for rows.Next() {
+		var i GetUserAdvisorHistoryRow
+		if err := rows.Scan(
+			&i.AdvisorID,
+			&i.SessionCount,
+			&i.RatingCount,
+			&i.AverageGivenRating,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserBalance = `-- name: GetUserBalance :one
+SELECT COALESCE((SELECT balance FROM user_balances WHERE user_id = $1), 0)::text
+`
+
+func (q *Queries) GetUserBalance(ctx context.Context, userID uuid.UUID) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserBalance, userID)
+	var column_1 string
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, phone, password_hash, display_name, role, gender, dob, created_at, updated_at, is_active, fcm_token, apns_token, device_type FROM users WHERE email = $1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email sql.NullString) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.Role,
+		&i.Gender,
+		&i.Dob,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsActive,
+		&i.FcmToken,
+		&i.ApnsToken,
+		&i.DeviceType,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, email, phone, password_hash, display_name, role, gender, dob, created_at, updated_at, is_active, fcm_token, apns_token, device_type FROM users WHERE id = $1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.Role,
+		&i.Gender,
+		&i.Dob,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsActive,
+		&i.FcmToken,
+		&i.ApnsToken,
+		&i.DeviceType,
+	)
+	return i, err
+}
+
+const getUserByPhone = `-- name: GetUserByPhone :one
+SELECT id, email, phone, password_hash, display_name, role, gender, dob, created_at, updated_at, is_active, fcm_token, apns_token, device_type FROM users WHERE phone = $1
+`
+
+func (q *Queries) GetUserByPhone(ctx context.Context, phone sql.NullString) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByPhone, phone)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Phone,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.Role,
+		&i.Gender,
+		&i.Dob,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.IsActive,
+		&i.FcmToken,
+		&i.ApnsToken,
+		&i.DeviceType,
+	)
+	return i, err
+}
+
+const getUserDeviceTokens = `-- name: GetUserDeviceTokens :one
+SELECT fcm_token, apns_token, device_type FROM users WHERE id = $1
+`
+
+type GetUserDeviceTokensRow struct {
+	FcmToken   sql.NullString `json:"fcm_token"`
+	ApnsToken  sql.NullString `json:"apns_token"`
+	DeviceType sql.NullString `json:"device_type"`
+}
+
+func (q *Queries) GetUserDeviceTokens(ctx context.Context, id uuid.UUID) (GetUserDeviceTokensRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserDeviceTokens, id)
+	var i GetUserDeviceTokensRow
+	err := row.Scan(&i.FcmToken, &i.ApnsToken, &i.DeviceType)
+	return i, err
+}
+
+const getUserReports = `-- name: GetUserReports :many
+SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status, source, message_id FROM admin_flags WHERE reported_user_id = $1 OR reported_advisor_id = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) GetUserReports(ctx context.Context, reportedUserID uuid.NullUUID) ([]AdminFlag, error) {
+	rows, err := q.db.QueryContext(ctx, getUserReports, reportedUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdminFlag
+	for rows.Next() {
+		var i AdminFlag
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReportedBy,
+			&i.ReportedUserID,
+			&i.ReportedAdvisorID,
+			&i.Reason,
+			&i.SessionID,
+			&i.CreatedAt,
+			&i.Status,
+			&i.Source,
+			&i.MessageID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSessionHistory = `-- name: GetUserSessionHistory :many
+SELECT s.id, s.user_id, s.advisor_id, s.type, s.started_at, s.ended_at, s.status, s.pricing_type, s.price_per_minute, s.min_billable_minutes, s.discount_percent, s.currency, s.requested_at, s.accepted_at, s.billing_started_at, s.billed_minutes, s.billed_amount, a.user_id as advisor_user_id
+FROM sessions s
+LEFT JOIN advisors a ON s.advisor_id = a.id
+WHERE s.user_id = $1
+ORDER BY s.started_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type GetUserSessionHistoryParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Limit  int32     `json:"limit"`
+	Offset int32     `json:"offset"`
+}
+
+type GetUserSessionHistoryRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	AdvisorID          uuid.NullUUID  `json:"advisor_id"`
+	Type               string         `json:"type"`
+	StartedAt          sql.NullTime   `json:"started_at"`
+	EndedAt            sql.NullTime   `json:"ended_at"`
+	Status             sql.NullString `json:"status"`
+	PricingType        sql.NullString `json:"pricing_type"`
+	PricePerMinute     sql.NullString `json:"price_per_minute"`
+	MinBillableMinutes sql.NullInt32  `json:"min_billable_minutes"`
+	DiscountPercent    sql.NullString `json:"discount_percent"`
+	Currency           sql.NullString `json:"currency"`
+	RequestedAt        sql.NullTime   `json:"requested_at"`
+	AcceptedAt         sql.NullTime   `json:"accepted_at"`
+	BillingStartedAt   sql.NullTime   `json:"billing_started_at"`
+	BilledMinutes      int32          `json:"billed_minutes"`
+	BilledAmount       string         `json:"billed_amount"`
+	AdvisorUserID      uuid.NullUUID  `json:"advisor_user_id"`
+}
+
+func (q *Queries) GetUserSessionHistory(ctx context.Context, arg GetUserSessionHistoryParams) ([]GetUserSessionHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserSessionHistory, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserSessionHistoryRow
+	for rows.Next() {
+		var i GetUserSessionHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+			&i.PricingType,
+			&i.PricePerMinute,
+			&i.MinBillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
+			&i.RequestedAt,
+			&i.AcceptedAt,
+			&i.BillingStartedAt,
+			&i.BilledMinutes,
+			&i.BilledAmount,
+			&i.AdvisorUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSessions = `-- name: GetUserSessions :many
+SELECT id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount FROM sessions WHERE user_id = $1 ORDER BY started_at DESC LIMIT $2 OFFSET $3
+`
+
+type GetUserSessionsParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Limit  int32     `json:"limit"`
+	Offset int32     `json:"offset"`
+}
+
+func (q *Queries) GetUserSessions(ctx context.Context, arg GetUserSessionsParams) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, getUserSessions, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+			&i.PricingType,
+			&i.PricePerMinute,
+			&i.MinBillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
+			&i.RequestedAt,
+			&i.AcceptedAt,
+			&i.BillingStartedAt,
+			&i.BilledMinutes,
+			&i.BilledAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSpecializations = `-- name: GetUserSpecializations :many
+SELECT s.name, s.category FROM specializations s
+JOIN advisors a ON a.specializations && ARRAY[s.name]
+WHERE a.user_id = $1
+`
+
+type GetUserSpecializationsRow struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+func (q *Queries) GetUserSpecializations(ctx context.Context, userID uuid.UUID) ([]GetUserSpecializationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserSpecializations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserSpecializationsRow
+	for rows.Next() {
+		var i GetUserSpecializationsRow
+		if err := rows.Scan(&i.Name, &i.Category); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertAIInteraction = `-- name: InsertAIInteraction :one
+INSERT INTO ai_interactions (user_id, prompt, response, content_key_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, prompt, response, created_at, content_key_id
+`
+
+type InsertAIInteractionParams struct {
+	UserID       uuid.UUID     `json:"user_id"`
+	Prompt       string        `json:"prompt"`
+	Response     string        `json:"response"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+}
+
+func (q *Queries) InsertAIInteraction(ctx context.Context, arg InsertAIInteractionParams) (AiInteraction, error) {
+	row := q.db.QueryRowContext(ctx, insertAIInteraction,
+		arg.UserID,
+		arg.Prompt,
+		arg.Response,
+		arg.ContentKeyID,
+	)
+	var i AiInteraction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Prompt,
+		&i.Response,
+		&i.CreatedAt,
+		&i.ContentKeyID,
+	)
+	return i, err
+}
+
+const insertAdvisorApplicationEvent = `-- name: InsertAdvisorApplicationEvent :exec
+INSERT INTO advisor_application_events (application_id, from_status, to_status, actor_id, note)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertAdvisorApplicationEventParams struct {
+	ApplicationID uuid.UUID      `json:"application_id"`
+	FromStatus    string         `json:"from_status"`
+	ToStatus      string         `json:"to_status"`
+	ActorID       uuid.UUID      `json:"actor_id"`
+	Note          sql.NullString `json:"note"`
+}
+
+func (q *Queries) InsertAdvisorApplicationEvent(ctx context.Context, arg InsertAdvisorApplicationEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertAdvisorApplicationEvent,
+		arg.ApplicationID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ActorID,
+		arg.Note,
+	)
+	return err
+}
+
+const insertAttachmentMessage = `-- name: InsertAttachmentMessage :one
+WITH claimed AS (
+    UPDATE chat_attachments a SET message_id = $1
+    WHERE a.id = $7 AND a.session_id = $8
+      AND a.uploader_id = $9 AND a.message_id IS NULL
+    RETURNING a.session_id, a.uploader_id
+)
+INSERT INTO chat_messages (id, session_id, sender_type, sender_id, content, content_key_id, search_terms, kind, client_message_id)
+SELECT $1, claimed.session_id, $2, claimed.uploader_id, $3, $4, $5::text[], 'ATTACHMENT', $6
+FROM claimed
+RETURNING id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms
+`
+
+type InsertAttachmentMessageParams struct {
+	ID              uuid.UUID      `json:"id"`
+	SenderType      string         `json:"sender_type"`
+	Content         string         `json:"content"`
+	ContentKeyID    uuid.NullUUID  `json:"content_key_id"`
+	SearchTerms     []string       `json:"search_terms"`
+	ClientMessageID sql.NullString `json:"client_message_id"`
+	AttachmentID    uuid.UUID      `json:"attachment_id"`
+	SessionID       uuid.UUID      `json:"session_id"`
+	SenderID        uuid.UUID      `json:"sender_id"`
+}
+
+// Sends an attachment the sender uploaded to the session. Claiming the attachment and
+// inserting the message is one statement, so an attachment is only ever sent once.
+func (q *Queries) InsertAttachmentMessage(ctx context.Context, arg InsertAttachmentMessageParams) (ChatMessage, error) {
+	row := q.db.QueryRowContext(ctx, insertAttachmentMessage,
+		arg.ID,
+		arg.SenderType,
+		arg.Content,
+		arg.ContentKeyID,
+		pq.Array(arg.SearchTerms),
+		arg.ClientMessageID,
+		arg.AttachmentID,
+		arg.SessionID,
+		arg.SenderID,
+	)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.SenderType,
+		&i.SenderID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.IsRead,
+		&i.ReadAt,
+		&i.EditedAt,
+		&i.DeletedAt,
+		&i.Kind,
+		&i.ClientMessageID,
+		&i.ContentKeyID,
+		pq.Array(&i.SearchTerms),
+	)
+	return i, err
+}
+
+const insertCallLog = `-- name: InsertCallLog :one
+INSERT INTO call_logs (session_id, external_call_id, started_at, ended_at, duration_seconds, status)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, session_id, external_call_id, started_at, ended_at, duration_seconds, status, status_update, status_timestamp
+`
+
+type InsertCallLogParams struct {
+	SessionID       uuid.UUID      `json:"session_id"`
+	ExternalCallID  sql.NullString `json:"external_call_id"`
+	StartedAt       sql.NullTime   `json:"started_at"`
+	EndedAt         sql.NullTime   `json:"ended_at"`
+	DurationSeconds sql.NullInt32  `json:"duration_seconds"`
+	Status          sql.NullString `json:"status"`
+}
+
+func (q *Queries) InsertCallLog(ctx context.Context, arg InsertCallLogParams) (CallLog, error) {
+	row := q.db.QueryRowContext(ctx, insertCallLog,
+		arg.SessionID,
+		arg.ExternalCallID,
+		arg.StartedAt,
+		arg.EndedAt,
+		arg.DurationSeconds,
+		arg.Status,
+	)
+	var i CallLog
+	err := row.Scan(
+		&i.ID,
+		&i.SessionID,
+		&i.ExternalCallID,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.DurationSeconds,
+		&i.Status,
+		&i.StatusUpdate,
+		&i.StatusTimestamp,
+	)
+	return i, err
+}
+
+const insertCredentialDocument = `-- name: InsertCredentialDocument :one
+INSERT INTO advisor_credential_documents (application_id, file_name, mime_type, size_bytes, encrypted_content)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, application_id, file_name, mime_type, size_bytes, created_at
+`
+
+type InsertCredentialDocumentParams struct {
+	ApplicationID    uuid.UUID `json:"application_id"`
+	FileName         string    `json:"file_name"`
+	MimeType         string    `json:"mime_type"`
+	SizeBytes        int32     `json:"size_bytes"`
+	EncryptedContent []byte    `json:"encrypted_content"`
+}
+
+type InsertCredentialDocumentRow struct {
+	ID            uuid.UUID    `json:"id"`
+	ApplicationID uuid.UUID    `json:"application_id"`
+	FileName      string       `json:"file_name"`
+	MimeType      string       `json:"mime_type"`
+	SizeBytes     int32        `json:"size_bytes"`
+	CreatedAt     sql.NullTime `json:"created_at"`
+}
+
+func (q *Queries) InsertCredentialDocument(ctx context.Context, arg InsertCredentialDocumentParams) (InsertCredentialDocumentRow, error) {
+	row := q.db.QueryRowContext(ctx, insertCredentialDocument,
+		arg.ApplicationID,
+		arg.FileName,
+		arg.MimeType,
+		arg.SizeBytes,
+		arg.EncryptedContent,
+	)
+	var i InsertCredentialDocumentRow
+	err := row.Scan(
+		&i.ID,
+		&i.ApplicationID,
+		&i.FileName,
+		&i.MimeType,
+		&i.SizeBytes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertMessageWithID = `-- name: InsertMessageWithID :one
+INSERT INTO chat_messages (session_id, sender_type, sender_id, content, content_key_id, search_terms, client_message_id)
+VALUES ($1, $2, $3, $4, $5, $6::text[], $7)
+RETURNING id
+`
+
+type InsertMessageWithIDParams struct {
+	SessionID       uuid.UUID      `json:"session_id"`
+	SenderType      string         `json:"sender_type"`
+	SenderID        uuid.UUID      `json:"sender_id"`
+	Content         string         `json:"content"`
+	ContentKeyID    uuid.NullUUID  `json:"content_key_id"`
+	SearchTerms     []string       `json:"search_terms"`
+	ClientMessageID sql.NullString `json:"client_message_id"`
+}
+
+func (q *Queries) InsertMessageWithID(ctx context.Context, arg InsertMessageWithIDParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, insertMessageWithID,
+		arg.SessionID,
+		arg.SenderType,
+		arg.SenderID,
+		arg.Content,
+		arg.ContentKeyID,
+		pq.Array(arg.SearchTerms),
+		arg.ClientMessageID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const joinAdvisorQueue = `-- name: JoinAdvisorQueue :one
+INSERT INTO advisor_queue_entries (advisor_id, user_id)
+VALUES ($1, $2)
+RETURNING id, advisor_id, user_id, status, session_id, offered_at, offer_expires_at, created_at, updated_at
+`
+
+type JoinAdvisorQueueParams struct {
+	AdvisorID uuid.UUID `json:"advisor_id"`
+	UserID    uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) JoinAdvisorQueue(ctx context.Context, arg JoinAdvisorQueueParams) (AdvisorQueueEntry, error) {
+	row := q.db.QueryRowContext(ctx, joinAdvisorQueue, arg.AdvisorID, arg.UserID)
+	var i AdvisorQueueEntry
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.SessionID,
+		&i.OfferedAt,
+		&i.OfferExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const leaveAdvisorQueue = `-- name: LeaveAdvisorQueue :one
+UPDATE advisor_queue_entries
+SET status = 'LEFT', updated_at = NOW()
+WHERE id = $1 AND user_id = $2 AND status IN ('WAITING', 'OFFERED')
+RETURNING id, advisor_id, user_id, status, session_id, offered_at, offer_expires_at, created_at, updated_at
+`
+
+type LeaveAdvisorQueueParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) LeaveAdvisorQueue(ctx context.Context, arg LeaveAdvisorQueueParams) (AdvisorQueueEntry, error) {
+	row := q.db.QueryRowContext(ctx, leaveAdvisorQueue, arg.ID, arg.UserID)
+	var i AdvisorQueueEntry
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.SessionID,
+		&i.OfferedAt,
+		&i.OfferExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAIInteractionsToReencrypt = `-- name: ListAIInteractionsToReencrypt :many
+SELECT id, prompt, response, content_key_id FROM ai_interactions
+WHERE (prompt <> '' OR response <> '') AND content_key_id IS DISTINCT FROM $1::uuid
+LIMIT $2
+`
+
+type ListAIInteractionsToReencryptParams struct {
+	ActiveKeyID uuid.NullUUID `json:"active_key_id"`
+	BatchSize   int32         `json:"batch_size"`
+}
+
+type ListAIInteractionsToReencryptRow struct {
+	ID           uuid.UUID     `json:"id"`
+	Prompt       string        `json:"prompt"`
+	Response     string        `json:"response"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+}
+
+func (q *Queries) ListAIInteractionsToReencrypt(ctx context.Context, arg ListAIInteractionsToReencryptParams) ([]ListAIInteractionsToReencryptRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAIInteractionsToReencrypt, arg.ActiveKeyID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAIInteractionsToReencryptRow
+	for rows.Next() {
+		var i ListAIInteractionsToReencryptRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Prompt,
+			&i.Response,
+			&i.ContentKeyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAdvisorApplicationsByStatus = `-- name: ListAdvisorApplicationsByStatus :many
+SELECT id, advisor_id, user_id, status, reviewer_id, reviewer_notes, rejection_reason, submitted_at, reviewed_at, created_at, updated_at FROM advisor_applications WHERE status = $1 ORDER BY submitted_at ASC NULLS LAST LIMIT $2 OFFSET $3
+`
+
+type ListAdvisorApplicationsByStatusParams struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListAdvisorApplicationsByStatus(ctx context.Context, arg ListAdvisorApplicationsByStatusParams) ([]AdvisorApplication, error) {
+	rows, err := q.db.QueryContext(ctx, listAdvisorApplicationsByStatus, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdvisorApplication
+	for rows.Next() {
+		var i AdvisorApplication
+		if err := rows.Scan(
+			&i.ID,
+			&i.AdvisorID,
+			&i.UserID,
+			&i.Status,
+			&i.ReviewerID,
+			&i.ReviewerNotes,
+			&i.RejectionReason,
+			&i.SubmittedAt,
+			&i.ReviewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAdvisorPricing = `-- name: ListAdvisorPricing :many
+SELECT id, advisor_id, session_type, per_minute_rate, min_billable_minutes, first_session_discount_percent, currency, created_at, updated_at FROM advisor_pricing WHERE advisor_id = $1 ORDER BY session_type
+`
+
+func (q *Queries) ListAdvisorPricing(ctx context.Context, advisorID uuid.UUID) ([]AdvisorPricing, error) {
+	rows, err := q.db.QueryContext(ctx, listAdvisorPricing, advisorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdvisorPricing
+	for rows.Next() {
+		var i AdvisorPricing
+		if err := rows.Scan(
+			&i.ID,
+			&i.AdvisorID,
+			&i.SessionType,
+			&i.PerMinuteRate,
+			&i.MinBillableMinutes,
+			&i.FirstSessionDiscountPercent,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAdvisors = `-- name: ListAdvisors :many
+SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, a.tier, a.max_concurrent_chats, a.auto_busy, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type,
+       COALESCE((SELECT AVG(r.rating) FROM ratings r WHERE r.advisor_id = a.user_id), 0)::float8 AS average_rating,
+       p.per_minute_rate
+FROM advisors a
+JOIN users u ON a.user_id = u.id
+LEFT JOIN advisor_pricing p ON p.advisor_id = a.id AND p.session_type = $1::text
+WHERE a.status = 'ONLINE'
+  AND ($2::numeric IS NULL OR p.per_minute_rate >= $2)
+  AND ($3::numeric IS NULL OR p.per_minute_rate <= $3)
+  AND ($4::text IS NULL OR p.currency = $4)
+ORDER BY
+  CASE WHEN $5::text = 'price' THEN p.per_minute_rate END ASC NULLS LAST,
+  CASE WHEN $5::text = 'price_desc' THEN p.per_minute_rate END DESC NULLS LAST,
+  CASE WHEN $5::text = 'experience' THEN a.experience_years END DESC NULLS LAST,
+  average_rating DESC
+LIMIT $7 OFFSET $6
+`
+
+type ListAdvisorsParams struct {
+	PricingType string         `json:"pricing_type"`
+	MinPrice    sql.NullString `json:"min_price"`
+	MaxPrice    sql.NullString `json:"max_price"`
+	Currency    sql.NullString `json:"currency"`
+	Sort        string         `json:"sort"`
+	RowOffset   int32          `json:"row_offset"`
+	RowLimit    int32          `json:"row_limit"`
+}
+
+type ListAdvisorsRow struct {
+	ID                 uuid.UUID      `json:"id"`
+	UserID             uuid.UUID      `json:"user_id"`
+	Bio                sql.NullString `json:"bio"`
+	ExperienceYears    sql.NullInt32  `json:"experience_years"`
+	Languages          []string       `json:"languages"`
+	Specializations    []string       `json:"specializations"`
+	IsVerified         sql.NullBool   `json:"is_verified"`
+	HourlyRate         sql.NullString `json:"hourly_rate"`
+	Status             sql.NullString `json:"status"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	Tier               string         `json:"tier"`
+	MaxConcurrentChats int32          `json:"max_concurrent_chats"`
+	AutoBusy           bool           `json:"auto_busy"`
+	ID_2               uuid.UUID      `json:"id_2"`
+	Email              sql.NullString `json:"email"`
+	Phone              sql.NullString `json:"phone"`
+	PasswordHash       string         `json:"password_hash"`
+	DisplayName        string         `json:"display_name"`
+	Role               string         `json:"role"`
+	Gender             sql.NullString `json:"gender"`
+	Dob                sql.NullTime   `json:"dob"`
+	CreatedAt_2        sql.NullTime   `json:"created_at_2"`
+	UpdatedAt_2        sql.NullTime   `json:"updated_at_2"`
+	IsActive           sql.NullBool   `json:"is_active"`
+	FcmToken           sql.NullString `json:"fcm_token"`
+	ApnsToken          sql.NullString `json:"apns_token"`
+	DeviceType         sql.NullString `json:"device_type"`
+	AverageRating      float64        `json:"average_rating"`
+	PerMinuteRate      sql.NullString `json:"per_minute_rate"`
+}
+
+func (q *Queries) ListAdvisors(ctx context.Context, arg ListAdvisorsParams) ([]ListAdvisorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAdvisors,
+		arg.PricingType,
+		arg.MinPrice,
+		arg.MaxPrice,
+		arg.Currency,
+		arg.Sort,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAdvisorsRow
+	for rows.Next() {
+		var i ListAdvisorsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Bio,
+			&i.ExperienceYears,
+			pq.Array(&i.Languages),
+			pq.Array(&i.Specializations),
+			&i.IsVerified,
+			&i.HourlyRate,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Tier,
+			&i.MaxConcurrentChats,
+			&i.AutoBusy,
+			&i.ID_2,
+			&i.Email,
+			&i.Phone,
+			&i.PasswordHash,
+			&i.DisplayName,
+			&i.Role,
+			&i.Gender,
+			&i.Dob,
+			&i.CreatedAt_2,
+			&i.UpdatedAt_2,
+			&i.IsActive,
+			&i.FcmToken,
+			&i.ApnsToken,
+			&i.DeviceType,
+			&i.AverageRating,
+			&i.PerMinuteRate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBalanceTransactions = `-- name: ListBalanceTransactions :many
+SELECT id, user_id, kind, amount, balance_after, session_id, created_by, note, created_at FROM balance_transactions WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2
+`
+
+type ListBalanceTransactionsParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Limit  int32     `json:"limit"`
+}
+
+func (q *Queries) ListBalanceTransactions(ctx context.Context, arg ListBalanceTransactionsParams) ([]BalanceTransaction, error) {
+	rows, err := q.db.QueryContext(ctx, listBalanceTransactions, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BalanceTransaction
+	for rows.Next() {
+		var i BalanceTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Kind,
+			&i.Amount,
+			&i.BalanceAfter,
+			&i.SessionID,
+			&i.CreatedBy,
+			&i.Note,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -406,31 +4315,36 @@ func (q *Queries) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]Se
 	return items, nil
 }
 
-const getActiveSpecializationsByCategory = `-- name: GetActiveSpecializationsByCategory :many
-SELECT id, name, description, category FROM specializations WHERE category = $1 AND is_active = true ORDER BY name
+const listClientNotes = `-- name: ListClientNotes :many
+SELECT id, advisor_id, user_id, session_id, encrypted_content, created_at, updated_at FROM advisor_client_notes
+WHERE advisor_id = $1 AND user_id = $2
+ORDER BY created_at DESC
+LIMIT $3
 `
 
-type GetActiveSpecializationsByCategoryRow struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
-	Category    string         `json:"category"`
+type ListClientNotesParams struct {
+	AdvisorID uuid.UUID `json:"advisor_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Limit     int32     `json:"limit"`
 }
 
-func (q *Queries) GetActiveSpecializationsByCategory(ctx context.Context, category string) ([]GetActiveSpecializationsByCategoryRow, error) {
-	rows, err := q.db.QueryContext(ctx, getActiveSpecializationsByCategory, category)
+func (q *Queries) ListClientNotes(ctx context.Context, arg ListClientNotesParams) ([]AdvisorClientNote, error) {
+	rows, err := q.db.QueryContext(ctx, listClientNotes, arg.AdvisorID, arg.UserID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetActiveSpecializationsByCategoryRow
+	var items []AdvisorClientNote
 	for rows.Next() {
-		var i GetActiveSpecializationsByCategoryRow
+		var i AdvisorClientNote
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.Category,
+			&i.AdvisorID,
+			&i.UserID,
+			&i.SessionID,
+			&i.EncryptedContent,
+			&i.CreatedAt,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -445,120 +4359,64 @@ func (q *Queries) GetActiveSpecializationsByCategory(ctx context.Context, catego
 	return items, nil
 }
 
-const getAdvisorByID = `-- name: GetAdvisorByID :one
-SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type FROM advisors a JOIN users u ON a.user_id = u.id WHERE a.id = $1
-`
-
-type GetAdvisorByIDRow struct {
-	ID              uuid.UUID      `json:"id"`
-	UserID          uuid.UUID      `json:"user_id"`
-	Bio             sql.NullString `json:"bio"`
-	ExperienceYears sql.NullInt32  `json:"experience_years"`
-	Languages       []string       `json:"languages"`
-	Specializations []string       `json:"specializations"`
-	IsVerified      sql.NullBool   `json:"is_verified"`
-	HourlyRate      sql.NullString `json:"hourly_rate"`
-	Status          sql.NullString `json:"status"`
-	CreatedAt       sql.NullTime   `json:"created_at"`
-	UpdatedAt       sql.NullTime   `json:"updated_at"`
-	ID_2            uuid.UUID      `json:"id_2"`
-	Email           sql.NullString `json:"email"`
-	Phone           sql.NullString `json:"phone"`
-	PasswordHash    string         `json:"password_hash"`
-	DisplayName     string         `json:"display_name"`
-	Role            string         `json:"role"`
-	Gender          sql.NullString `json:"gender"`
-	Dob             sql.NullTime   `json:"dob"`
-	CreatedAt_2     sql.NullTime   `json:"created_at_2"`
-	UpdatedAt_2     sql.NullTime   `json:"updated_at_2"`
-	IsActive        sql.NullBool   `json:"is_active"`
-	FcmToken        sql.NullString `json:"fcm_token"`
-	ApnsToken       sql.NullString `json:"apns_token"`
-	DeviceType      sql.NullString `json:"device_type"`
-}
-
-func (q *Queries) GetAdvisorByID(ctx context.Context, id uuid.UUID) (GetAdvisorByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getAdvisorByID, id)
-	var i GetAdvisorByIDRow
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Bio,
-		&i.ExperienceYears,
-		pq.Array(&i.Languages),
-		pq.Array(&i.Specializations),
-		&i.IsVerified,
-		&i.HourlyRate,
-		&i.Status,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.ID_2,
-		&i.Email,
-		&i.Phone,
-		&i.PasswordHash,
-		&i.DisplayName,
-		&i.Role,
-		&i.Gender,
-		&i.Dob,
-		&i.CreatedAt_2,
-		&i.UpdatedAt_2,
-		&i.IsActive,
-		&i.FcmToken,
-		&i.ApnsToken,
-		&i.DeviceType,
-	)
-	return i, err
-}
-
-const getAdvisorByUserID = `-- name: GetAdvisorByUserID :one
-SELECT id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at FROM advisors WHERE user_id = $1
+const listCommissionTiers = `-- name: ListCommissionTiers :many
+SELECT tier, commission_percent, updated_at FROM commission_tiers ORDER BY tier
 `
 
-func (q *Queries) GetAdvisorByUserID(ctx context.Context, userID uuid.UUID) (Advisor, error) {
-	row := q.db.QueryRowContext(ctx, getAdvisorByUserID, userID)
-	var i Advisor
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Bio,
-		&i.ExperienceYears,
-		pq.Array(&i.Languages),
-		pq.Array(&i.Specializations),
-		&i.IsVerified,
-		&i.HourlyRate,
-		&i.Status,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+func (q *Queries) ListCommissionTiers(ctx context.Context) ([]CommissionTier, error) {
+	rows, err := q.db.QueryContext(ctx, listCommissionTiers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommissionTier
+	for rows.Next() {
+		var i CommissionTier
+		if err := rows.Scan(&i.Tier, &i.CommissionPercent, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getAdvisorRatings = `-- name: GetAdvisorRatings :many
-SELECT id, session_id, user_id, advisor_id, rating, review_text, created_at FROM ratings WHERE advisor_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3
+const listCredentialDocuments = `-- name: ListCredentialDocuments :many
+SELECT id, application_id, file_name, mime_type, size_bytes, created_at
+FROM advisor_credential_documents
+WHERE application_id = $1
+ORDER BY created_at
 `
 
-type GetAdvisorRatingsParams struct {
-	AdvisorID uuid.UUID `json:"advisor_id"`
-	Limit     int32     `json:"limit"`
-	Offset    int32     `json:"offset"`
+type ListCredentialDocumentsRow struct {
+	ID            uuid.UUID    `json:"id"`
+	ApplicationID uuid.UUID    `json:"application_id"`
+	FileName      string       `json:"file_name"`
+	MimeType      string       `json:"mime_type"`
+	SizeBytes     int32        `json:"size_bytes"`
+	CreatedAt     sql.NullTime `json:"created_at"`
 }
 
-func (q *Queries) GetAdvisorRatings(ctx context.Context, arg GetAdvisorRatingsParams) ([]Rating, error) {
-	rows, err := q.db.QueryContext(ctx, getAdvisorRatings, arg.AdvisorID, arg.Limit, arg.Offset)
+func (q *Queries) ListCredentialDocuments(ctx context.Context, applicationID uuid.UUID) ([]ListCredentialDocumentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCredentialDocuments, applicationID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Rating
+	var items []ListCredentialDocumentsRow
 	for rows.Next() {
-		var i Rating
+		var i ListCredentialDocumentsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.SessionID,
-			&i.UserID,
-			&i.AdvisorID,
-			&i.Rating,
-			&i.ReviewText,
+			&i.ApplicationID,
+			&i.FileName,
+			&i.MimeType,
+			&i.SizeBytes,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -574,43 +4432,53 @@ func (q *Queries) GetAdvisorRatings(ctx context.Context, arg GetAdvisorRatingsPa
 	return items, nil
 }
 
-const getAdvisorRatingsWithReviewer = `-- name: GetAdvisorRatingsWithReviewer :many
-SELECT r.id, r.session_id, r.user_id, r.advisor_id, r.rating, r.review_text, r.created_at, u.display_name as reviewer_name
-FROM ratings r
-JOIN users u ON r.user_id = u.id
-WHERE r.advisor_id = $1
-ORDER BY r.created_at DESC
+const listDueSessionCharges = `-- name: ListDueSessionCharges :many
+SELECT d.id, d.user_id, d.advisor_id, d.currency, d.billed_minutes, d.billed_amount, d.minute_price, d.due_minutes, COALESCE(b.balance, 0)::text AS balance
+FROM (
+    SELECT s.id, s.user_id, s.advisor_id, s.currency, s.billed_minutes, s.billed_amount::text AS billed_amount,
+           ROUND(s.price_per_minute * (100 - COALESCE(s.discount_percent, 0)) / 100, 2)::text AS minute_price,
+           GREATEST(CEIL(EXTRACT(EPOCH FROM (NOW() - s.billing_started_at)) / 60), COALESCE(s.min_billable_minutes, 0))::INTEGER AS due_minutes
+    FROM sessions s
+    WHERE s.status = 'ONGOING'
+      AND s.billing_started_at IS NOT NULL
+      AND ($1::uuid IS NULL OR s.id = $1)
+) d
+LEFT JOIN user_balances b ON b.user_id = d.user_id
+WHERE d.due_minutes > d.billed_minutes
 `
 
-type GetAdvisorRatingsWithReviewerRow struct {
-	ID           uuid.UUID      `json:"id"`
-	SessionID    uuid.UUID      `json:"session_id"`
-	UserID       uuid.UUID      `json:"user_id"`
-	AdvisorID    uuid.UUID      `json:"advisor_id"`
-	Rating       int32          `json:"rating"`
-	ReviewText   sql.NullString `json:"review_text"`
-	CreatedAt    sql.NullTime   `json:"created_at"`
-	ReviewerName string         `json:"reviewer_name"`
+type ListDueSessionChargesRow struct {
+	ID            uuid.UUID      `json:"id"`
+	UserID        uuid.UUID      `json:"user_id"`
+	AdvisorID     uuid.NullUUID  `json:"advisor_id"`
+	Currency      sql.NullString `json:"currency"`
+	BilledMinutes int32          `json:"billed_minutes"`
+	BilledAmount  string         `json:"billed_amount"`
+	MinutePrice   string         `json:"minute_price"`
+	DueMinutes    int32          `json:"due_minutes"`
+	Balance       string         `json:"balance"`
 }
 
-func (q *Queries) GetAdvisorRatingsWithReviewer(ctx context.Context, advisorID uuid.UUID) ([]GetAdvisorRatingsWithReviewerRow, error) {
-	rows, err := q.db.QueryContext(ctx, getAdvisorRatingsWithReviewer, advisorID)
+// Metered chats with started minutes not charged yet, at least the minimum billable minutes
+func (q *Queries) ListDueSessionCharges(ctx context.Context, sessionID uuid.NullUUID) ([]ListDueSessionChargesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDueSessionCharges, sessionID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAdvisorRatingsWithReviewerRow
+	var items []ListDueSessionChargesRow
 	for rows.Next() {
-		var i GetAdvisorRatingsWithReviewerRow
+		var i ListDueSessionChargesRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.SessionID,
 			&i.UserID,
 			&i.AdvisorID,
-			&i.Rating,
-			&i.ReviewText,
-			&i.CreatedAt,
-			&i.ReviewerName,
+			&i.Currency,
+			&i.BilledMinutes,
+			&i.BilledAmount,
+			&i.MinutePrice,
+			&i.DueMinutes,
+			&i.Balance,
 		); err != nil {
 			return nil, err
 		}
@@ -625,35 +4493,53 @@ func (q *Queries) GetAdvisorRatingsWithReviewer(ctx context.Context, advisorID u
 	return items, nil
 }
 
-const getAllFAQs = `-- name: GetAllFAQs :many
-
-SELECT id, question, answer, category, is_active FROM faqs ORDER BY category, question
+const listEarnings = `-- name: ListEarnings :many
+SELECT id, session_id, advisor_id, session_type, duration_seconds, hourly_rate, gross_amount, commission_percent, commission_amount, net_amount, payout_batch_id, created_at, per_minute_rate, billable_minutes, discount_percent, currency FROM advisor_earnings
+WHERE advisor_id = $1 AND created_at >= $2 AND created_at < $3
+ORDER BY created_at DESC
+LIMIT $5 OFFSET $4
 `
 
-type GetAllFAQsRow struct {
-	ID       uuid.UUID    `json:"id"`
-	Question string       `json:"question"`
-	Answer   string       `json:"answer"`
-	Category string       `json:"category"`
-	IsActive sql.NullBool `json:"is_active"`
+type ListEarningsParams struct {
+	AdvisorID uuid.UUID    `json:"advisor_id"`
+	FromTime  sql.NullTime `json:"from_time"`
+	ToTime    sql.NullTime `json:"to_time"`
+	RowOffset int32        `json:"row_offset"`
+	RowLimit  int32        `json:"row_limit"`
 }
 
-// FAQ Management
-func (q *Queries) GetAllFAQs(ctx context.Context) ([]GetAllFAQsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getAllFAQs)
+func (q *Queries) ListEarnings(ctx context.Context, arg ListEarningsParams) ([]AdvisorEarning, error) {
+	rows, err := q.db.QueryContext(ctx, listEarnings,
+		arg.AdvisorID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAllFAQsRow
+	var items []AdvisorEarning
 	for rows.Next() {
-		var i GetAllFAQsRow
+		var i AdvisorEarning
 		if err := rows.Scan(
 			&i.ID,
-			&i.Question,
-			&i.Answer,
-			&i.Category,
-			&i.IsActive,
+			&i.SessionID,
+			&i.AdvisorID,
+			&i.SessionType,
+			&i.DurationSeconds,
+			&i.HourlyRate,
+			&i.GrossAmount,
+			&i.CommissionPercent,
+			&i.CommissionAmount,
+			&i.NetAmount,
+			&i.PayoutBatchID,
+			&i.CreatedAt,
+			&i.PerMinuteRate,
+			&i.BillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
 		); err != nil {
 			return nil, err
 		}
@@ -668,35 +4554,25 @@ func (q *Queries) GetAllFAQs(ctx context.Context) ([]GetAllFAQsRow, error) {
 	return items, nil
 }
 
-const getAllSpecializations = `-- name: GetAllSpecializations :many
-
-SELECT id, name, description, category, is_active FROM specializations ORDER BY category, name
+const listEncryptionKeys = `-- name: ListEncryptionKeys :many
+SELECT id, purpose, wrapped_key, kek_id, created_at FROM encryption_keys ORDER BY created_at
 `
 
-type GetAllSpecializationsRow struct {
-	ID          uuid.UUID      `json:"id"`
-	Name        string         `json:"name"`
-	Description sql.NullString `json:"description"`
-	Category    string         `json:"category"`
-	IsActive    sql.NullBool   `json:"is_active"`
-}
-
-// Specializations Management
-func (q *Queries) GetAllSpecializations(ctx context.Context) ([]GetAllSpecializationsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getAllSpecializations)
+func (q *Queries) ListEncryptionKeys(ctx context.Context) ([]EncryptionKey, error) {
+	rows, err := q.db.QueryContext(ctx, listEncryptionKeys)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAllSpecializationsRow
+	var items []EncryptionKey
 	for rows.Next() {
-		var i GetAllSpecializationsRow
+		var i EncryptionKey
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.Category,
-			&i.IsActive,
+			&i.Purpose,
+			&i.WrappedKey,
+			&i.KekID,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -711,79 +4587,45 @@ func (q *Queries) GetAllSpecializations(ctx context.Context) ([]GetAllSpecializa
 	return items, nil
 }
 
-const getAverageSessionDuration = `-- name: GetAverageSessionDuration :one
-SELECT AVG(EXTRACT(EPOCH FROM (ended_at - started_at))) FROM sessions WHERE user_id = $1 AND status = 'ENDED'
-`
-
-func (q *Queries) GetAverageSessionDuration(ctx context.Context, userID uuid.UUID) (float64, error) {
-	row := q.db.QueryRowContext(ctx, getAverageSessionDuration, userID)
-	var avg float64
-	err := row.Scan(&avg)
-	return avg, err
-}
-
-const getCallSessionByID = `-- name: GetCallSessionByID :one
-SELECT id, user_id, advisor_id, type, started_at, ended_at, status FROM sessions WHERE id = $1
-`
-
-func (q *Queries) GetCallSessionByID(ctx context.Context, id uuid.UUID) (Session, error) {
-	row := q.db.QueryRowContext(ctx, getCallSessionByID, id)
-	var i Session
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.AdvisorID,
-		&i.Type,
-		&i.StartedAt,
-		&i.EndedAt,
-		&i.Status,
-	)
-	return i, err
-}
-
-const getCallStatus = `-- name: GetCallStatus :one
-SELECT status_update, status_timestamp FROM call_logs WHERE session_id = $1 ORDER BY status_timestamp DESC LIMIT 1
-`
-
-type GetCallStatusRow struct {
-	StatusUpdate    sql.NullString `json:"status_update"`
-	StatusTimestamp sql.NullTime   `json:"status_timestamp"`
-}
-
-func (q *Queries) GetCallStatus(ctx context.Context, sessionID uuid.UUID) (GetCallStatusRow, error) {
-	row := q.db.QueryRowContext(ctx, getCallStatus, sessionID)
-	var i GetCallStatusRow
-	err := row.Scan(&i.StatusUpdate, &i.StatusTimestamp)
-	return i, err
-}
-
-const getFAQsByCategory = `-- name: GetFAQsByCategory :many
-SELECT id, question, answer, category, is_active FROM faqs WHERE category = $1 AND is_active = true ORDER BY question
+const listIdleSessions = `-- name: ListIdleSessions :many
+SELECT s.id, s.user_id, s.advisor_id, s.type, s.started_at, s.ended_at, s.status, s.pricing_type, s.price_per_minute, s.min_billable_minutes, s.discount_percent, s.currency, s.requested_at, s.accepted_at, s.billing_started_at, s.billed_minutes, s.billed_amount FROM sessions s
+WHERE s.status = 'ONGOING'
+  AND s.type = 'CHAT'
+  AND s.advisor_id IS NOT NULL
+  AND s.started_at < $1
+  AND NOT EXISTS (
+      SELECT 1 FROM chat_messages m WHERE m.session_id = s.id AND m.created_at >= $1
+  )
 `
 
-type GetFAQsByCategoryRow struct {
-	ID       uuid.UUID    `json:"id"`
-	Question string       `json:"question"`
-	Answer   string       `json:"answer"`
-	Category string       `json:"category"`
-	IsActive sql.NullBool `json:"is_active"`
-}
-
-func (q *Queries) GetFAQsByCategory(ctx context.Context, category string) ([]GetFAQsByCategoryRow, error) {
-	rows, err := q.db.QueryContext(ctx, getFAQsByCategory, category)
+// Advisor chats with no message since the cutoff, counting from when the chat started
+func (q *Queries) ListIdleSessions(ctx context.Context, idleSince sql.NullTime) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listIdleSessions, idleSince)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetFAQsByCategoryRow
+	var items []Session
 	for rows.Next() {
-		var i GetFAQsByCategoryRow
+		var i Session
 		if err := rows.Scan(
 			&i.ID,
-			&i.Question,
-			&i.Answer,
-			&i.Category,
-			&i.IsActive,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+			&i.PricingType,
+			&i.PricePerMinute,
+			&i.MinBillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
+			&i.RequestedAt,
+			&i.AcceptedAt,
+			&i.BillingStartedAt,
+			&i.BilledMinutes,
+			&i.BilledAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -798,57 +4640,50 @@ func (q *Queries) GetFAQsByCategory(ctx context.Context, category string) ([]Get
 	return items, nil
 }
 
-const getFeedbackPromptBySession = `-- name: GetFeedbackPromptBySession :one
-SELECT id, session_id, user_id, advisor_id, prompt_sent_at, response_received_at, rating, feedback_text
-FROM call_feedback_prompts
+const listMessagesAfter = `-- name: ListMessagesAfter :many
+SELECT id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms FROM chat_messages
 WHERE session_id = $1
-ORDER BY prompt_sent_at DESC
-LIMIT 1
-`
-
-func (q *Queries) GetFeedbackPromptBySession(ctx context.Context, sessionID uuid.UUID) (CallFeedbackPrompt, error) {
-	row := q.db.QueryRowContext(ctx, getFeedbackPromptBySession, sessionID)
-	var i CallFeedbackPrompt
-	err := row.Scan(
-		&i.ID,
-		&i.SessionID,
-		&i.UserID,
-		&i.AdvisorID,
-		&i.PromptSentAt,
-		&i.ResponseReceivedAt,
-		&i.Rating,
-		&i.FeedbackText,
-	)
-	return i, err
-}
-
-const getFlags = `-- name: GetFlags :many
-SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status FROM admin_flags ORDER BY created_at DESC LIMIT $1 OFFSET $2
+  AND (created_at, id) > ($2::timestamptz, $3::uuid)
+ORDER BY created_at, id
+LIMIT $4
 `
 
-type GetFlagsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type ListMessagesAfterParams struct {
+	SessionID   uuid.UUID `json:"session_id"`
+	AfterAt     time.Time `json:"after_at"`
+	AfterID     uuid.UUID `json:"after_id"`
+	MaxMessages int32     `json:"max_messages"`
 }
 
-func (q *Queries) GetFlags(ctx context.Context, arg GetFlagsParams) ([]AdminFlag, error) {
-	rows, err := q.db.QueryContext(ctx, getFlags, arg.Limit, arg.Offset)
+func (q *Queries) ListMessagesAfter(ctx context.Context, arg ListMessagesAfterParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, listMessagesAfter,
+		arg.SessionID,
+		arg.AfterAt,
+		arg.AfterID,
+		arg.MaxMessages,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AdminFlag
+	var items []ChatMessage
 	for rows.Next() {
-		var i AdminFlag
+		var i ChatMessage
 		if err := rows.Scan(
 			&i.ID,
-			&i.ReportedBy,
-			&i.ReportedUserID,
-			&i.ReportedAdvisorID,
-			&i.Reason,
 			&i.SessionID,
+			&i.SenderType,
+			&i.SenderID,
+			&i.Content,
 			&i.CreatedAt,
-			&i.Status,
+			&i.IsRead,
+			&i.ReadAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Kind,
+			&i.ClientMessageID,
+			&i.ContentKeyID,
+			pq.Array(&i.SearchTerms),
 		); err != nil {
 			return nil, err
 		}
@@ -863,18 +4698,29 @@ func (q *Queries) GetFlags(ctx context.Context, arg GetFlagsParams) ([]AdminFlag
 	return items, nil
 }
 
-const getMessages = `-- name: GetMessages :many
-SELECT id, session_id, sender_type, sender_id, content, created_at, is_read FROM chat_messages WHERE session_id = $1 ORDER BY created_at LIMIT $2 OFFSET $3
+const listMessagesBefore = `-- name: ListMessagesBefore :many
+SELECT id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms FROM chat_messages
+WHERE session_id = $1
+  AND ($2::uuid IS NULL OR (created_at, id) < ($3::timestamptz, $2::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
-type GetMessagesParams struct {
-	SessionID uuid.UUID `json:"session_id"`
-	Limit     int32     `json:"limit"`
-	Offset    int32     `json:"offset"`
+type ListMessagesBeforeParams struct {
+	SessionID   uuid.UUID     `json:"session_id"`
+	BeforeID    uuid.NullUUID `json:"before_id"`
+	BeforeAt    sql.NullTime  `json:"before_at"`
+	MaxMessages int32         `json:"max_messages"`
 }
 
-func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]ChatMessage, error) {
-	rows, err := q.db.QueryContext(ctx, getMessages, arg.SessionID, arg.Limit, arg.Offset)
+// Newest first, so the page closest to the cursor comes back; the latest page without one
+func (q *Queries) ListMessagesBefore(ctx context.Context, arg ListMessagesBeforeParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, listMessagesBefore,
+		arg.SessionID,
+		arg.BeforeID,
+		arg.BeforeAt,
+		arg.MaxMessages,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -890,6 +4736,13 @@ func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Cha
 			&i.Content,
 			&i.CreatedAt,
 			&i.IsRead,
+			&i.ReadAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Kind,
+			&i.ClientMessageID,
+			&i.ContentKeyID,
+			pq.Array(&i.SearchTerms),
 		); err != nil {
 			return nil, err
 		}
@@ -904,78 +4757,67 @@ func (q *Queries) GetMessages(ctx context.Context, arg GetMessagesParams) ([]Cha
 	return items, nil
 }
 
-const getPendingAdvisors = `-- name: GetPendingAdvisors :many
-SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type FROM advisors a JOIN users u ON a.user_id = u.id WHERE a.status = 'PENDING' LIMIT $1 OFFSET $2
+const listMessagesToReencrypt = `-- name: ListMessagesToReencrypt :many
+SELECT id, content, content_key_id FROM chat_messages
+WHERE content <> ''
+  AND (content_key_id IS DISTINCT FROM $1::uuid OR search_terms IS NULL)
+LIMIT $2
 `
 
-type GetPendingAdvisorsParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type ListMessagesToReencryptParams struct {
+	ActiveKeyID uuid.NullUUID `json:"active_key_id"`
+	BatchSize   int32         `json:"batch_size"`
 }
 
-type GetPendingAdvisorsRow struct {
-	ID              uuid.UUID      `json:"id"`
-	UserID          uuid.UUID      `json:"user_id"`
-	Bio             sql.NullString `json:"bio"`
-	ExperienceYears sql.NullInt32  `json:"experience_years"`
-	Languages       []string       `json:"languages"`
-	Specializations []string       `json:"specializations"`
-	IsVerified      sql.NullBool   `json:"is_verified"`
-	HourlyRate      sql.NullString `json:"hourly_rate"`
-	Status          sql.NullString `json:"status"`
-	CreatedAt       sql.NullTime   `json:"created_at"`
-	UpdatedAt       sql.NullTime   `json:"updated_at"`
-	ID_2            uuid.UUID      `json:"id_2"`
-	Email           sql.NullString `json:"email"`
-	Phone           sql.NullString `json:"phone"`
-	PasswordHash    string         `json:"password_hash"`
-	DisplayName     string         `json:"display_name"`
-	Role            string         `json:"role"`
-	Gender          sql.NullString `json:"gender"`
-	Dob             sql.NullTime   `json:"dob"`
-	CreatedAt_2     sql.NullTime   `json:"created_at_2"`
-	UpdatedAt_2     sql.NullTime   `json:"updated_at_2"`
-	IsActive        sql.NullBool   `json:"is_active"`
-	FcmToken        sql.NullString `json:"fcm_token"`
-	ApnsToken       sql.NullString `json:"apns_token"`
-	DeviceType      sql.NullString `json:"device_type"`
+type ListMessagesToReencryptRow struct {
+	ID           uuid.UUID     `json:"id"`
+	Content      string        `json:"content"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
 }
 
-func (q *Queries) GetPendingAdvisors(ctx context.Context, arg GetPendingAdvisorsParams) ([]GetPendingAdvisorsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPendingAdvisors, arg.Limit, arg.Offset)
+// Messages not encrypted with the active key or not indexed for search yet
+func (q *Queries) ListMessagesToReencrypt(ctx context.Context, arg ListMessagesToReencryptParams) ([]ListMessagesToReencryptRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMessagesToReencrypt, arg.ActiveKeyID, arg.BatchSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPendingAdvisorsRow
+	var items []ListMessagesToReencryptRow
 	for rows.Next() {
-		var i GetPendingAdvisorsRow
+		var i ListMessagesToReencryptRow
+		if err := rows.Scan(&i.ID, &i.Content, &i.ContentKeyID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listModerationRules = `-- name: ListModerationRules :many
+SELECT detector, action, notice, updated_by, updated_at FROM moderation_rules ORDER BY detector
+`
+
+func (q *Queries) ListModerationRules(ctx context.Context) ([]ModerationRule, error) {
+	rows, err := q.db.QueryContext(ctx, listModerationRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ModerationRule
+	for rows.Next() {
+		var i ModerationRule
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.Bio,
-			&i.ExperienceYears,
-			pq.Array(&i.Languages),
-			pq.Array(&i.Specializations),
-			&i.IsVerified,
-			&i.HourlyRate,
-			&i.Status,
-			&i.CreatedAt,
+			&i.Detector,
+			&i.Action,
+			&i.Notice,
+			&i.UpdatedBy,
 			&i.UpdatedAt,
-			&i.ID_2,
-			&i.Email,
-			&i.Phone,
-			&i.PasswordHash,
-			&i.DisplayName,
-			&i.Role,
-			&i.Gender,
-			&i.Dob,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
-			&i.IsActive,
-			&i.FcmToken,
-			&i.ApnsToken,
-			&i.DeviceType,
 		); err != nil {
 			return nil, err
 		}
@@ -990,38 +4832,74 @@ func (q *Queries) GetPendingAdvisors(ctx context.Context, arg GetPendingAdvisors
 	return items, nil
 }
 
-const getPendingFeedbackPrompts = `-- name: GetPendingFeedbackPrompts :many
-SELECT cfp.id, cfp.session_id, u.display_name as user_name, a.display_name as advisor_name, cfp.prompt_sent_at
-FROM call_feedback_prompts cfp
-JOIN users u ON u.id = cfp.user_id
-JOIN users a ON a.id = cfp.advisor_id
-WHERE cfp.response_received_at IS NULL
-ORDER BY cfp.prompt_sent_at DESC
+const listModerationTerms = `-- name: ListModerationTerms :many
+SELECT term FROM moderation_terms ORDER BY term
 `
 
-type GetPendingFeedbackPromptsRow struct {
-	ID           uuid.UUID    `json:"id"`
-	SessionID    uuid.UUID    `json:"session_id"`
-	UserName     string       `json:"user_name"`
-	AdvisorName  string       `json:"advisor_name"`
-	PromptSentAt sql.NullTime `json:"prompt_sent_at"`
+func (q *Queries) ListModerationTerms(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listModerationTerms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var term string
+		if err := rows.Scan(&term); err != nil {
+			return nil, err
+		}
+		items = append(items, term)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetPendingFeedbackPrompts(ctx context.Context) ([]GetPendingFeedbackPromptsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPendingFeedbackPrompts)
+const listPayoutBatches = `-- name: ListPayoutBatches :many
+SELECT id, advisor_id, status, period_start, period_end, earnings_count, total_amount, created_by, paid_at, payment_reference, created_at FROM payout_batches
+WHERE ($1::uuid IS NULL OR advisor_id = $1)
+  AND ($2::text IS NULL OR status = $2)
+ORDER BY created_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListPayoutBatchesParams struct {
+	AdvisorID uuid.NullUUID  `json:"advisor_id"`
+	Status    sql.NullString `json:"status"`
+	RowOffset int32          `json:"row_offset"`
+	RowLimit  int32          `json:"row_limit"`
+}
+
+func (q *Queries) ListPayoutBatches(ctx context.Context, arg ListPayoutBatchesParams) ([]PayoutBatch, error) {
+	rows, err := q.db.QueryContext(ctx, listPayoutBatches,
+		arg.AdvisorID,
+		arg.Status,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPendingFeedbackPromptsRow
+	var items []PayoutBatch
 	for rows.Next() {
-		var i GetPendingFeedbackPromptsRow
+		var i PayoutBatch
 		if err := rows.Scan(
 			&i.ID,
-			&i.SessionID,
-			&i.UserName,
-			&i.AdvisorName,
-			&i.PromptSentAt,
+			&i.AdvisorID,
+			&i.Status,
+			&i.PeriodStart,
+			&i.PeriodEnd,
+			&i.EarningsCount,
+			&i.TotalAmount,
+			&i.CreatedBy,
+			&i.PaidAt,
+			&i.PaymentReference,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1036,28 +4914,29 @@ func (q *Queries) GetPendingFeedbackPrompts(ctx context.Context) ([]GetPendingFe
 	return items, nil
 }
 
-const getRecentAdminFlags = `-- name: GetRecentAdminFlags :many
-SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status FROM admin_flags WHERE created_at >= NOW() - INTERVAL '%s days' ORDER BY created_at DESC
+const listPlatformTemplates = `-- name: ListPlatformTemplates :many
+SELECT id, advisor_id, title, body, category, is_active, created_by, created_at, updated_at FROM message_templates WHERE advisor_id IS NULL ORDER BY category NULLS LAST, title
 `
 
-func (q *Queries) GetRecentAdminFlags(ctx context.Context) ([]AdminFlag, error) {
-	rows, err := q.db.QueryContext(ctx, getRecentAdminFlags)
+func (q *Queries) ListPlatformTemplates(ctx context.Context) ([]MessageTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listPlatformTemplates)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AdminFlag
+	var items []MessageTemplate
 	for rows.Next() {
-		var i AdminFlag
+		var i MessageTemplate
 		if err := rows.Scan(
 			&i.ID,
-			&i.ReportedBy,
-			&i.ReportedUserID,
-			&i.ReportedAdvisorID,
-			&i.Reason,
-			&i.SessionID,
+			&i.AdvisorID,
+			&i.Title,
+			&i.Body,
+			&i.Category,
+			&i.IsActive,
+			&i.CreatedBy,
 			&i.CreatedAt,
-			&i.Status,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1072,31 +4951,29 @@ func (q *Queries) GetRecentAdminFlags(ctx context.Context) ([]AdminFlag, error)
 	return items, nil
 }
 
-const getRecentEndedSessions = `-- name: GetRecentEndedSessions :many
-SELECT id, user_id, advisor_id, type, started_at, ended_at, status
-FROM sessions
-WHERE status = 'ENDED'
-AND ended_at >= NOW() - INTERVAL '24 hours'
-ORDER BY ended_at DESC
+const listPricingForAdvisors = `-- name: ListPricingForAdvisors :many
+SELECT id, advisor_id, session_type, per_minute_rate, min_billable_minutes, first_session_discount_percent, currency, created_at, updated_at FROM advisor_pricing WHERE advisor_id = ANY($1::uuid[]) ORDER BY advisor_id, session_type
 `
 
-func (q *Queries) GetRecentEndedSessions(ctx context.Context) ([]Session, error) {
-	rows, err := q.db.QueryContext(ctx, getRecentEndedSessions)
+func (q *Queries) ListPricingForAdvisors(ctx context.Context, advisorIds []uuid.UUID) ([]AdvisorPricing, error) {
+	rows, err := q.db.QueryContext(ctx, listPricingForAdvisors, pq.Array(advisorIds))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Session
+	var items []AdvisorPricing
 	for rows.Next() {
-		var i Session
+		var i AdvisorPricing
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
 			&i.AdvisorID,
-			&i.Type,
-			&i.StartedAt,
-			&i.EndedAt,
-			&i.Status,
+			&i.SessionType,
+			&i.PerMinuteRate,
+			&i.MinBillableMinutes,
+			&i.FirstSessionDiscountPercent,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1111,98 +4988,38 @@ func (q *Queries) GetRecentEndedSessions(ctx context.Context) ([]Session, error)
 	return items, nil
 }
 
-const getRecommendedAdvisors = `-- name: GetRecommendedAdvisors :many
-SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type, 
-       COALESCE(AVG(r.rating), 0) as average_rating
-FROM advisors a
-JOIN users u ON a.user_id = u.id
-LEFT JOIN ratings r ON a.user_id = r.advisor_id
-WHERE a.status = 'ONLINE' 
-AND a.is_verified = true
-AND ($1::text[] IS NULL OR a.specializations && $1::text[])
-AND ($2::text[] IS NULL OR a.languages && $2::text[])
-GROUP BY a.id, u.id
-ORDER BY average_rating DESC, a.experience_years DESC
-LIMIT $3 OFFSET $4
+const listQueueHeadsReadyForOffer = `-- name: ListQueueHeadsReadyForOffer :many
+SELECT DISTINCT ON (q.advisor_id) q.id, q.advisor_id, q.user_id, q.status, q.session_id, q.offered_at, q.offer_expires_at, q.created_at, q.updated_at
+FROM advisor_queue_entries q
+JOIN advisors a ON a.user_id = q.advisor_id
+WHERE q.status = 'WAITING'
+  AND a.status = 'ONLINE'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries o WHERE o.advisor_id = q.advisor_id AND o.status = 'OFFERED')
+  AND NOT EXISTS (SELECT 1 FROM sessions s WHERE s.advisor_id = q.advisor_id AND s.status = 'ONGOING' AND s.type = 'CALL')
+  AND (SELECT COUNT(*) FROM sessions s WHERE s.advisor_id = q.advisor_id AND s.status IN ('REQUESTED', 'ONGOING')) < a.max_concurrent_chats
+ORDER BY q.advisor_id, q.created_at
 `
 
-type GetRecommendedAdvisorsParams struct {
-	Column1 []string `json:"column_1"`
-	Column2 []string `json:"column_2"`
-	Limit   int32    `json:"limit"`
-	Offset  int32    `json:"offset"`
-}
-
-type GetRecommendedAdvisorsRow struct {
-	ID              uuid.UUID      `json:"id"`
-	UserID          uuid.UUID      `json:"user_id"`
-	Bio             sql.NullString `json:"bio"`
-	ExperienceYears sql.NullInt32  `json:"experience_years"`
-	Languages       []string       `json:"languages"`
-	Specializations []string       `json:"specializations"`
-	IsVerified      sql.NullBool   `json:"is_verified"`
-	HourlyRate      sql.NullString `json:"hourly_rate"`
-	Status          sql.NullString `json:"status"`
-	CreatedAt       sql.NullTime   `json:"created_at"`
-	UpdatedAt       sql.NullTime   `json:"updated_at"`
-	ID_2            uuid.UUID      `json:"id_2"`
-	Email           sql.NullString `json:"email"`
-	Phone           sql.NullString `json:"phone"`
-	PasswordHash    string         `json:"password_hash"`
-	DisplayName     string         `json:"display_name"`
-	Role            string         `json:"role"`
-	Gender          sql.NullString `json:"gender"`
-	Dob             sql.NullTime   `json:"dob"`
-	CreatedAt_2     sql.NullTime   `json:"created_at_2"`
-	UpdatedAt_2     sql.NullTime   `json:"updated_at_2"`
-	IsActive        sql.NullBool   `json:"is_active"`
-	FcmToken        sql.NullString `json:"fcm_token"`
-	ApnsToken       sql.NullString `json:"apns_token"`
-	DeviceType      sql.NullString `json:"device_type"`
-	AverageRating   interface{}    `json:"average_rating"`
-}
-
-func (q *Queries) GetRecommendedAdvisors(ctx context.Context, arg GetRecommendedAdvisorsParams) ([]GetRecommendedAdvisorsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getRecommendedAdvisors,
-		pq.Array(arg.Column1),
-		pq.Array(arg.Column2),
-		arg.Limit,
-		arg.Offset,
-	)
+// Front of each queue whose advisor is online, below chat capacity, not on a call and has no outstanding offer
+func (q *Queries) ListQueueHeadsReadyForOffer(ctx context.Context) ([]AdvisorQueueEntry, error) {
+	rows, err := q.db.QueryContext(ctx, listQueueHeadsReadyForOffer)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetRecommendedAdvisorsRow
+	var items []AdvisorQueueEntry
 	for rows.Next() {
-		var i GetRecommendedAdvisorsRow
+		var i AdvisorQueueEntry
 		if err := rows.Scan(
 			&i.ID,
+			&i.AdvisorID,
 			&i.UserID,
-			&i.Bio,
-			&i.ExperienceYears,
-			pq.Array(&i.Languages),
-			pq.Array(&i.Specializations),
-			&i.IsVerified,
-			&i.HourlyRate,
 			&i.Status,
+			&i.SessionID,
+			&i.OfferedAt,
+			&i.OfferExpiresAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.ID_2,
-			&i.Email,
-			&i.Phone,
-			&i.PasswordHash,
-			&i.DisplayName,
-			&i.Role,
-			&i.Gender,
-			&i.Dob,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
-			&i.IsActive,
-			&i.FcmToken,
-			&i.ApnsToken,
-			&i.DeviceType,
-			&i.AverageRating,
 		); err != nil {
 			return nil, err
 		}
@@ -1217,28 +5034,69 @@ func (q *Queries) GetRecommendedAdvisors(ctx context.Context, arg GetRecommended
 	return items, nil
 }
 
-const getReportsByStatus = `-- name: GetReportsByStatus :many
-SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status FROM admin_flags WHERE status = $1 ORDER BY created_at DESC
+const listRevisionsToReencrypt = `-- name: ListRevisionsToReencrypt :many
+SELECT id, content, content_key_id FROM chat_message_revisions
+WHERE content <> '' AND content_key_id IS DISTINCT FROM $1::uuid
+LIMIT $2
+`
+
+type ListRevisionsToReencryptParams struct {
+	ActiveKeyID uuid.NullUUID `json:"active_key_id"`
+	BatchSize   int32         `json:"batch_size"`
+}
+
+type ListRevisionsToReencryptRow struct {
+	ID           uuid.UUID     `json:"id"`
+	Content      string        `json:"content"`
+	ContentKeyID uuid.NullUUID `json:"content_key_id"`
+}
+
+func (q *Queries) ListRevisionsToReencrypt(ctx context.Context, arg ListRevisionsToReencryptParams) ([]ListRevisionsToReencryptRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRevisionsToReencrypt, arg.ActiveKeyID, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRevisionsToReencryptRow
+	for rows.Next() {
+		var i ListRevisionsToReencryptRow
+		if err := rows.Scan(&i.ID, &i.Content, &i.ContentKeyID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionCallLogs = `-- name: ListSessionCallLogs :many
+SELECT id, session_id, external_call_id, started_at, ended_at, duration_seconds, status, status_update, status_timestamp FROM call_logs WHERE session_id = $1 ORDER BY started_at NULLS LAST
 `
 
-func (q *Queries) GetReportsByStatus(ctx context.Context, status sql.NullString) ([]AdminFlag, error) {
-	rows, err := q.db.QueryContext(ctx, getReportsByStatus, status)
+func (q *Queries) ListSessionCallLogs(ctx context.Context, sessionID uuid.UUID) ([]CallLog, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionCallLogs, sessionID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AdminFlag
+	var items []CallLog
 	for rows.Next() {
-		var i AdminFlag
+		var i CallLog
 		if err := rows.Scan(
 			&i.ID,
-			&i.ReportedBy,
-			&i.ReportedUserID,
-			&i.ReportedAdvisorID,
-			&i.Reason,
 			&i.SessionID,
-			&i.CreatedAt,
+			&i.ExternalCallID,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.DurationSeconds,
 			&i.Status,
+			&i.StatusUpdate,
+			&i.StatusTimestamp,
 		); err != nil {
 			return nil, err
 		}
@@ -1253,55 +5111,28 @@ func (q *Queries) GetReportsByStatus(ctx context.Context, status sql.NullString)
 	return items, nil
 }
 
-const getSessionByID = `-- name: GetSessionByID :one
-SELECT id, user_id, advisor_id, type, started_at, ended_at, status FROM sessions WHERE id = $1
-`
-
-func (q *Queries) GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error) {
-	row := q.db.QueryRowContext(ctx, getSessionByID, id)
-	var i Session
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.AdvisorID,
-		&i.Type,
-		&i.StartedAt,
-		&i.EndedAt,
-		&i.Status,
-	)
-	return i, err
-}
-
-const getSessionParticipantDeviceTokens = `-- name: GetSessionParticipantDeviceTokens :many
-SELECT DISTINCT u.fcm_token, u.apns_token, u.device_type
-FROM users u
-JOIN sessions s ON (s.user_id = u.id OR s.advisor_id = u.id)
-WHERE s.id = $1
-  AND u.id != $2
-  AND (u.fcm_token IS NOT NULL OR u.apns_token IS NOT NULL)
+const listSessionRatings = `-- name: ListSessionRatings :many
+SELECT id, session_id, user_id, advisor_id, rating, review_text, created_at FROM ratings WHERE session_id = $1 ORDER BY created_at
 `
 
-type GetSessionParticipantDeviceTokensParams struct {
-	ID   uuid.UUID `json:"id"`
-	ID_2 uuid.UUID `json:"id_2"`
-}
-
-type GetSessionParticipantDeviceTokensRow struct {
-	FcmToken   sql.NullString `json:"fcm_token"`
-	ApnsToken  sql.NullString `json:"apns_token"`
-	DeviceType sql.NullString `json:"device_type"`
-}
-
-func (q *Queries) GetSessionParticipantDeviceTokens(ctx context.Context, arg GetSessionParticipantDeviceTokensParams) ([]GetSessionParticipantDeviceTokensRow, error) {
-	rows, err := q.db.QueryContext(ctx, getSessionParticipantDeviceTokens, arg.ID, arg.ID_2)
+func (q *Queries) ListSessionRatings(ctx context.Context, sessionID uuid.UUID) ([]Rating, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionRatings, sessionID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetSessionParticipantDeviceTokensRow
+	var items []Rating
 	for rows.Next() {
-		var i GetSessionParticipantDeviceTokensRow
-		if err := rows.Scan(&i.FcmToken, &i.ApnsToken, &i.DeviceType); err != nil {
+		var i Rating
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Rating,
+			&i.ReviewText,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1315,25 +5146,41 @@ func (q *Queries) GetSessionParticipantDeviceTokens(ctx context.Context, arg Get
 	return items, nil
 }
 
-const getSessionParticipants = `-- name: GetSessionParticipants :many
-SELECT user_id, advisor_id FROM sessions WHERE id = $1
+const listSessionRequests = `-- name: ListSessionRequests :many
+SELECT id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount FROM sessions
+WHERE sessions.advisor_id = $1 AND sessions.status = 'REQUESTED'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries q WHERE q.session_id = sessions.id)
+ORDER BY requested_at
 `
 
-type GetSessionParticipantsRow struct {
-	UserID    uuid.UUID     `json:"user_id"`
-	AdvisorID uuid.NullUUID `json:"advisor_id"`
-}
-
-func (q *Queries) GetSessionParticipants(ctx context.Context, id uuid.UUID) ([]GetSessionParticipantsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getSessionParticipants, id)
+func (q *Queries) ListSessionRequests(ctx context.Context, advisorID uuid.NullUUID) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionRequests, advisorID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetSessionParticipantsRow
+	var items []Session
 	for rows.Next() {
-		var i GetSessionParticipantsRow
-		if err := rows.Scan(&i.UserID, &i.AdvisorID); err != nil {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AdvisorID,
+			&i.Type,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.Status,
+			&i.PricingType,
+			&i.PricePerMinute,
+			&i.MinBillableMinutes,
+			&i.DiscountPercent,
+			&i.Currency,
+			&i.RequestedAt,
+			&i.AcceptedAt,
+			&i.BillingStartedAt,
+			&i.BilledMinutes,
+			&i.BilledAmount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1347,123 +5194,32 @@ func (q *Queries) GetSessionParticipants(ctx context.Context, id uuid.UUID) ([]G
 	return items, nil
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, phone, password_hash, display_name, role, gender, dob, created_at, updated_at, is_active, fcm_token, apns_token, device_type FROM users WHERE email = $1
-`
-
-func (q *Queries) GetUserByEmail(ctx context.Context, email sql.NullString) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByEmail, email)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.Phone,
-		&i.PasswordHash,
-		&i.DisplayName,
-		&i.Role,
-		&i.Gender,
-		&i.Dob,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.IsActive,
-		&i.FcmToken,
-		&i.ApnsToken,
-		&i.DeviceType,
-	)
-	return i, err
-}
-
-const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, phone, password_hash, display_name, role, gender, dob, created_at, updated_at, is_active, fcm_token, apns_token, device_type FROM users WHERE id = $1
-`
-
-func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByID, id)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.Phone,
-		&i.PasswordHash,
-		&i.DisplayName,
-		&i.Role,
-		&i.Gender,
-		&i.Dob,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.IsActive,
-		&i.FcmToken,
-		&i.ApnsToken,
-		&i.DeviceType,
-	)
-	return i, err
-}
-
-const getUserByPhone = `-- name: GetUserByPhone :one
-SELECT id, email, phone, password_hash, display_name, role, gender, dob, created_at, updated_at, is_active, fcm_token, apns_token, device_type FROM users WHERE phone = $1
-`
-
-func (q *Queries) GetUserByPhone(ctx context.Context, phone sql.NullString) (User, error) {
-	row := q.db.QueryRowContext(ctx, getUserByPhone, phone)
-	var i User
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.Phone,
-		&i.PasswordHash,
-		&i.DisplayName,
-		&i.Role,
-		&i.Gender,
-		&i.Dob,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.IsActive,
-		&i.FcmToken,
-		&i.ApnsToken,
-		&i.DeviceType,
-	)
-	return i, err
-}
-
-const getUserDeviceTokens = `-- name: GetUserDeviceTokens :one
-SELECT fcm_token, apns_token, device_type FROM users WHERE id = $1
-`
-
-type GetUserDeviceTokensRow struct {
-	FcmToken   sql.NullString `json:"fcm_token"`
-	ApnsToken  sql.NullString `json:"apns_token"`
-	DeviceType sql.NullString `json:"device_type"`
-}
-
-func (q *Queries) GetUserDeviceTokens(ctx context.Context, id uuid.UUID) (GetUserDeviceTokensRow, error) {
-	row := q.db.QueryRowContext(ctx, getUserDeviceTokens, id)
-	var i GetUserDeviceTokensRow
-	err := row.Scan(&i.FcmToken, &i.ApnsToken, &i.DeviceType)
-	return i, err
-}
-
-const getUserReports = `-- name: GetUserReports :many
-SELECT id, reported_by, reported_user_id, reported_advisor_id, reason, session_id, created_at, status FROM admin_flags WHERE reported_user_id = $1 OR reported_advisor_id = $1 ORDER BY created_at DESC
+const listTemplatesForAdvisor = `-- name: ListTemplatesForAdvisor :many
+SELECT id, advisor_id, title, body, category, is_active, created_by, created_at, updated_at FROM message_templates
+WHERE advisor_id = $1 OR (advisor_id IS NULL AND is_active)
+ORDER BY advisor_id NULLS LAST, category NULLS LAST, title
 `
 
-func (q *Queries) GetUserReports(ctx context.Context, reportedUserID uuid.NullUUID) ([]AdminFlag, error) {
-	rows, err := q.db.QueryContext(ctx, getUserReports, reportedUserID)
+// The advisor's own saved replies followed by active platform templates
+func (q *Queries) ListTemplatesForAdvisor(ctx context.Context, advisorID uuid.NullUUID) ([]MessageTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listTemplatesForAdvisor, advisorID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AdminFlag
+	var items []MessageTemplate
 	for rows.Next() {
-		var i AdminFlag
+		var i MessageTemplate
 		if err := rows.Scan(
 			&i.ID,
-			&i.ReportedBy,
-			&i.ReportedUserID,
-			&i.ReportedAdvisorID,
-			&i.Reason,
-			&i.SessionID,
+			&i.AdvisorID,
+			&i.Title,
+			&i.Body,
+			&i.Category,
+			&i.IsActive,
+			&i.CreatedBy,
 			&i.CreatedAt,
-			&i.Status,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1478,50 +5234,30 @@ func (q *Queries) GetUserReports(ctx context.Context, reportedUserID uuid.NullUU
 	return items, nil
 }
 
-const getUserSessionHistory = `-- name: GetUserSessionHistory :many
-SELECT s.id, s.user_id, s.advisor_id, s.type, s.started_at, s.ended_at, s.status, a.user_id as advisor_user_id
-FROM sessions s
-LEFT JOIN advisors a ON s.advisor_id = a.id
-WHERE s.user_id = $1
-ORDER BY s.started_at DESC
-LIMIT $2 OFFSET $3
+const listTranscriptAttachments = `-- name: ListTranscriptAttachments :many
+SELECT id, session_id, uploader_id, message_id, kind, mime_type, size_bytes, duration_ms, storage_key, created_at FROM chat_attachments WHERE session_id = $1 AND message_id IS NOT NULL
 `
 
-type GetUserSessionHistoryParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
-}
-
-type GetUserSessionHistoryRow struct {
-	ID            uuid.UUID      `json:"id"`
-	UserID        uuid.UUID      `json:"user_id"`
-	AdvisorID     uuid.NullUUID  `json:"advisor_id"`
-	Type          string         `json:"type"`
-	StartedAt     sql.NullTime   `json:"started_at"`
-	EndedAt       sql.NullTime   `json:"ended_at"`
-	Status        sql.NullString `json:"status"`
-	AdvisorUserID uuid.NullUUID  `json:"advisor_user_id"`
-}
-
-func (q *Queries) GetUserSessionHistory(ctx context.Context, arg GetUserSessionHistoryParams) ([]GetUserSessionHistoryRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserSessionHistory, arg.UserID, arg.Limit, arg.Offset)
+func (q *Queries) ListTranscriptAttachments(ctx context.Context, sessionID uuid.UUID) ([]ChatAttachment, error) {
+	rows, err := q.db.QueryContext(ctx, listTranscriptAttachments, sessionID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetUserSessionHistoryRow
+	var items []ChatAttachment
 	for rows.Next() {
-		var i GetUserSessionHistoryRow
+		var i ChatAttachment
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
-			&i.AdvisorID,
-			&i.Type,
-			&i.StartedAt,
-			&i.EndedAt,
-			&i.Status,
-			&i.AdvisorUserID,
+			&i.SessionID,
+			&i.UploaderID,
+			&i.MessageID,
+			&i.Kind,
+			&i.MimeType,
+			&i.SizeBytes,
+			&i.DurationMs,
+			&i.StorageKey,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1536,33 +5272,34 @@ func (q *Queries) GetUserSessionHistory(ctx context.Context, arg GetUserSessionH
 	return items, nil
 }
 
-const getUserSessions = `-- name: GetUserSessions :many
-SELECT id, user_id, advisor_id, type, started_at, ended_at, status FROM sessions WHERE user_id = $1 ORDER BY started_at DESC LIMIT $2 OFFSET $3
-`
-
-type GetUserSessionsParams struct {
-	UserID uuid.UUID `json:"user_id"`
-	Limit  int32     `json:"limit"`
-	Offset int32     `json:"offset"`
-}
+const listTranscriptMessages = `-- name: ListTranscriptMessages :many
+SELECT id, session_id, sender_type, sender_id, content, created_at, is_read, read_at, edited_at, deleted_at, kind, client_message_id, content_key_id, search_terms FROM chat_messages WHERE session_id = $1 ORDER BY created_at, id
+`
 
-func (q *Queries) GetUserSessions(ctx context.Context, arg GetUserSessionsParams) ([]Session, error) {
-	rows, err := q.db.QueryContext(ctx, getUserSessions, arg.UserID, arg.Limit, arg.Offset)
+func (q *Queries) ListTranscriptMessages(ctx context.Context, sessionID uuid.UUID) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, listTranscriptMessages, sessionID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Session
+	var items []ChatMessage
 	for rows.Next() {
-		var i Session
+		var i ChatMessage
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
-			&i.AdvisorID,
-			&i.Type,
-			&i.StartedAt,
-			&i.EndedAt,
-			&i.Status,
+			&i.SessionID,
+			&i.SenderType,
+			&i.SenderID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.IsRead,
+			&i.ReadAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Kind,
+			&i.ClientMessageID,
+			&i.ContentKeyID,
+			pq.Array(&i.SearchTerms),
 		); err != nil {
 			return nil, err
 		}
@@ -1577,27 +5314,31 @@ func (q *Queries) GetUserSessions(ctx context.Context, arg GetUserSessionsParams
 	return items, nil
 }
 
-const getUserSpecializations = `-- name: GetUserSpecializations :many
-SELECT s.name, s.category FROM specializations s
-JOIN advisors a ON a.specializations && ARRAY[s.name]
-WHERE a.user_id = $1
+const listTranscriptRevisions = `-- name: ListTranscriptRevisions :many
+SELECT r.id, r.message_id, r.action, r.content, r.created_at, r.content_key_id FROM chat_message_revisions r
+JOIN chat_messages m ON m.id = r.message_id
+WHERE m.session_id = $1
+ORDER BY r.created_at
 `
 
-type GetUserSpecializationsRow struct {
-	Name     string `json:"name"`
-	Category string `json:"category"`
-}
-
-func (q *Queries) GetUserSpecializations(ctx context.Context, userID uuid.UUID) ([]GetUserSpecializationsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserSpecializations, userID)
+// Edit and delete history of the session's messages, for admin exports
+func (q *Queries) ListTranscriptRevisions(ctx context.Context, sessionID uuid.UUID) ([]ChatMessageRevision, error) {
+	rows, err := q.db.QueryContext(ctx, listTranscriptRevisions, sessionID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetUserSpecializationsRow
+	var items []ChatMessageRevision
 	for rows.Next() {
-		var i GetUserSpecializationsRow
-		if err := rows.Scan(&i.Name, &i.Category); err != nil {
+		var i ChatMessageRevision
+		if err := rows.Scan(
+			&i.ID,
+			&i.MessageID,
+			&i.Action,
+			&i.Content,
+			&i.CreatedAt,
+			&i.ContentKeyID,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1611,206 +5352,310 @@ func (q *Queries) GetUserSpecializations(ctx context.Context, userID uuid.UUID)
 	return items, nil
 }
 
-const insertAIInteraction = `-- name: InsertAIInteraction :one
-INSERT INTO ai_interactions (user_id, prompt, response)
-VALUES ($1, $2, $3)
-RETURNING id, user_id, prompt, response, created_at
+const markPayoutBatchPaid = `-- name: MarkPayoutBatchPaid :one
+UPDATE payout_batches SET status = 'PAID', paid_at = NOW(), payment_reference = $2
+WHERE id = $1 AND status = 'PENDING'
+RETURNING id, advisor_id, status, period_start, period_end, earnings_count, total_amount, created_by, paid_at, payment_reference, created_at
 `
 
-type InsertAIInteractionParams struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Prompt   string    `json:"prompt"`
-	Response string    `json:"response"`
+type MarkPayoutBatchPaidParams struct {
+	ID               uuid.UUID      `json:"id"`
+	PaymentReference sql.NullString `json:"payment_reference"`
 }
 
-func (q *Queries) InsertAIInteraction(ctx context.Context, arg InsertAIInteractionParams) (AiInteraction, error) {
-	row := q.db.QueryRowContext(ctx, insertAIInteraction, arg.UserID, arg.Prompt, arg.Response)
-	var i AiInteraction
+func (q *Queries) MarkPayoutBatchPaid(ctx context.Context, arg MarkPayoutBatchPaidParams) (PayoutBatch, error) {
+	row := q.db.QueryRowContext(ctx, markPayoutBatchPaid, arg.ID, arg.PaymentReference)
+	var i PayoutBatch
 	err := row.Scan(
 		&i.ID,
-		&i.UserID,
-		&i.Prompt,
-		&i.Response,
+		&i.AdvisorID,
+		&i.Status,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.EarningsCount,
+		&i.TotalAmount,
+		&i.CreatedBy,
+		&i.PaidAt,
+		&i.PaymentReference,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const insertCallLog = `-- name: InsertCallLog :one
-INSERT INTO call_logs (session_id, external_call_id, started_at, ended_at, duration_seconds, status)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, session_id, external_call_id, started_at, ended_at, duration_seconds, status, status_update, status_timestamp
+const offerQueueEntry = `-- name: OfferQueueEntry :one
+UPDATE advisor_queue_entries
+SET status = 'OFFERED', session_id = $2, offered_at = NOW(), offer_expires_at = $3, updated_at = NOW()
+WHERE id = $1 AND status = 'WAITING'
+RETURNING id, advisor_id, user_id, status, session_id, offered_at, offer_expires_at, created_at, updated_at
 `
 
-type InsertCallLogParams struct {
-	SessionID       uuid.UUID      `json:"session_id"`
-	ExternalCallID  sql.NullString `json:"external_call_id"`
-	StartedAt       sql.NullTime   `json:"started_at"`
-	EndedAt         sql.NullTime   `json:"ended_at"`
-	DurationSeconds sql.NullInt32  `json:"duration_seconds"`
-	Status          sql.NullString `json:"status"`
+type OfferQueueEntryParams struct {
+	ID             uuid.UUID     `json:"id"`
+	SessionID      uuid.NullUUID `json:"session_id"`
+	OfferExpiresAt sql.NullTime  `json:"offer_expires_at"`
 }
 
-func (q *Queries) InsertCallLog(ctx context.Context, arg InsertCallLogParams) (CallLog, error) {
-	row := q.db.QueryRowContext(ctx, insertCallLog,
-		arg.SessionID,
-		arg.ExternalCallID,
-		arg.StartedAt,
-		arg.EndedAt,
-		arg.DurationSeconds,
-		arg.Status,
-	)
-	var i CallLog
+func (q *Queries) OfferQueueEntry(ctx context.Context, arg OfferQueueEntryParams) (AdvisorQueueEntry, error) {
+	row := q.db.QueryRowContext(ctx, offerQueueEntry, arg.ID, arg.SessionID, arg.OfferExpiresAt)
+	var i AdvisorQueueEntry
 	err := row.Scan(
 		&i.ID,
-		&i.SessionID,
-		&i.ExternalCallID,
-		&i.StartedAt,
-		&i.EndedAt,
-		&i.DurationSeconds,
+		&i.AdvisorID,
+		&i.UserID,
 		&i.Status,
-		&i.StatusUpdate,
-		&i.StatusTimestamp,
+		&i.SessionID,
+		&i.OfferedAt,
+		&i.OfferExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const insertMessage = `-- name: InsertMessage :one
-INSERT INTO chat_messages (session_id, sender_type, sender_id, content)
-VALUES ($1, $2, $3, $4)
-RETURNING id, session_id, sender_type, sender_id, content, created_at, is_read
+const recordSessionEarning = `-- name: RecordSessionEarning :exec
+INSERT INTO advisor_earnings (session_id, advisor_id, session_type, duration_seconds, hourly_rate, per_minute_rate, billable_minutes,
+                              discount_percent, currency, gross_amount, commission_percent, commission_amount, net_amount)
+SELECT e.session_id, e.advisor_id, e.session_type, e.duration_seconds, e.hourly_rate, e.per_minute_rate, e.billable_minutes,
+       e.discount_percent, e.currency, e.gross_amount,
+       e.commission_percent, ROUND(e.gross_amount * e.commission_percent / 100, 2),
+       e.gross_amount - ROUND(e.gross_amount * e.commission_percent / 100, 2)
+FROM (
+    SELECT d.session_id, d.advisor_id, d.session_type, d.duration_seconds, d.hourly_rate, d.per_minute_rate, d.billable_minutes,
+           d.discount_percent, d.currency, d.commission_percent,
+           CASE WHEN d.metered THEN d.billed_amount
+                WHEN d.per_minute_rate IS NULL
+                THEN ROUND(d.hourly_rate * d.duration_seconds / 3600, 2)
+                ELSE ROUND(d.per_minute_rate * d.billable_minutes * (100 - d.discount_percent) / 100, 2)
+           END AS gross_amount
+    FROM (
+        SELECT s.id AS session_id,
+               a.id AS advisor_id,
+               s.type AS session_type,
+               GREATEST(EXTRACT(EPOCH FROM (s.ended_at - s.started_at)), 0)::INTEGER AS duration_seconds,
+               COALESCE(a.hourly_rate, 0) AS hourly_rate,
+               s.price_per_minute AS per_minute_rate,
+               s.type = 'CHAT' AND s.price_per_minute IS NOT NULL AS metered,
+               s.billed_amount,
+               CASE WHEN s.type = 'CHAT' AND s.price_per_minute IS NOT NULL
+                    THEN s.billed_minutes
+                    ELSE GREATEST(CEIL(GREATEST(EXTRACT(EPOCH FROM (s.ended_at - s.started_at)), 0) / 60), COALESCE(s.min_billable_minutes, 0))::INTEGER
+               END AS billable_minutes,
+               COALESCE(s.discount_percent, 0) AS discount_percent,
+               s.currency,
+               COALESCE(ct.commission_percent, 0) AS commission_percent
+        FROM sessions s
+        JOIN advisors a ON a.user_id = s.advisor_id
+        LEFT JOIN commission_tiers ct ON ct.tier = a.tier
+        WHERE s.id = $1 AND s.status = 'ENDED' AND s.type IN ('CHAT', 'CALL') AND s.ended_at IS NOT NULL
+    ) d
+) e
+ON CONFLICT (session_id) DO NOTHING
 `
 
-type InsertMessageParams struct {
-	SessionID  uuid.UUID `json:"session_id"`
-	SenderType string    `json:"sender_type"`
-	SenderID   uuid.UUID `json:"sender_id"`
-	Content    string    `json:"content"`
+// Sessions with a price snapshot are billed per started minute (at least the minimum billable
+// minutes, less any first-session discount); older sessions fall back to the hourly rate.
+// Paid chats are metered while they run, so they earn what the user was charged.
+func (q *Queries) RecordSessionEarning(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, recordSessionEarning, id)
+	return err
 }
 
-func (q *Queries) InsertMessage(ctx context.Context, arg InsertMessageParams) (ChatMessage, error) {
-	row := q.db.QueryRowContext(ctx, insertMessage,
-		arg.SessionID,
-		arg.SenderType,
-		arg.SenderID,
-		arg.Content,
+const reencryptAIInteraction = `-- name: ReencryptAIInteraction :exec
+UPDATE ai_interactions SET prompt = $1, response = $2, content_key_id = $3
+WHERE id = $4 AND content_key_id IS NOT DISTINCT FROM $5::uuid
+`
+
+type ReencryptAIInteractionParams struct {
+	Prompt        string        `json:"prompt"`
+	Response      string        `json:"response"`
+	ContentKeyID  uuid.NullUUID `json:"content_key_id"`
+	ID            uuid.UUID     `json:"id"`
+	PreviousKeyID uuid.NullUUID `json:"previous_key_id"`
+}
+
+func (q *Queries) ReencryptAIInteraction(ctx context.Context, arg ReencryptAIInteractionParams) error {
+	_, err := q.db.ExecContext(ctx, reencryptAIInteraction,
+		arg.Prompt,
+		arg.Response,
+		arg.ContentKeyID,
+		arg.ID,
+		arg.PreviousKeyID,
 	)
-	var i ChatMessage
-	err := row.Scan(
-		&i.ID,
-		&i.SessionID,
-		&i.SenderType,
-		&i.SenderID,
-		&i.Content,
-		&i.CreatedAt,
-		&i.IsRead,
+	return err
+}
+
+const reencryptMessage = `-- name: ReencryptMessage :execrows
+UPDATE chat_messages
+SET content = $1, content_key_id = $2, search_terms = $3::text[]
+WHERE id = $4 AND content = $5 AND deleted_at IS NULL
+`
+
+type ReencryptMessageParams struct {
+	Content         string        `json:"content"`
+	ContentKeyID    uuid.NullUUID `json:"content_key_id"`
+	SearchTerms     []string      `json:"search_terms"`
+	ID              uuid.UUID     `json:"id"`
+	PreviousContent string        `json:"previous_content"`
+}
+
+// Skips messages edited or deleted since they were read
+func (q *Queries) ReencryptMessage(ctx context.Context, arg ReencryptMessageParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reencryptMessage,
+		arg.Content,
+		arg.ContentKeyID,
+		pq.Array(arg.SearchTerms),
+		arg.ID,
+		arg.PreviousContent,
 	)
-	return i, err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const insertMessageWithID = `-- name: InsertMessageWithID :one
-INSERT INTO chat_messages (session_id, sender_type, sender_id, content)
-VALUES ($1, $2, $3, $4)
-RETURNING id
+const reencryptRevision = `-- name: ReencryptRevision :exec
+UPDATE chat_message_revisions SET content = $1, content_key_id = $2
+WHERE id = $3 AND content = $4
 `
 
-type InsertMessageWithIDParams struct {
-	SessionID  uuid.UUID `json:"session_id"`
-	SenderType string    `json:"sender_type"`
-	SenderID   uuid.UUID `json:"sender_id"`
-	Content    string    `json:"content"`
+type ReencryptRevisionParams struct {
+	Content         string        `json:"content"`
+	ContentKeyID    uuid.NullUUID `json:"content_key_id"`
+	ID              uuid.UUID     `json:"id"`
+	PreviousContent string        `json:"previous_content"`
 }
 
-func (q *Queries) InsertMessageWithID(ctx context.Context, arg InsertMessageWithIDParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, insertMessageWithID,
-		arg.SessionID,
-		arg.SenderType,
-		arg.SenderID,
+func (q *Queries) ReencryptRevision(ctx context.Context, arg ReencryptRevisionParams) error {
+	_, err := q.db.ExecContext(ctx, reencryptRevision,
 		arg.Content,
+		arg.ContentKeyID,
+		arg.ID,
+		arg.PreviousContent,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	return err
 }
 
-const listAdvisors = `-- name: ListAdvisors :many
-SELECT a.id, a.user_id, a.bio, a.experience_years, a.languages, a.specializations, a.is_verified, a.hourly_rate, a.status, a.created_at, a.updated_at, u.id, u.email, u.phone, u.password_hash, u.display_name, u.role, u.gender, u.dob, u.created_at, u.updated_at, u.is_active, u.fcm_token, u.apns_token, u.device_type, 0 as average_rating
-FROM advisors a
-JOIN users u ON a.user_id = u.id
-WHERE a.status = 'ONLINE'
-LIMIT $2 OFFSET $1
+const refreshSessionMetrics = `-- name: RefreshSessionMetrics :execrows
+INSERT INTO advisor_session_metrics (session_id, advisor_id, session_type, status, started_at, ended_at, duration_seconds,
+                                     first_response_seconds, accepted, repeat_client, missed_call, computed_at)
+SELECT s.id,
+       s.advisor_id::uuid,
+       s.type,
+       s.status,
+       s.started_at,
+       s.ended_at,
+       GREATEST(EXTRACT(EPOCH FROM (s.ended_at - s.started_at)), 0)::INTEGER,
+       CASE WHEN s.type = 'CHAT' AND adv.first_at IS NOT NULL
+            THEN GREATEST(EXTRACT(EPOCH FROM (adv.first_at - COALESCE(usr.first_at, s.started_at))), 0)::INTEGER
+       END,
+       CASE WHEN s.type = 'CALL' THEN calls.answered ELSE adv.first_at IS NOT NULL END,
+       EXISTS (
+           SELECT 1 FROM sessions prev
+           WHERE prev.user_id = s.user_id AND prev.advisor_id = s.advisor_id
+             AND prev.status = 'ENDED' AND prev.started_at < s.started_at
+       ),
+       s.type = 'CALL' AND NOT calls.answered,
+       NOW()
+FROM sessions s
+LEFT JOIN LATERAL (
+    SELECT MIN(m.created_at) AS first_at FROM chat_messages m WHERE m.session_id = s.id AND m.sender_type = 'USER'
+) usr ON TRUE
+LEFT JOIN LATERAL (
+    SELECT MIN(m.created_at) AS first_at FROM chat_messages m WHERE m.session_id = s.id AND m.sender_type = 'ADVISOR'
+) adv ON TRUE
+CROSS JOIN LATERAL (
+    SELECT EXISTS (SELECT 1 FROM call_logs cl WHERE cl.session_id = s.id AND COALESCE(cl.duration_seconds, 0) > 0) AS answered
+) calls
+WHERE s.advisor_id IS NOT NULL
+  AND s.type IN ('CHAT', 'CALL')
+  AND s.status IN ('ENDED', 'CANCELLED', 'DECLINED', 'EXPIRED')
+  AND NOT (s.status = 'CANCELLED' AND s.requested_at IS NOT NULL AND s.accepted_at IS NULL)
+  AND s.started_at IS NOT NULL
+  AND s.ended_at >= $1
+  AND NOT EXISTS (
+      SELECT 1 FROM advisor_queue_entries q
+      WHERE q.session_id = s.id AND q.status IN ('LEFT', 'EXPIRED')
+  )
+ON CONFLICT (session_id) DO UPDATE SET
+    status = EXCLUDED.status,
+    ended_at = EXCLUDED.ended_at,
+    duration_seconds = EXCLUDED.duration_seconds,
+    first_response_seconds = EXCLUDED.first_response_seconds,
+    accepted = EXCLUDED.accepted,
+    repeat_client = EXCLUDED.repeat_client,
+    missed_call = EXCLUDED.missed_call,
+    computed_at = EXCLUDED.computed_at
 `
 
-type ListAdvisorsParams struct {
-	Offset int32 `json:"offset"`
-	Limit  int32 `json:"limit"`
+// Recomputes metrics for advisor sessions that finished since the given time. Declined and expired
+// requests count as not accepted. Sessions cancelled because a queued user never accepted the offer,
+// or requests the user withdrew before the advisor answered, are not held against the advisor.
+func (q *Queries) RefreshSessionMetrics(ctx context.Context, since sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, refreshSessionMetrics, since)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-type ListAdvisorsRow struct {
-	ID              uuid.UUID      `json:"id"`
-	UserID          uuid.UUID      `json:"user_id"`
-	Bio             sql.NullString `json:"bio"`
-	ExperienceYears sql.NullInt32  `json:"experience_years"`
-	Languages       []string       `json:"languages"`
-	Specializations []string       `json:"specializations"`
-	IsVerified      sql.NullBool   `json:"is_verified"`
-	HourlyRate      sql.NullString `json:"hourly_rate"`
-	Status          sql.NullString `json:"status"`
-	CreatedAt       sql.NullTime   `json:"created_at"`
-	UpdatedAt       sql.NullTime   `json:"updated_at"`
-	ID_2            uuid.UUID      `json:"id_2"`
-	Email           sql.NullString `json:"email"`
-	Phone           sql.NullString `json:"phone"`
-	PasswordHash    string         `json:"password_hash"`
-	DisplayName     string         `json:"display_name"`
-	Role            string         `json:"role"`
-	Gender          sql.NullString `json:"gender"`
-	Dob             sql.NullTime   `json:"dob"`
-	CreatedAt_2     sql.NullTime   `json:"created_at_2"`
-	UpdatedAt_2     sql.NullTime   `json:"updated_at_2"`
-	IsActive        sql.NullBool   `json:"is_active"`
-	FcmToken        sql.NullString `json:"fcm_token"`
-	ApnsToken       sql.NullString `json:"apns_token"`
-	DeviceType      sql.NullString `json:"device_type"`
-	AverageRating   int32          `json:"average_rating"`
+const removeModerationTerms = `-- name: RemoveModerationTerms :execrows
+DELETE FROM moderation_terms WHERE term = ANY($1::text[])
+`
+
+func (q *Queries) RemoveModerationTerms(ctx context.Context, terms []string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeModerationTerms, pq.Array(terms))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-func (q *Queries) ListAdvisors(ctx context.Context, arg ListAdvisorsParams) ([]ListAdvisorsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listAdvisors, arg.Offset, arg.Limit)
+const rewrapEncryptionKey = `-- name: RewrapEncryptionKey :exec
+UPDATE encryption_keys SET wrapped_key = $2, kek_id = $3 WHERE id = $1
+`
+
+type RewrapEncryptionKeyParams struct {
+	ID         uuid.UUID `json:"id"`
+	WrappedKey []byte    `json:"wrapped_key"`
+	KekID      string    `json:"kek_id"`
+}
+
+func (q *Queries) RewrapEncryptionKey(ctx context.Context, arg RewrapEncryptionKeyParams) error {
+	_, err := q.db.ExecContext(ctx, rewrapEncryptionKey, arg.ID, arg.WrappedKey, arg.KekID)
+	return err
+}
+
+const searchFAQs = `-- name: SearchFAQs :many
+SELECT id, question, answer, category, is_active
+FROM faqs
+WHERE (question ILIKE '%' || $1 || '%' OR answer ILIKE '%' || $1 || '%')
+  AND is_active = true
+ORDER BY category, question
+`
+
+type SearchFAQsRow struct {
+	ID       uuid.UUID    `json:"id"`
+	Question string       `json:"question"`
+	Answer   string       `json:"answer"`
+	Category string       `json:"category"`
+	IsActive sql.NullBool `json:"is_active"`
+}
+
+func (q *Queries) SearchFAQs(ctx context.Context, dollar_1 sql.NullString) ([]SearchFAQsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchFAQs, dollar_1)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListAdvisorsRow
+	var items []SearchFAQsRow
 	for rows.Next() {
-		var i ListAdvisorsRow
+		var i SearchFAQsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.UserID,
-			&i.Bio,
-			&i.ExperienceYears,
-			pq.Array(&i.Languages),
-			pq.Array(&i.Specializations),
-			&i.IsVerified,
-			&i.HourlyRate,
-			&i.Status,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.ID_2,
-			&i.Email,
-			&i.Phone,
-			&i.PasswordHash,
-			&i.DisplayName,
-			&i.Role,
-			&i.Gender,
-			&i.Dob,
-			&i.CreatedAt_2,
-			&i.UpdatedAt_2,
+			&i.Question,
+			&i.Answer,
+			&i.Category,
 			&i.IsActive,
-			&i.FcmToken,
-			&i.ApnsToken,
-			&i.DeviceType,
-			&i.AverageRating,
 		); err != nil {
 			return nil, err
 		}
@@ -1822,40 +5667,237 @@ func (q *Queries) ListAdvisors(ctx context.Context, arg ListAdvisorsParams) ([]L
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	return items, nil
+	return items, nil
+}
+
+const searchMessages = `-- name: SearchMessages :many
+SELECT m.id, m.session_id, m.sender_type, m.sender_id, m.content, m.created_at, m.is_read, m.read_at, m.edited_at, m.deleted_at, m.kind, m.client_message_id, m.content_key_id, m.search_terms, s.advisor_id AS session_advisor_id
+FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE (s.user_id = $1 OR s.advisor_id = $1)
+  AND m.deleted_at IS NULL
+  AND m.search_terms @> $2::text[]
+  AND ($3::uuid IS NULL OR m.session_id = $3::uuid)
+  AND ($4::uuid IS NULL OR s.advisor_id = $4::uuid)
+  AND ($5::timestamptz IS NULL OR m.created_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR m.created_at < $6::timestamptz)
+  AND ($7::uuid IS NULL OR (m.created_at, m.id) < ($8::timestamptz, $7::uuid))
+ORDER BY m.created_at DESC, m.id DESC
+LIMIT $9
+`
+
+type SearchMessagesParams struct {
+	MemberID   uuid.UUID     `json:"member_id"`
+	Terms      []string      `json:"terms"`
+	SessionID  uuid.NullUUID `json:"session_id"`
+	AdvisorID  uuid.NullUUID `json:"advisor_id"`
+	SentFrom   sql.NullTime  `json:"sent_from"`
+	SentTo     sql.NullTime  `json:"sent_to"`
+	BeforeID   uuid.NullUUID `json:"before_id"`
+	BeforeAt   sql.NullTime  `json:"before_at"`
+	MaxResults int32         `json:"max_results"`
+}
+
+type SearchMessagesRow struct {
+	ChatMessage      ChatMessage   `json:"chat_message"`
+	SessionAdvisorID uuid.NullUUID `json:"session_advisor_id"`
+}
+
+// Messages of the member's sessions containing every search term, newest first
+func (q *Queries) SearchMessages(ctx context.Context, arg SearchMessagesParams) ([]SearchMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchMessages,
+		arg.MemberID,
+		pq.Array(arg.Terms),
+		arg.SessionID,
+		arg.AdvisorID,
+		arg.SentFrom,
+		arg.SentTo,
+		arg.BeforeID,
+		arg.BeforeAt,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchMessagesRow
+	for rows.Next() {
+		var i SearchMessagesRow
+		if err := rows.Scan(
+			&i.ChatMessage.ID,
+			&i.ChatMessage.SessionID,
+			&i.ChatMessage.SenderType,
+			&i.ChatMessage.SenderID,
+			&i.ChatMessage.Content,
+			&i.ChatMessage.CreatedAt,
+			&i.ChatMessage.IsRead,
+			&i.ChatMessage.ReadAt,
+			&i.ChatMessage.EditedAt,
+			&i.ChatMessage.DeletedAt,
+			&i.ChatMessage.Kind,
+			&i.ChatMessage.ClientMessageID,
+			&i.ChatMessage.ContentKeyID,
+			pq.Array(&i.ChatMessage.SearchTerms),
+			&i.SessionAdvisorID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setAdvisorAutoBusy = `-- name: SetAdvisorAutoBusy :exec
+UPDATE advisors
+SET status = CASE WHEN $1::boolean THEN 'BUSY' ELSE 'ONLINE' END,
+    auto_busy = $1::boolean,
+    updated_at = NOW()
+WHERE id = $2
+`
+
+type SetAdvisorAutoBusyParams struct {
+	Busy bool      `json:"busy"`
+	ID   uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetAdvisorAutoBusy(ctx context.Context, arg SetAdvisorAutoBusyParams) error {
+	_, err := q.db.ExecContext(ctx, setAdvisorAutoBusy, arg.Busy, arg.ID)
+	return err
+}
+
+const setAdvisorChatCapacity = `-- name: SetAdvisorChatCapacity :one
+UPDATE advisors SET max_concurrent_chats = $2, updated_at = NOW() WHERE user_id = $1 RETURNING id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at, tier, max_concurrent_chats, auto_busy
+`
+
+type SetAdvisorChatCapacityParams struct {
+	UserID             uuid.UUID `json:"user_id"`
+	MaxConcurrentChats int32     `json:"max_concurrent_chats"`
+}
+
+func (q *Queries) SetAdvisorChatCapacity(ctx context.Context, arg SetAdvisorChatCapacityParams) (Advisor, error) {
+	row := q.db.QueryRowContext(ctx, setAdvisorChatCapacity, arg.UserID, arg.MaxConcurrentChats)
+	var i Advisor
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Bio,
+		&i.ExperienceYears,
+		pq.Array(&i.Languages),
+		pq.Array(&i.Specializations),
+		&i.IsVerified,
+		&i.HourlyRate,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
+		&i.MaxConcurrentChats,
+		&i.AutoBusy,
+	)
+	return i, err
+}
+
+const setAdvisorTier = `-- name: SetAdvisorTier :exec
+UPDATE advisors SET tier = $2, updated_at = NOW() WHERE id = $1
+`
+
+type SetAdvisorTierParams struct {
+	ID   uuid.UUID `json:"id"`
+	Tier string    `json:"tier"`
+}
+
+func (q *Queries) SetAdvisorTier(ctx context.Context, arg SetAdvisorTierParams) error {
+	_, err := q.db.ExecContext(ctx, setAdvisorTier, arg.ID, arg.Tier)
+	return err
+}
+
+const startSessionBilling = `-- name: StartSessionBilling :execrows
+UPDATE sessions SET billing_started_at = NOW()
+WHERE id = $1
+  AND status = 'ONGOING'
+  AND type = 'CHAT'
+  AND billing_started_at IS NULL
+  AND price_per_minute > 0
+  AND COALESCE(discount_percent, 0) < 100
+`
+
+// Paid chats start billing with the advisor's first reply
+func (q *Queries) StartSessionBilling(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, startSessionBilling, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const searchFAQs = `-- name: SearchFAQs :many
-SELECT id, question, answer, category, is_active
-FROM faqs
-WHERE (question ILIKE '%' || $1 || '%' OR answer ILIKE '%' || $1 || '%')
-  AND is_active = true
-ORDER BY category, question
+const submitFeedback = `-- name: SubmitFeedback :exec
+UPDATE call_feedback_prompts
+SET response_received_at = NOW(), rating = $1, feedback_text = $2
+WHERE id = $3
 `
 
-type SearchFAQsRow struct {
-	ID       uuid.UUID    `json:"id"`
-	Question string       `json:"question"`
-	Answer   string       `json:"answer"`
-	Category string       `json:"category"`
-	IsActive sql.NullBool `json:"is_active"`
+type SubmitFeedbackParams struct {
+	Rating       sql.NullInt32  `json:"rating"`
+	FeedbackText sql.NullString `json:"feedback_text"`
+	ID           uuid.UUID      `json:"id"`
 }
 
-func (q *Queries) SearchFAQs(ctx context.Context, dollar_1 sql.NullString) ([]SearchFAQsRow, error) {
-	rows, err := q.db.QueryContext(ctx, searchFAQs, dollar_1)
+func (q *Queries) SubmitFeedback(ctx context.Context, arg SubmitFeedbackParams) error {
+	_, err := q.db.ExecContext(ctx, submitFeedback, arg.Rating, arg.FeedbackText, arg.ID)
+	return err
+}
+
+const syncMessages = `-- name: SyncMessages :many
+SELECT m.id, m.session_id, m.sender_type, m.sender_id, m.content, m.created_at, m.is_read, m.read_at, m.edited_at, m.deleted_at, m.kind, m.client_message_id, m.content_key_id, m.search_terms FROM chat_messages m
+JOIN sessions s ON s.id = m.session_id
+WHERE (s.user_id = $1 OR s.advisor_id = $1)
+  AND ($2::uuid IS NULL OR (m.created_at, m.id) > ($3::timestamptz, $2::uuid))
+ORDER BY m.created_at, m.id
+LIMIT $4
+`
+
+type SyncMessagesParams struct {
+	MemberID    uuid.UUID     `json:"member_id"`
+	AfterID     uuid.NullUUID `json:"after_id"`
+	AfterAt     sql.NullTime  `json:"after_at"`
+	MaxMessages int32         `json:"max_messages"`
+}
+
+// Messages in all of the member's sessions after the cursor, oldest first; everything without one
+func (q *Queries) SyncMessages(ctx context.Context, arg SyncMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.QueryContext(ctx, syncMessages,
+		arg.MemberID,
+		arg.AfterID,
+		arg.AfterAt,
+		arg.MaxMessages,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SearchFAQsRow
+	var items []ChatMessage
 	for rows.Next() {
-		var i SearchFAQsRow
+		var i ChatMessage
 		if err := rows.Scan(
 			&i.ID,
-			&i.Question,
-			&i.Answer,
-			&i.Category,
-			&i.IsActive,
+			&i.SessionID,
+			&i.SenderType,
+			&i.SenderID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.IsRead,
+			&i.ReadAt,
+			&i.EditedAt,
+			&i.DeletedAt,
+			&i.Kind,
+			&i.ClientMessageID,
+			&i.ContentKeyID,
+			pq.Array(&i.SearchTerms),
 		); err != nil {
 			return nil, err
 		}
@@ -1870,21 +5912,52 @@ func (q *Queries) SearchFAQs(ctx context.Context, dollar_1 sql.NullString) ([]Se
 	return items, nil
 }
 
-const submitFeedback = `-- name: SubmitFeedback :exec
-UPDATE call_feedback_prompts
-SET response_received_at = NOW(), rating = $1, feedback_text = $2
-WHERE id = $3
+const transitionAdvisorApplication = `-- name: TransitionAdvisorApplication :one
+UPDATE advisor_applications
+SET status = $1,
+    reviewer_id = COALESCE($2, reviewer_id),
+    reviewer_notes = COALESCE($3, reviewer_notes),
+    rejection_reason = CASE WHEN $1 IN ('NEEDS_CHANGES', 'REJECTED') THEN $4 ELSE rejection_reason END,
+    submitted_at = CASE WHEN $1 = 'SUBMITTED' THEN NOW() ELSE submitted_at END,
+    reviewed_at = CASE WHEN $1 IN ('NEEDS_CHANGES', 'APPROVED', 'REJECTED') THEN NOW() ELSE reviewed_at END,
+    updated_at = NOW()
+WHERE id = $5 AND status = $6
+RETURNING id, advisor_id, user_id, status, reviewer_id, reviewer_notes, rejection_reason, submitted_at, reviewed_at, created_at, updated_at
 `
 
-type SubmitFeedbackParams struct {
-	Rating       sql.NullInt32  `json:"rating"`
-	FeedbackText sql.NullString `json:"feedback_text"`
-	ID           uuid.UUID      `json:"id"`
+type TransitionAdvisorApplicationParams struct {
+	ToStatus        string         `json:"to_status"`
+	ReviewerID      uuid.NullUUID  `json:"reviewer_id"`
+	ReviewerNotes   sql.NullString `json:"reviewer_notes"`
+	RejectionReason sql.NullString `json:"rejection_reason"`
+	ID              uuid.UUID      `json:"id"`
+	FromStatus      string         `json:"from_status"`
 }
 
-func (q *Queries) SubmitFeedback(ctx context.Context, arg SubmitFeedbackParams) error {
-	_, err := q.db.ExecContext(ctx, submitFeedback, arg.Rating, arg.FeedbackText, arg.ID)
-	return err
+func (q *Queries) TransitionAdvisorApplication(ctx context.Context, arg TransitionAdvisorApplicationParams) (AdvisorApplication, error) {
+	row := q.db.QueryRowContext(ctx, transitionAdvisorApplication,
+		arg.ToStatus,
+		arg.ReviewerID,
+		arg.ReviewerNotes,
+		arg.RejectionReason,
+		arg.ID,
+		arg.FromStatus,
+	)
+	var i AdvisorApplication
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.Status,
+		&i.ReviewerID,
+		&i.ReviewerNotes,
+		&i.RejectionReason,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
 const updateAdminFlagStatus = `-- name: UpdateAdminFlagStatus :exec
@@ -1902,9 +5975,9 @@ func (q *Queries) UpdateAdminFlagStatus(ctx context.Context, arg UpdateAdminFlag
 }
 
 const updateAdvisor = `-- name: UpdateAdvisor :one
-UPDATE advisors SET bio = $2, experience_years = $3, languages = $4, specializations = $5, hourly_rate = $6, status = $7, updated_at = NOW()
+UPDATE advisors SET bio = $2, experience_years = $3, languages = $4, specializations = $5, hourly_rate = $6, status = $7, auto_busy = FALSE, updated_at = NOW()
 WHERE id = $1
-RETURNING id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at
+RETURNING id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at, tier, max_concurrent_chats, auto_busy
 `
 
 type UpdateAdvisorParams struct {
@@ -1940,12 +6013,61 @@ func (q *Queries) UpdateAdvisor(ctx context.Context, arg UpdateAdvisorParams) (A
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Tier,
+		&i.MaxConcurrentChats,
+		&i.AutoBusy,
+	)
+	return i, err
+}
+
+const updateAdvisorApplicationProfile = `-- name: UpdateAdvisorApplicationProfile :one
+
+UPDATE advisors SET bio = $2, experience_years = $3, languages = $4, specializations = $5, hourly_rate = $6, updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, bio, experience_years, languages, specializations, is_verified, hourly_rate, status, created_at, updated_at, tier, max_concurrent_chats, auto_busy
+`
+
+type UpdateAdvisorApplicationProfileParams struct {
+	ID              uuid.UUID      `json:"id"`
+	Bio             sql.NullString `json:"bio"`
+	ExperienceYears sql.NullInt32  `json:"experience_years"`
+	Languages       []string       `json:"languages"`
+	Specializations []string       `json:"specializations"`
+	HourlyRate      sql.NullString `json:"hourly_rate"`
+}
+
+// Advisor Application Workflow
+func (q *Queries) UpdateAdvisorApplicationProfile(ctx context.Context, arg UpdateAdvisorApplicationProfileParams) (Advisor, error) {
+	row := q.db.QueryRowContext(ctx, updateAdvisorApplicationProfile,
+		arg.ID,
+		arg.Bio,
+		arg.ExperienceYears,
+		pq.Array(arg.Languages),
+		pq.Array(arg.Specializations),
+		arg.HourlyRate,
+	)
+	var i Advisor
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Bio,
+		&i.ExperienceYears,
+		pq.Array(&i.Languages),
+		pq.Array(&i.Specializations),
+		&i.IsVerified,
+		&i.HourlyRate,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
+		&i.MaxConcurrentChats,
+		&i.AutoBusy,
 	)
 	return i, err
 }
 
 const updateAdvisorStatus = `-- name: UpdateAdvisorStatus :exec
-UPDATE advisors SET status = $2, updated_at = NOW() WHERE id = $1
+UPDATE advisors SET status = $2, auto_busy = FALSE, updated_at = NOW() WHERE id = $1
 `
 
 type UpdateAdvisorStatusParams struct {
@@ -1958,6 +6080,44 @@ func (q *Queries) UpdateAdvisorStatus(ctx context.Context, arg UpdateAdvisorStat
 	return err
 }
 
+const updateAdvisorTemplate = `-- name: UpdateAdvisorTemplate :one
+UPDATE message_templates
+SET title = $3, body = $4, category = $5, updated_at = NOW()
+WHERE id = $1 AND advisor_id = $2
+RETURNING id, advisor_id, title, body, category, is_active, created_by, created_at, updated_at
+`
+
+type UpdateAdvisorTemplateParams struct {
+	ID        uuid.UUID      `json:"id"`
+	AdvisorID uuid.NullUUID  `json:"advisor_id"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Category  sql.NullString `json:"category"`
+}
+
+func (q *Queries) UpdateAdvisorTemplate(ctx context.Context, arg UpdateAdvisorTemplateParams) (MessageTemplate, error) {
+	row := q.db.QueryRowContext(ctx, updateAdvisorTemplate,
+		arg.ID,
+		arg.AdvisorID,
+		arg.Title,
+		arg.Body,
+		arg.Category,
+	)
+	var i MessageTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.Title,
+		&i.Body,
+		&i.Category,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateCallStatus = `-- name: UpdateCallStatus :exec
 
 UPDATE call_logs SET status_update = $1, status_timestamp = NOW() WHERE session_id = $2
@@ -1974,6 +6134,34 @@ func (q *Queries) UpdateCallStatus(ctx context.Context, arg UpdateCallStatusPara
 	return err
 }
 
+const updateClientNote = `-- name: UpdateClientNote :one
+UPDATE advisor_client_notes
+SET encrypted_content = $3, updated_at = NOW()
+WHERE id = $1 AND advisor_id = $2
+RETURNING id, advisor_id, user_id, session_id, encrypted_content, created_at, updated_at
+`
+
+type UpdateClientNoteParams struct {
+	ID               uuid.UUID `json:"id"`
+	AdvisorID        uuid.UUID `json:"advisor_id"`
+	EncryptedContent []byte    `json:"encrypted_content"`
+}
+
+func (q *Queries) UpdateClientNote(ctx context.Context, arg UpdateClientNoteParams) (AdvisorClientNote, error) {
+	row := q.db.QueryRowContext(ctx, updateClientNote, arg.ID, arg.AdvisorID, arg.EncryptedContent)
+	var i AdvisorClientNote
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.UserID,
+		&i.SessionID,
+		&i.EncryptedContent,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateFAQ = `-- name: UpdateFAQ :exec
 UPDATE faqs SET question = $1, answer = $2, category = $3, is_active = $4 WHERE id = $5
 `
@@ -1997,13 +6185,97 @@ func (q *Queries) UpdateFAQ(ctx context.Context, arg UpdateFAQParams) error {
 	return err
 }
 
-const updateMessageReadStatus = `-- name: UpdateMessageReadStatus :exec
-UPDATE chat_messages SET is_read = true, read_at = NOW() WHERE id = $1
+const updateMessageReadStatus = `-- name: UpdateMessageReadStatus :execrows
+UPDATE chat_messages SET is_read = TRUE, read_at = NOW()
+WHERE session_id = $1
+  AND sender_id <> $2
+  AND created_at <= $3
+  AND NOT COALESCE(is_read, FALSE)
 `
 
-func (q *Queries) UpdateMessageReadStatus(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, updateMessageReadStatus, id)
-	return err
+type UpdateMessageReadStatusParams struct {
+	SessionID uuid.UUID    `json:"session_id"`
+	ReaderID  uuid.UUID    `json:"reader_id"`
+	ReadUntil sql.NullTime `json:"read_until"`
+}
+
+// Marks what the other participant sent up to the reader's watermark as read
+func (q *Queries) UpdateMessageReadStatus(ctx context.Context, arg UpdateMessageReadStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateMessageReadStatus, arg.SessionID, arg.ReaderID, arg.ReadUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateModerationRule = `-- name: UpdateModerationRule :one
+UPDATE moderation_rules
+SET action = $2, notice = $3, updated_by = $4, updated_at = NOW()
+WHERE detector = $1
+RETURNING detector, action, notice, updated_by, updated_at
+`
+
+type UpdateModerationRuleParams struct {
+	Detector  string        `json:"detector"`
+	Action    string        `json:"action"`
+	Notice    string        `json:"notice"`
+	UpdatedBy uuid.NullUUID `json:"updated_by"`
+}
+
+func (q *Queries) UpdateModerationRule(ctx context.Context, arg UpdateModerationRuleParams) (ModerationRule, error) {
+	row := q.db.QueryRowContext(ctx, updateModerationRule,
+		arg.Detector,
+		arg.Action,
+		arg.Notice,
+		arg.UpdatedBy,
+	)
+	var i ModerationRule
+	err := row.Scan(
+		&i.Detector,
+		&i.Action,
+		&i.Notice,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updatePlatformTemplate = `-- name: UpdatePlatformTemplate :one
+UPDATE message_templates
+SET title = $2, body = $3, category = $4, is_active = $5, updated_at = NOW()
+WHERE id = $1 AND advisor_id IS NULL
+RETURNING id, advisor_id, title, body, category, is_active, created_by, created_at, updated_at
+`
+
+type UpdatePlatformTemplateParams struct {
+	ID       uuid.UUID      `json:"id"`
+	Title    string         `json:"title"`
+	Body     string         `json:"body"`
+	Category sql.NullString `json:"category"`
+	IsActive bool           `json:"is_active"`
+}
+
+func (q *Queries) UpdatePlatformTemplate(ctx context.Context, arg UpdatePlatformTemplateParams) (MessageTemplate, error) {
+	row := q.db.QueryRowContext(ctx, updatePlatformTemplate,
+		arg.ID,
+		arg.Title,
+		arg.Body,
+		arg.Category,
+		arg.IsActive,
+	)
+	var i MessageTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.Title,
+		&i.Body,
+		&i.Category,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
 const updateSessionStatus = `-- name: UpdateSessionStatus :exec
@@ -2180,3 +6452,139 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 	_, err := q.db.ExecContext(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
 	return err
 }
+
+const upsertAdvisorPricing = `-- name: UpsertAdvisorPricing :one
+INSERT INTO advisor_pricing (advisor_id, session_type, per_minute_rate, min_billable_minutes, first_session_discount_percent, currency)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (advisor_id, session_type) DO UPDATE SET
+    per_minute_rate = EXCLUDED.per_minute_rate,
+    min_billable_minutes = EXCLUDED.min_billable_minutes,
+    first_session_discount_percent = EXCLUDED.first_session_discount_percent,
+    currency = EXCLUDED.currency,
+    updated_at = NOW()
+RETURNING id, advisor_id, session_type, per_minute_rate, min_billable_minutes, first_session_discount_percent, currency, created_at, updated_at
+`
+
+type UpsertAdvisorPricingParams struct {
+	AdvisorID                   uuid.UUID `json:"advisor_id"`
+	SessionType                 string    `json:"session_type"`
+	PerMinuteRate               string    `json:"per_minute_rate"`
+	MinBillableMinutes          int32     `json:"min_billable_minutes"`
+	FirstSessionDiscountPercent string    `json:"first_session_discount_percent"`
+	Currency                    string    `json:"currency"`
+}
+
+func (q *Queries) UpsertAdvisorPricing(ctx context.Context, arg UpsertAdvisorPricingParams) (AdvisorPricing, error) {
+	row := q.db.QueryRowContext(ctx, upsertAdvisorPricing,
+		arg.AdvisorID,
+		arg.SessionType,
+		arg.PerMinuteRate,
+		arg.MinBillableMinutes,
+		arg.FirstSessionDiscountPercent,
+		arg.Currency,
+	)
+	var i AdvisorPricing
+	err := row.Scan(
+		&i.ID,
+		&i.AdvisorID,
+		&i.SessionType,
+		&i.PerMinuteRate,
+		&i.MinBillableMinutes,
+		&i.FirstSessionDiscountPercent,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertCommissionTier = `-- name: UpsertCommissionTier :one
+INSERT INTO commission_tiers (tier, commission_percent) VALUES ($1, $2)
+ON CONFLICT (tier) DO UPDATE SET commission_percent = EXCLUDED.commission_percent, updated_at = NOW()
+RETURNING tier, commission_percent, updated_at
+`
+
+type UpsertCommissionTierParams struct {
+	Tier              string `json:"tier"`
+	CommissionPercent string `json:"commission_percent"`
+}
+
+func (q *Queries) UpsertCommissionTier(ctx context.Context, arg UpsertCommissionTierParams) (CommissionTier, error) {
+	row := q.db.QueryRowContext(ctx, upsertCommissionTier, arg.Tier, arg.CommissionPercent)
+	var i CommissionTier
+	err := row.Scan(&i.Tier, &i.CommissionPercent, &i.UpdatedAt)
+	return i, err
+}
+
+const upsertReadReceipt = `-- name: UpsertReadReceipt :one
+INSERT INTO chat_read_receipts (session_id, user_id, last_read_message_id, last_read_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (session_id, user_id) DO UPDATE SET
+    last_read_message_id = EXCLUDED.last_read_message_id,
+    last_read_at = EXCLUDED.last_read_at,
+    updated_at = NOW()
+WHERE chat_read_receipts.last_read_at < EXCLUDED.last_read_at
+RETURNING session_id, user_id, last_read_message_id, last_read_at, updated_at
+`
+
+type UpsertReadReceiptParams struct {
+	SessionID         uuid.UUID     `json:"session_id"`
+	UserID            uuid.UUID     `json:"user_id"`
+	LastReadMessageID uuid.NullUUID `json:"last_read_message_id"`
+	LastReadAt        time.Time     `json:"last_read_at"`
+}
+
+// Only ever moves the watermark forward; no row is returned when it was already further along
+func (q *Queries) UpsertReadReceipt(ctx context.Context, arg UpsertReadReceiptParams) (ChatReadReceipt, error) {
+	row := q.db.QueryRowContext(ctx, upsertReadReceipt,
+		arg.SessionID,
+		arg.UserID,
+		arg.LastReadMessageID,
+		arg.LastReadAt,
+	)
+	var i ChatReadReceipt
+	err := row.Scan(
+		&i.SessionID,
+		&i.UserID,
+		&i.LastReadMessageID,
+		&i.LastReadAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const withdrawSessionRequest = `-- name: WithdrawSessionRequest :one
+UPDATE sessions SET status = 'CANCELLED', ended_at = NOW()
+WHERE id = $1 AND user_id = $2 AND status = 'REQUESTED'
+RETURNING id, user_id, advisor_id, type, started_at, ended_at, status, pricing_type, price_per_minute, min_billable_minutes, discount_percent, currency, requested_at, accepted_at, billing_started_at, billed_minutes, billed_amount
+`
+
+type WithdrawSessionRequestParams struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) WithdrawSessionRequest(ctx context.Context, arg WithdrawSessionRequestParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, withdrawSessionRequest, arg.ID, arg.UserID)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.AdvisorID,
+		&i.Type,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.Status,
+		&i.PricingType,
+		&i.PricePerMinute,
+		&i.MinBillableMinutes,
+		&i.DiscountPercent,
+		&i.Currency,
+		&i.RequestedAt,
+		&i.AcceptedAt,
+		&i.BillingStartedAt,
+		&i.BilledMinutes,
+		&i.BilledAmount,
+	)
+	return i, err
+}
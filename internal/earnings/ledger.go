@@ -0,0 +1,140 @@
+package earnings
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+// Periods accepted for earnings aggregation, matching date_trunc units
+var validPeriods = map[string]struct{}{
+	"day":   {},
+	"week":  {},
+	"month": {},
+}
+
+// Ledger records advisor earnings for ended sessions
+type Ledger struct {
+	repo *db.Queries
+}
+
+func NewLedger(repo *db.Queries) *Ledger {
+	return &Ledger{repo: repo}
+}
+
+// RecordSession adds the ledger entry for an ended chat or call session.
+// Sessions without an advisor and sessions that were already recorded are ignored,
+// so it is safe to call more than once.
+func (l *Ledger) RecordSession(ctx context.Context, sessionID uuid.UUID) {
+	if l == nil {
+		return
+	}
+	if err := l.repo.RecordSessionEarning(ctx, sessionID); err != nil {
+		log.Printf("Error recording earnings for session %s: %v", sessionID, err)
+	}
+}
+
+// ParsePeriod validates an aggregation period, defaulting to day
+func ParsePeriod(period string) (string, error) {
+	if period == "" {
+		return "day", nil
+	}
+	if _, ok := validPeriods[period]; !ok {
+		return "", fmt.Errorf("invalid period %q, expected day, week or month", period)
+	}
+	return period, nil
+}
+
+// ParseRange parses an optional RFC3339 range, defaulting to the last 30 days
+func ParseRange(from, to string) (time.Time, time.Time, error) {
+	end := time.Now()
+	if to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to time: %w", err)
+		}
+		end = t
+	}
+
+	start := end.AddDate(0, 0, -30)
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from time: %w", err)
+		}
+		start = t
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must be before to")
+	}
+
+	return start, end, nil
+}
+
+// ParseAmount converts a DECIMAL column to float64
+func ParseAmount(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
+
+func MapLineItem(e db.AdvisorEarning) *common.EarningLineItem {
+	item := &common.EarningLineItem{
+		Id:                e.ID.String(),
+		SessionId:         e.SessionID.String(),
+		SessionType:       common.SessionType(common.SessionType_value[e.SessionType]),
+		DurationSeconds:   e.DurationSeconds,
+		HourlyRate:        ParseAmount(e.HourlyRate),
+		GrossAmount:       ParseAmount(e.GrossAmount),
+		CommissionPercent: ParseAmount(e.CommissionPercent),
+		CommissionAmount:  ParseAmount(e.CommissionAmount),
+		NetAmount:         ParseAmount(e.NetAmount),
+		CreatedAt:         e.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if e.PayoutBatchID.Valid {
+		item.PayoutBatchId = e.PayoutBatchID.UUID.String()
+	}
+	return item
+}
+
+func MapPayoutBatch(b db.PayoutBatch) *common.PayoutBatch {
+	batch := &common.PayoutBatch{
+		Id:               b.ID.String(),
+		AdvisorId:        b.AdvisorID.String(),
+		Status:           b.Status,
+		PeriodEnd:        b.PeriodEnd.Format("2006-01-02T15:04:05Z"),
+		EarningsCount:    b.EarningsCount,
+		TotalAmount:      ParseAmount(b.TotalAmount),
+		PaymentReference: b.PaymentReference.String,
+		CreatedAt:        b.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if b.PeriodStart.Valid {
+		batch.PeriodStart = b.PeriodStart.Time.Format("2006-01-02T15:04:05Z")
+	}
+	if b.PaidAt.Valid {
+		batch.PaidAt = b.PaidAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	return batch
+}
+
+func MapBalanceTransaction(t db.BalanceTransaction) *common.BalanceTransaction {
+	tx := &common.BalanceTransaction{
+		Id:           t.ID.String(),
+		Kind:         t.Kind,
+		Amount:       ParseAmount(t.Amount),
+		BalanceAfter: ParseAmount(t.BalanceAfter),
+		Note:         t.Note.String,
+		CreatedAt:    t.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if t.SessionID.Valid {
+		tx.SessionId = t.SessionID.UUID.String()
+	}
+	return tx
+}
@@ -0,0 +1,98 @@
+-- name: RecordSessionEarning :exec
+-- Sessions with a price snapshot are billed per started minute (at least the minimum billable
+-- minutes, less any first-session discount); older sessions fall back to the hourly rate.
+-- Paid chats are metered while they run, so they earn what the user was charged.
+INSERT INTO advisor_earnings (session_id, advisor_id, session_type, duration_seconds, hourly_rate, per_minute_rate, billable_minutes,
+                              discount_percent, currency, gross_amount, commission_percent, commission_amount, net_amount)
+SELECT e.session_id, e.advisor_id, e.session_type, e.duration_seconds, e.hourly_rate, e.per_minute_rate, e.billable_minutes,
+       e.discount_percent, e.currency, e.gross_amount,
+       e.commission_percent, ROUND(e.gross_amount * e.commission_percent / 100, 2),
+       e.gross_amount - ROUND(e.gross_amount * e.commission_percent / 100, 2)
+FROM (
+    SELECT d.session_id, d.advisor_id, d.session_type, d.duration_seconds, d.hourly_rate, d.per_minute_rate, d.billable_minutes,
+           d.discount_percent, d.currency, d.commission_percent,
+           CASE WHEN d.metered THEN d.billed_amount
+                WHEN d.per_minute_rate IS NULL
+                THEN ROUND(d.hourly_rate * d.duration_seconds / 3600, 2)
+                ELSE ROUND(d.per_minute_rate * d.billable_minutes * (100 - d.discount_percent) / 100, 2)
+           END AS gross_amount
+    FROM (
+        SELECT s.id AS session_id,
+               a.id AS advisor_id,
+               s.type AS session_type,
+               GREATEST(EXTRACT(EPOCH FROM (s.ended_at - s.started_at)), 0)::INTEGER AS duration_seconds,
+               COALESCE(a.hourly_rate, 0) AS hourly_rate,
+               s.price_per_minute AS per_minute_rate,
+               s.type = 'CHAT' AND s.price_per_minute IS NOT NULL AS metered,
+               s.billed_amount,
+               CASE WHEN s.type = 'CHAT' AND s.price_per_minute IS NOT NULL
+                    THEN s.billed_minutes
+                    ELSE GREATEST(CEIL(GREATEST(EXTRACT(EPOCH FROM (s.ended_at - s.started_at)), 0) / 60), COALESCE(s.min_billable_minutes, 0))::INTEGER
+               END AS billable_minutes,
+               COALESCE(s.discount_percent, 0) AS discount_percent,
+               s.currency,
+               COALESCE(ct.commission_percent, 0) AS commission_percent
+        FROM sessions s
+        JOIN advisors a ON a.user_id = s.advisor_id
+        LEFT JOIN commission_tiers ct ON ct.tier = a.tier
+        WHERE s.id = $1 AND s.status = 'ENDED' AND s.type IN ('CHAT', 'CALL') AND s.ended_at IS NOT NULL
+    ) d
+) e
+ON CONFLICT (session_id) DO NOTHING;
+
+-- name: GetEarningsByPeriod :many
+SELECT date_trunc(sqlc.arg(period)::text, created_at)::timestamptz AS period_start,
+       COUNT(*) AS session_count,
+       SUM(duration_seconds)::bigint AS total_seconds,
+       SUM(gross_amount)::text AS gross_amount,
+       SUM(commission_amount)::text AS commission_amount,
+       SUM(net_amount)::text AS net_amount
+FROM advisor_earnings
+WHERE advisor_id = sqlc.arg(advisor_id) AND created_at >= sqlc.arg(from_time) AND created_at < sqlc.arg(to_time)
+GROUP BY 1
+ORDER BY 1 DESC;
+
+-- name: ListEarnings :many
+SELECT * FROM advisor_earnings
+WHERE advisor_id = sqlc.arg(advisor_id) AND created_at >= sqlc.arg(from_time) AND created_at < sqlc.arg(to_time)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: GetUnpaidEarningsTotal :one
+SELECT COALESCE(SUM(net_amount), 0)::text FROM advisor_earnings WHERE advisor_id = $1 AND payout_batch_id IS NULL;
+
+-- name: CreatePayoutBatch :one
+WITH assigned AS (
+    UPDATE advisor_earnings
+    SET payout_batch_id = sqlc.arg(id)
+    WHERE advisor_earnings.advisor_id = sqlc.arg(advisor_id) AND payout_batch_id IS NULL AND created_at < sqlc.arg(period_end)
+    RETURNING net_amount, created_at
+)
+INSERT INTO payout_batches (id, advisor_id, period_start, period_end, earnings_count, total_amount, created_by)
+SELECT sqlc.arg(id), sqlc.arg(advisor_id), MIN(assigned.created_at), sqlc.arg(period_end), COUNT(*), COALESCE(SUM(assigned.net_amount), 0), sqlc.arg(created_by)
+FROM assigned
+HAVING COUNT(*) > 0
+RETURNING *;
+
+-- name: MarkPayoutBatchPaid :one
+UPDATE payout_batches SET status = 'PAID', paid_at = NOW(), payment_reference = $2
+WHERE id = $1 AND status = 'PENDING'
+RETURNING *;
+
+-- name: ListPayoutBatches :many
+SELECT * FROM payout_batches
+WHERE (sqlc.narg(advisor_id)::uuid IS NULL OR advisor_id = sqlc.narg(advisor_id))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at DESC
+LIMIT sqlc.arg(row_limit) OFFSET sqlc.arg(row_offset);
+
+-- name: ListCommissionTiers :many
+SELECT * FROM commission_tiers ORDER BY tier;
+
+-- name: UpsertCommissionTier :one
+INSERT INTO commission_tiers (tier, commission_percent) VALUES ($1, $2)
+ON CONFLICT (tier) DO UPDATE SET commission_percent = EXCLUDED.commission_percent, updated_at = NOW()
+RETURNING *;
+
+-- name: SetAdvisorTier :exec
+UPDATE advisors SET tier = $2, updated_at = NOW() WHERE id = $1;
@@ -0,0 +1,84 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	ErrKeyNotConfigured = errors.New("encryption key not configured")
+	ErrMalformedCipher  = errors.New("ciphertext too short")
+	ErrInvalidKeyLength = errors.New("encryption key must be 32 bytes (64 hex characters)")
+)
+
+// Cipher seals data with AES-256-GCM. Sealed output is the random nonce
+// followed by the ciphertext, so a single column can hold it.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a cipher from a raw 32 byte key.
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != 32 {
+		return nil, ErrInvalidKeyLength
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// NewCipherFromHex creates a cipher from a hex encoded key as found in config.
+func NewCipherFromHex(hexKey string) (*Cipher, error) {
+	key, err := decodeKey(hexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCipher(key)
+}
+
+func decodeKey(hexKey string) ([]byte, error) {
+	if hexKey == "" {
+		return nil, ErrKeyNotConfigured
+	}
+
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal encrypts plaintext and returns nonce||ciphertext.
+func (c *Cipher) Seal(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Open decrypts data produced by Seal.
+func (c *Cipher) Open(sealed []byte) ([]byte, error) {
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, ErrMalformedCipher
+	}
+
+	return c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+}
@@ -0,0 +1,369 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"loveguru/internal/config"
+	"loveguru/internal/db"
+
+	"github.com/google/uuid"
+)
+
+// Purposes of the keys in encryption_keys
+const (
+	PurposeContent = "CONTENT" // encrypts chat messages, their revisions and AI conversations
+	PurposeSearch  = "SEARCH"  // keys the hashes of the words messages are searched by
+)
+
+var ErrUnknownKey = errors.New("content was encrypted with a key that no longer exists")
+
+// Keyring encrypts chat and AI content with data keys stored in the database, each of
+// them encrypted with the key encryption key (KEK) from config. Content records the key
+// it was encrypted with, so the active key can be rotated while older content stays
+// readable until it has been re-encrypted.
+//
+// Without a KEK content is stored as plaintext. Every method works on a nil Keyring the
+// same way.
+type Keyring struct {
+	repo        *db.Queries
+	kek         *Cipher
+	kekID       string
+	previous    map[string]*Cipher // KEKs being replaced, by ID
+	rotateEvery time.Duration
+
+	mu     sync.RWMutex
+	keys   map[uuid.UUID]*Cipher
+	active uuid.NullUUID
+	search []byte
+}
+
+// NewKeyring loads the data keys, creating them on first use, and re-encrypts the ones
+// encrypted with a previous KEK
+func NewKeyring(ctx context.Context, repo *db.Queries, cfg *config.EncryptionConfig) (*Keyring, error) {
+	k := &Keyring{
+		repo:        repo,
+		previous:    make(map[string]*Cipher),
+		rotateEvery: time.Duration(cfg.RotationDays) * 24 * time.Hour,
+		keys:        make(map[uuid.UUID]*Cipher),
+	}
+
+	if cfg.KEK == "" {
+		existing, err := repo.ListEncryptionKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			return nil, errors.New("chat content is encrypted but encryption.kek is not configured")
+		}
+		return k, nil
+	}
+
+	var err error
+	if k.kek, k.kekID, err = loadKEK(cfg.KEK); err != nil {
+		return nil, fmt.Errorf("encryption.kek: %w", err)
+	}
+	for _, hexKey := range cfg.PreviousKEKs {
+		kek, id, err := loadKEK(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("encryption.previous_keks: %w", err)
+		}
+		k.previous[id] = kek
+	}
+
+	if err := k.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// loadKEK returns the cipher of a KEK and its ID, a fingerprint that identifies the KEK
+// without revealing it
+func loadKEK(hexKey string) (*Cipher, string, error) {
+	key, err := decodeKey(hexKey)
+	if err != nil {
+		return nil, "", err
+	}
+	c, err := NewCipher(key)
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(key)
+	return c, hex.EncodeToString(sum[:8]), nil
+}
+
+// Enabled reports whether content is encrypted
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.kek != nil
+}
+
+// KEKID is the fingerprint of the configured KEK, empty when encryption is off
+func (k *Keyring) KEKID() string {
+	if !k.Enabled() {
+		return ""
+	}
+	return k.kekID
+}
+
+// ActiveKeyID is the key new content is encrypted with, if any
+func (k *Keyring) ActiveKeyID() uuid.NullUUID {
+	if !k.Enabled() {
+		return uuid.NullUUID{}
+	}
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.active
+}
+
+// Reload reads the data keys again, picking up keys other instances added. The newest
+// CONTENT key is the active one; the oldest SEARCH key is used so every instance hashes
+// words alike.
+func (k *Keyring) Reload(ctx context.Context) error {
+	if !k.Enabled() {
+		return nil
+	}
+
+	rows, err := k.repo.ListEncryptionKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !hasPurpose(rows, PurposeSearch) {
+		if _, err := k.createKey(ctx, PurposeSearch); err != nil {
+			return err
+		}
+	}
+	if !hasPurpose(rows, PurposeContent) {
+		if _, err := k.createKey(ctx, PurposeContent); err != nil {
+			return err
+		}
+	}
+	if !hasPurpose(rows, PurposeSearch) || !hasPurpose(rows, PurposeContent) {
+		// Read back what was created, along with whatever other instances created meanwhile
+		if rows, err = k.repo.ListEncryptionKeys(ctx); err != nil {
+			return err
+		}
+	}
+
+	keys := make(map[uuid.UUID]*Cipher, len(rows))
+	var active uuid.NullUUID
+	var search []byte
+	for _, row := range rows {
+		key, err := k.unwrap(ctx, row)
+		if err != nil {
+			return fmt.Errorf("encryption key %s: %w", row.ID, err)
+		}
+
+		switch row.Purpose {
+		case PurposeContent:
+			c, err := NewCipher(key)
+			if err != nil {
+				return fmt.Errorf("encryption key %s: %w", row.ID, err)
+			}
+			keys[row.ID] = c
+			active = uuid.NullUUID{UUID: row.ID, Valid: true}
+		case PurposeSearch:
+			if search == nil {
+				search = key
+			}
+		}
+	}
+
+	k.mu.Lock()
+	k.keys, k.active, k.search = keys, active, search
+	k.mu.Unlock()
+	return nil
+}
+
+// Rotate adds a CONTENT key and makes it the active one. Content encrypted with the
+// previous key is re-encrypted in the background by Run.
+func (k *Keyring) Rotate(ctx context.Context) (db.EncryptionKey, error) {
+	if !k.Enabled() {
+		return db.EncryptionKey{}, ErrKeyNotConfigured
+	}
+	key, err := k.createKey(ctx, PurposeContent)
+	if err != nil {
+		return db.EncryptionKey{}, err
+	}
+	return key, k.Reload(ctx)
+}
+
+// rotateDue adds a CONTENT key when the active one is older than the rotation period,
+// unless another instance just did
+func (k *Keyring) rotateDue(ctx context.Context) error {
+	if !k.Enabled() || k.rotateEvery <= 0 {
+		return nil
+	}
+
+	wrapped, err := k.newWrappedKey()
+	if err != nil {
+		return err
+	}
+	key, err := k.repo.AddContentKey(ctx, db.AddContentKeyParams{
+		WrappedKey:   wrapped,
+		KekID:        k.kekID,
+		CreatedAfter: time.Now().Add(-k.rotateEvery),
+	})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	log.Printf("Rotated the content encryption key, new key %s", key.ID)
+	return k.Reload(ctx)
+}
+
+// Seal encrypts content with the active key and returns it base64 encoded, with the ID
+// of the key. Empty content, and all content when encryption is off, is returned as is
+// without a key.
+func (k *Keyring) Seal(plaintext string) (string, uuid.NullUUID, error) {
+	sealed, keyID, err := k.SealAll(plaintext)
+	if err != nil {
+		return "", uuid.NullUUID{}, err
+	}
+	return sealed[0], keyID, nil
+}
+
+// SealAll encrypts the columns of one row with the same key, like Seal
+func (k *Keyring) SealAll(plaintexts ...string) ([]string, uuid.NullUUID, error) {
+	if !k.Enabled() {
+		return plaintexts, uuid.NullUUID{}, nil
+	}
+
+	k.mu.RLock()
+	id := k.active
+	c := k.keys[id.UUID]
+	k.mu.RUnlock()
+	if c == nil {
+		return nil, uuid.NullUUID{}, ErrKeyNotConfigured
+	}
+
+	sealed := make([]string, len(plaintexts))
+	var used uuid.NullUUID
+	for i, plaintext := range plaintexts {
+		if plaintext == "" {
+			continue
+		}
+		out, err := c.Seal([]byte(plaintext))
+		if err != nil {
+			return nil, uuid.NullUUID{}, err
+		}
+		sealed[i] = base64.StdEncoding.EncodeToString(out)
+		used = id
+	}
+	return sealed, used, nil
+}
+
+// Open decrypts content sealed with the key. Content without a key is plaintext.
+func (k *Keyring) Open(ctx context.Context, content string, keyID uuid.NullUUID) (string, error) {
+	if !keyID.Valid || content == "" {
+		return content, nil
+	}
+	if !k.Enabled() {
+		return "", ErrKeyNotConfigured
+	}
+
+	c, err := k.key(ctx, keyID.UUID)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := c.Open(sealed)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// key returns a CONTENT key, reloading once for keys another instance added
+func (k *Keyring) key(ctx context.Context, id uuid.UUID) (*Cipher, error) {
+	k.mu.RLock()
+	c := k.keys[id]
+	k.mu.RUnlock()
+	if c != nil {
+		return c, nil
+	}
+
+	if err := k.Reload(ctx); err != nil {
+		return nil, err
+	}
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if c = k.keys[id]; c == nil {
+		return nil, ErrUnknownKey
+	}
+	return c, nil
+}
+
+// unwrap decrypts a data key. Keys encrypted with a previous KEK are encrypted with the
+// current one and saved, so the previous KEK can be removed from config afterwards.
+func (k *Keyring) unwrap(ctx context.Context, row db.EncryptionKey) ([]byte, error) {
+	if row.KekID == k.kekID {
+		return k.kek.Open(row.WrappedKey)
+	}
+
+	previous, ok := k.previous[row.KekID]
+	if !ok {
+		return nil, fmt.Errorf("encrypted with KEK %s, which is neither encryption.kek nor in encryption.previous_keks", row.KekID)
+	}
+	key, err := previous.Open(row.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := k.kek.Seal(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.repo.RewrapEncryptionKey(ctx, db.RewrapEncryptionKeyParams{
+		ID:         row.ID,
+		WrappedKey: wrapped,
+		KekID:      k.kekID,
+	}); err != nil {
+		return nil, err
+	}
+	log.Printf("Re-encrypted encryption key %s with KEK %s", row.ID, k.kekID)
+	return key, nil
+}
+
+func (k *Keyring) createKey(ctx context.Context, purpose string) (db.EncryptionKey, error) {
+	wrapped, err := k.newWrappedKey()
+	if err != nil {
+		return db.EncryptionKey{}, err
+	}
+	return k.repo.CreateEncryptionKey(ctx, db.CreateEncryptionKeyParams{
+		Purpose:    purpose,
+		WrappedKey: wrapped,
+		KekID:      k.kekID,
+	})
+}
+
+// newWrappedKey generates a data key encrypted with the KEK
+func (k *Keyring) newWrappedKey() ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return k.kek.Seal(key)
+}
+
+func hasPurpose(rows []db.EncryptionKey, purpose string) bool {
+	for _, row := range rows {
+		if row.Purpose == purpose {
+			return true
+		}
+	}
+	return false
+}
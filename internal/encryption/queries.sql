@@ -0,0 +1,72 @@
+-- name: ListEncryptionKeys :many
+SELECT * FROM encryption_keys ORDER BY created_at;
+
+-- name: CreateEncryptionKey :one
+INSERT INTO encryption_keys (purpose, wrapped_key, kek_id) VALUES ($1, $2, $3) RETURNING *;
+
+-- name: AddContentKey :one
+-- Adds a CONTENT key unless one was added after the cutoff, so instances rotating on a
+-- schedule add a single key between them
+INSERT INTO encryption_keys (purpose, wrapped_key, kek_id)
+SELECT 'CONTENT', sqlc.arg(wrapped_key)::bytea, sqlc.arg(kek_id)::text
+WHERE NOT EXISTS (SELECT 1 FROM encryption_keys WHERE purpose = 'CONTENT' AND created_at > sqlc.arg(created_after)::timestamptz)
+RETURNING *;
+
+-- name: RewrapEncryptionKey :exec
+UPDATE encryption_keys SET wrapped_key = $2, kek_id = $3 WHERE id = $1;
+
+-- name: DeleteRetiredEncryptionKeys :execrows
+-- CONTENT keys superseded before the cutoff that nothing is encrypted with anymore. The
+-- cutoff leaves every instance time to start using the newer key.
+DELETE FROM encryption_keys k
+WHERE k.purpose = 'CONTENT'
+  AND EXISTS (SELECT 1 FROM encryption_keys newer
+              WHERE newer.purpose = 'CONTENT' AND newer.created_at > k.created_at AND newer.created_at < sqlc.arg(superseded_before))
+  AND NOT EXISTS (SELECT 1 FROM chat_messages m WHERE m.content_key_id = k.id)
+  AND NOT EXISTS (SELECT 1 FROM chat_message_revisions r WHERE r.content_key_id = k.id)
+  AND NOT EXISTS (SELECT 1 FROM ai_interactions a WHERE a.content_key_id = k.id);
+
+-- name: ListMessagesToReencrypt :many
+-- Messages not encrypted with the active key or not indexed for search yet
+SELECT id, content, content_key_id FROM chat_messages
+WHERE content <> ''
+  AND (content_key_id IS DISTINCT FROM sqlc.narg(active_key_id)::uuid OR search_terms IS NULL)
+LIMIT sqlc.arg(batch_size);
+
+-- name: ReencryptMessage :execrows
+-- Skips messages edited or deleted since they were read
+UPDATE chat_messages
+SET content = sqlc.arg(content), content_key_id = sqlc.narg(content_key_id), search_terms = sqlc.arg(search_terms)::text[]
+WHERE id = sqlc.arg(id) AND content = sqlc.arg(previous_content) AND deleted_at IS NULL;
+
+-- name: ListRevisionsToReencrypt :many
+SELECT id, content, content_key_id FROM chat_message_revisions
+WHERE content <> '' AND content_key_id IS DISTINCT FROM sqlc.narg(active_key_id)::uuid
+LIMIT sqlc.arg(batch_size);
+
+-- name: ReencryptRevision :exec
+UPDATE chat_message_revisions SET content = sqlc.arg(content), content_key_id = sqlc.narg(content_key_id)
+WHERE id = sqlc.arg(id) AND content = sqlc.arg(previous_content);
+
+-- name: ListAIInteractionsToReencrypt :many
+SELECT id, prompt, response, content_key_id FROM ai_interactions
+WHERE (prompt <> '' OR response <> '') AND content_key_id IS DISTINCT FROM sqlc.narg(active_key_id)::uuid
+LIMIT sqlc.arg(batch_size);
+
+-- name: ReencryptAIInteraction :exec
+UPDATE ai_interactions SET prompt = sqlc.arg(prompt), response = sqlc.arg(response), content_key_id = sqlc.narg(content_key_id)
+WHERE id = sqlc.arg(id) AND content_key_id IS NOT DISTINCT FROM sqlc.narg(previous_key_id)::uuid;
+
+-- name: CountContentByKey :many
+-- How much content each key encrypts; plaintext has no key
+SELECT c.content_key_id, COUNT(*)::int AS content_count FROM (
+    SELECT content_key_id FROM chat_messages WHERE content <> ''
+    UNION ALL
+    SELECT content_key_id FROM chat_message_revisions WHERE content <> ''
+    UNION ALL
+    SELECT content_key_id FROM ai_interactions
+) c
+GROUP BY c.content_key_id;
+
+-- name: CountUnindexedMessages :one
+SELECT COUNT(*)::int FROM chat_messages WHERE content <> '' AND search_terms IS NULL;
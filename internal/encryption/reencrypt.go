@@ -0,0 +1,203 @@
+package encryption
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"loveguru/internal/db"
+)
+
+const (
+	maintenanceInterval = time.Minute
+	reencryptBatch      = 200
+
+	// retiredKeyGrace is how long a superseded key is kept after the newer key was
+	// added, so instances that have not reloaded yet can still read what they wrote
+	retiredKeyGrace = time.Hour
+)
+
+// Run keeps the keyring up to date until ctx is cancelled: it picks up keys other
+// instances added, rotates the content key on schedule, re-encrypts and indexes content
+// not encrypted with the active key, and deletes keys nothing is encrypted with anymore
+func (k *Keyring) Run(ctx context.Context) {
+	k.maintain(ctx)
+
+	ticker := time.NewTicker(maintenanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			k.maintain(ctx)
+		}
+	}
+}
+
+func (k *Keyring) maintain(ctx context.Context) {
+	if err := k.Reload(ctx); err != nil {
+		log.Printf("Error reloading encryption keys: %v", err)
+		return
+	}
+	if err := k.rotateDue(ctx); err != nil {
+		log.Printf("Error rotating the content encryption key: %v", err)
+	}
+
+	steps := []struct {
+		name string
+		run  func(context.Context) (found, done int)
+	}{
+		{"chat messages", k.reencryptMessages},
+		{"message revisions", k.reencryptRevisions},
+		{"AI interactions", k.reencryptAIInteractions},
+	}
+	for _, step := range steps {
+		total := 0
+		for ctx.Err() == nil {
+			found, done := step.run(ctx)
+			total += done
+			// Rows that fail are found again every batch, so stop once a batch gets nowhere
+			if found < reencryptBatch || done == 0 {
+				break
+			}
+		}
+		if total > 0 {
+			log.Printf("Re-encrypted %d %s", total, step.name)
+		}
+	}
+
+	if !k.Enabled() {
+		return
+	}
+	deleted, err := k.repo.DeleteRetiredEncryptionKeys(ctx, time.Now().Add(-retiredKeyGrace))
+	if err != nil {
+		log.Printf("Error deleting retired encryption keys: %v", err)
+	} else if deleted > 0 {
+		log.Printf("Deleted %d retired encryption keys", deleted)
+	}
+}
+
+// reencryptMessages encrypts a batch of messages with the active key and indexes them
+// for search. Without encryption it only indexes them.
+func (k *Keyring) reencryptMessages(ctx context.Context) (int, int) {
+	rows, err := k.repo.ListMessagesToReencrypt(ctx, db.ListMessagesToReencryptParams{
+		ActiveKeyID: k.ActiveKeyID(),
+		BatchSize:   reencryptBatch,
+	})
+	if err != nil {
+		log.Printf("Error listing messages to re-encrypt: %v", err)
+		return 0, 0
+	}
+
+	done := 0
+	for _, row := range rows {
+		plaintext, err := k.Open(ctx, row.Content, row.ContentKeyID)
+		if err != nil {
+			log.Printf("Error decrypting message %s: %v", row.ID, err)
+			continue
+		}
+		content, keyID, err := k.Seal(plaintext)
+		if err != nil {
+			log.Printf("Error encrypting message %s: %v", row.ID, err)
+			continue
+		}
+		// A message edited meanwhile was written with the active key already
+		updated, err := k.repo.ReencryptMessage(ctx, db.ReencryptMessageParams{
+			Content:         content,
+			ContentKeyID:    keyID,
+			SearchTerms:     k.SearchTerms(plaintext),
+			ID:              row.ID,
+			PreviousContent: row.Content,
+		})
+		if err != nil {
+			log.Printf("Error re-encrypting message %s: %v", row.ID, err)
+			continue
+		}
+		if updated > 0 {
+			done++
+		}
+	}
+	return len(rows), done
+}
+
+func (k *Keyring) reencryptRevisions(ctx context.Context) (int, int) {
+	rows, err := k.repo.ListRevisionsToReencrypt(ctx, db.ListRevisionsToReencryptParams{
+		ActiveKeyID: k.ActiveKeyID(),
+		BatchSize:   reencryptBatch,
+	})
+	if err != nil {
+		log.Printf("Error listing message revisions to re-encrypt: %v", err)
+		return 0, 0
+	}
+
+	done := 0
+	for _, row := range rows {
+		plaintext, err := k.Open(ctx, row.Content, row.ContentKeyID)
+		if err != nil {
+			log.Printf("Error decrypting message revision %s: %v", row.ID, err)
+			continue
+		}
+		content, keyID, err := k.Seal(plaintext)
+		if err != nil {
+			log.Printf("Error encrypting message revision %s: %v", row.ID, err)
+			continue
+		}
+		if err := k.repo.ReencryptRevision(ctx, db.ReencryptRevisionParams{
+			Content:         content,
+			ContentKeyID:    keyID,
+			ID:              row.ID,
+			PreviousContent: row.Content,
+		}); err != nil {
+			log.Printf("Error re-encrypting message revision %s: %v", row.ID, err)
+			continue
+		}
+		done++
+	}
+	return len(rows), done
+}
+
+func (k *Keyring) reencryptAIInteractions(ctx context.Context) (int, int) {
+	rows, err := k.repo.ListAIInteractionsToReencrypt(ctx, db.ListAIInteractionsToReencryptParams{
+		ActiveKeyID: k.ActiveKeyID(),
+		BatchSize:   reencryptBatch,
+	})
+	if err != nil {
+		log.Printf("Error listing AI interactions to re-encrypt: %v", err)
+		return 0, 0
+	}
+
+	done := 0
+	for _, row := range rows {
+		prompt, err := k.Open(ctx, row.Prompt, row.ContentKeyID)
+		if err != nil {
+			log.Printf("Error decrypting AI interaction %s: %v", row.ID, err)
+			continue
+		}
+		response, err := k.Open(ctx, row.Response, row.ContentKeyID)
+		if err != nil {
+			log.Printf("Error decrypting AI interaction %s: %v", row.ID, err)
+			continue
+		}
+
+		sealed, keyID, err := k.SealAll(prompt, response)
+		if err != nil {
+			log.Printf("Error encrypting AI interaction %s: %v", row.ID, err)
+			continue
+		}
+
+		if err := k.repo.ReencryptAIInteraction(ctx, db.ReencryptAIInteractionParams{
+			Prompt:        sealed[0],
+			Response:      sealed[1],
+			ContentKeyID:  keyID,
+			ID:            row.ID,
+			PreviousKeyID: row.ContentKeyID,
+		}); err != nil {
+			log.Printf("Error re-encrypting AI interaction %s: %v", row.ID, err)
+			continue
+		}
+		done++
+	}
+	return len(rows), done
+}
@@ -0,0 +1,45 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+// Words splits text into the lower case words it is searched by
+func Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// SearchTerms returns the terms a message is indexed by, or a query looks for: each of
+// its words once. With encryption on they are keyed hashes, so the index tells which
+// messages share a word but not the word.
+func (k *Keyring) SearchTerms(text string) []string {
+	var key []byte
+	if k.Enabled() {
+		k.mu.RLock()
+		key = k.search
+		k.mu.RUnlock()
+	}
+
+	words := Words(text)
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, w := range words {
+		term := w
+		if key != nil {
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte(w))
+			term = hex.EncodeToString(mac.Sum(nil)[:16])
+		}
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
@@ -112,6 +112,12 @@ func authenticate(ctx context.Context, jwtSecret string) (*UserInfo, error) {
 		return nil, status.Error(codes.Unauthenticated, "empty token")
 	}
 
+	return ParseToken(tokenString, jwtSecret)
+}
+
+// ParseToken validates an access token and returns the user it was issued to. HTTP
+// endpoints outside gRPC use it to authenticate their requests.
+func ParseToken(tokenString, jwtSecret string) (*UserInfo, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		return []byte(jwtSecret), nil
 	})
@@ -0,0 +1,108 @@
+package moderation
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// match is a span of a message, in bytes, that a detector found
+type match struct {
+	detector   Detector
+	start, end int
+}
+
+var patterns = map[Detector][]*regexp.Regexp{
+	// Addresses, also when spelled out as "name (at) mail (dot) com"
+	DetectorEmail: {
+		regexp.MustCompile(`(?i)[a-z0-9._%+-]+\s*(?:@|\(at\)|\[at\])\s*[a-z0-9-]+(?:\s*(?:\.|\(dot\)|\[dot\])\s*[a-z0-9-]+)*\s*(?:\.|\(dot\)|\[dot\])\s*[a-z]{2,}\b`),
+	},
+	DetectorPaymentHandle: {
+		// UPI IDs
+		regexp.MustCompile(`(?i)\b[a-z0-9._-]{2,}@(?:okaxis|oksbi|okhdfcbank|okicici|ybl|ibl|axl|apl|paytm|upi|pthdfc|ptsbi|ptyes|ptaxis)\b`),
+		// Cash App cashtags
+		regexp.MustCompile(`\$[A-Za-z][A-Za-z0-9_]{1,19}\b`),
+		// Payment links
+		regexp.MustCompile(`(?i)\b(?:paypal\.me|venmo\.com|cash\.app)/[a-z0-9._$-]+`),
+		// "my venmo is @name"
+		regexp.MustCompile(`(?i)\b(?:venmo|paypal|cash\s?app|zelle|g\s?pay|phone\s?pe|paytm)\b[^@\n]{0,20}@[a-z0-9._-]{3,}`),
+	},
+	DetectorURL: {
+		regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"]+`),
+		regexp.MustCompile(`(?i)\b[a-z0-9][a-z0-9-]*(?:\.[a-z0-9-]+)*\.(?:com|net|org|io|me|in|co|app|xyz|info|link|ly|gg|tv|us|uk)\b(?:/[^\s<>"]*)?`),
+	},
+	// 9 to 15 digits with the usual separators, long enough to leave dates and prices alone
+	DetectorPhone: {
+		regexp.MustCompile(`\+?\(?\d(?:[\s().-]{0,2}\d){8,14}`),
+	},
+}
+
+// find returns the matches of every detector. Where matches overlap, the one of the
+// detector listed first in Detectors wins, so an email address is not also a link.
+func (rs *ruleset) find(content string) []match {
+	var found []match
+	for _, d := range Detectors {
+		for _, m := range rs.detect(d, content) {
+			overlaps := slices.ContainsFunc(found, func(f match) bool {
+				return m.start < f.end && f.start < m.end
+			})
+			if !overlaps {
+				found = append(found, m)
+			}
+		}
+	}
+	return found
+}
+
+func (rs *ruleset) detect(d Detector, content string) []match {
+	if d == DetectorProfanity {
+		return rs.profanity.find(content)
+	}
+
+	var found []match
+	for _, re := range patterns[d] {
+		for _, loc := range re.FindAllStringIndex(content, -1) {
+			end := loc[1]
+			if d == DetectorURL {
+				// A link at the end of a sentence does not include the full stop
+				end = loc[0] + len(strings.TrimRight(content[loc[0]:end], ".,!?;:)'\""))
+			}
+			found = append(found, match{detector: d, start: loc[0], end: end})
+		}
+	}
+	return found
+}
+
+// lexicon matches the profanity terms as whole words, ignoring case
+type lexicon struct {
+	re *regexp.Regexp // nil without terms
+}
+
+func newLexicon(terms []string) *lexicon {
+	if len(terms) == 0 {
+		return &lexicon{}
+	}
+
+	// Longer terms first, so a term is not cut short by one it starts with
+	sorted := slices.Clone(terms)
+	slices.SortFunc(sorted, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+
+	quoted := make([]string, len(sorted))
+	for i, t := range sorted {
+		quoted[i] = regexp.QuoteMeta(t)
+	}
+	return &lexicon{re: regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)}
+}
+
+func (l *lexicon) find(content string) []match {
+	if l.re == nil {
+		return nil
+	}
+
+	var found []match
+	for _, loc := range l.re.FindAllStringIndex(content, -1) {
+		found = append(found, match{detector: DetectorProfanity, start: loc[0], end: loc[1]})
+	}
+	return found
+}
@@ -0,0 +1,231 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"loveguru/internal/db"
+)
+
+// Detector names a kind of content the filter looks for in chat messages
+type Detector string
+
+const (
+	DetectorPhone         Detector = "PHONE"
+	DetectorEmail         Detector = "EMAIL"
+	DetectorPaymentHandle Detector = "PAYMENT_HANDLE"
+	DetectorURL           Detector = "URL"
+	DetectorProfanity     Detector = "PROFANITY"
+)
+
+// Action is what happens to a message a detector matched
+type Action string
+
+const (
+	ActionAllow Action = "ALLOW" // the detector is off
+	ActionMask  Action = "MASK"  // the match is replaced with asterisks
+	ActionBlock Action = "BLOCK" // the message is rejected with a notice to the sender
+	ActionFlag  Action = "FLAG"  // the message is stored as sent and flagged for review
+)
+
+// reloadInterval is how often rules an admin changed through another instance are
+// picked up
+const reloadInterval = 30 * time.Second
+
+// Detectors lists every detector, in the order overlapping matches are attributed
+var Detectors = []Detector{DetectorEmail, DetectorPaymentHandle, DetectorURL, DetectorPhone, DetectorProfanity}
+
+var actions = []Action{ActionAllow, ActionMask, ActionBlock, ActionFlag}
+
+// descriptions complete "contains ..." in flag reasons and default notices
+var descriptions = map[Detector]string{
+	DetectorPhone:         "a phone number",
+	DetectorEmail:         "an email address",
+	DetectorPaymentHandle: "payment details",
+	DetectorURL:           "a link",
+	DetectorProfanity:     "language that isn't allowed",
+}
+
+// defaultActions are the rules until the first reload, matching the seeded ones
+var defaultActions = map[Detector]Action{
+	DetectorPhone:         ActionMask,
+	DetectorEmail:         ActionMask,
+	DetectorPaymentHandle: ActionBlock,
+	DetectorURL:           ActionFlag,
+	DetectorProfanity:     ActionMask,
+}
+
+// Result is what may be stored of a message that was not blocked
+type Result struct {
+	Content string     // the message with the matches of MASK rules masked
+	Flagged []Detector // detectors with a FLAG rule that matched
+}
+
+// BlockedError rejects a message a BLOCK rule matched. Its message is the notice for
+// the sender.
+type BlockedError struct {
+	Detector Detector
+	Notice   string
+}
+
+func (e *BlockedError) Error() string {
+	return e.Notice
+}
+
+type rule struct {
+	action Action
+	notice string
+}
+
+// ruleset is an immutable snapshot of the rules, swapped as a whole on reload
+type ruleset struct {
+	rules     map[Detector]rule
+	profanity *lexicon
+}
+
+// Filter screens chat messages before they are stored, with the rules and lexicon
+// admins maintain in the database
+type Filter struct {
+	repo  *db.Queries
+	rules atomic.Pointer[ruleset]
+}
+
+func NewFilter(repo *db.Queries) *Filter {
+	f := &Filter{repo: repo}
+
+	rs := &ruleset{rules: make(map[Detector]rule), profanity: newLexicon(nil)}
+	for d, a := range defaultActions {
+		rs.rules[d] = rule{action: a}
+	}
+	f.rules.Store(rs)
+
+	return f
+}
+
+// Run reloads the rules until ctx is cancelled
+func (f *Filter) Run(ctx context.Context) {
+	if err := f.Reload(ctx); err != nil {
+		log.Printf("Error loading moderation rules, using defaults: %v", err)
+	}
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.Reload(ctx); err != nil {
+				log.Printf("Error reloading moderation rules: %v", err)
+			}
+		}
+	}
+}
+
+// Reload replaces the rules with the ones in the database. Messages being checked
+// meanwhile use either the old or the new rules, never a mix.
+func (f *Filter) Reload(ctx context.Context) error {
+	rows, err := f.repo.ListModerationRules(ctx)
+	if err != nil {
+		return err
+	}
+	terms, err := f.repo.ListModerationTerms(ctx)
+	if err != nil {
+		return err
+	}
+
+	rs := &ruleset{rules: make(map[Detector]rule), profanity: newLexicon(terms)}
+	for _, r := range rows {
+		rs.rules[Detector(r.Detector)] = rule{action: Action(r.Action), notice: r.Notice}
+	}
+	f.rules.Store(rs)
+
+	return nil
+}
+
+// Check screens a message. A message a BLOCK rule matched fails with a *BlockedError.
+func (f *Filter) Check(content string) (Result, error) {
+	rs := f.rules.Load()
+
+	var masked []match
+	var flagged []Detector
+	for _, m := range rs.find(content) {
+		r := rs.rules[m.detector]
+		switch r.action {
+		case ActionBlock:
+			return Result{}, &BlockedError{Detector: m.detector, Notice: r.noticeFor(m.detector)}
+		case ActionMask:
+			masked = append(masked, m)
+		case ActionFlag:
+			if !slices.Contains(flagged, m.detector) {
+				flagged = append(flagged, m.detector)
+			}
+		}
+	}
+
+	return Result{Content: mask(content, masked), Flagged: flagged}, nil
+}
+
+func (r rule) noticeFor(d Detector) string {
+	if r.notice != "" {
+		return r.notice
+	}
+	return fmt.Sprintf("Your message wasn't sent because it contains %s. Please keep the conversation on LoveGuru.", descriptions[d])
+}
+
+// Reason describes why a message was flagged, for admins reviewing the flag
+func Reason(flagged []Detector) string {
+	var parts []string
+	for _, d := range flagged {
+		parts = append(parts, descriptions[d])
+	}
+	return "Automatic: message contains " + strings.Join(parts, ", ")
+}
+
+// ValidateRule checks a rule an admin sets
+func ValidateRule(detector, action string) error {
+	if !slices.Contains(Detectors, Detector(detector)) {
+		return fmt.Errorf("unknown detector %q", detector)
+	}
+	if !slices.Contains(actions, Action(action)) {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return nil
+}
+
+// NormalizeTerms lower-cases lexicon terms and drops blank and repeated ones
+func NormalizeTerms(terms []string) []string {
+	var normalized []string
+	for _, t := range terms {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "" && !slices.Contains(normalized, t) {
+			normalized = append(normalized, t)
+		}
+	}
+	return normalized
+}
+
+// mask replaces the matches, which do not overlap, with one asterisk per character
+func mask(content string, matches []match) string {
+	if len(matches) == 0 {
+		return content
+	}
+	slices.SortFunc(matches, func(a, b match) int { return a.start - b.start })
+
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		sb.WriteString(content[last:m.start])
+		sb.WriteString(strings.Repeat("*", utf8.RuneCountInString(content[m.start:m.end])))
+		last = m.end
+	}
+	sb.WriteString(content[last:])
+	return sb.String()
+}
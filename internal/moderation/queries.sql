@@ -0,0 +1,24 @@
+-- name: ListModerationRules :many
+SELECT * FROM moderation_rules ORDER BY detector;
+
+-- name: UpdateModerationRule :one
+UPDATE moderation_rules
+SET action = $2, notice = $3, updated_by = $4, updated_at = NOW()
+WHERE detector = $1
+RETURNING *;
+
+-- name: ListModerationTerms :many
+SELECT term FROM moderation_terms ORDER BY term;
+
+-- name: AddModerationTerms :execrows
+INSERT INTO moderation_terms (term, created_by)
+SELECT UNNEST(sqlc.arg(terms)::text[]), sqlc.arg(created_by)
+ON CONFLICT (term) DO NOTHING;
+
+-- name: RemoveModerationTerms :execrows
+DELETE FROM moderation_terms WHERE term = ANY(sqlc.arg(terms)::text[]);
+
+-- name: CreateModerationFlag :one
+INSERT INTO admin_flags (reported_user_id, reported_advisor_id, session_id, message_id, reason, source)
+VALUES ($1, $2, $3, $4, $5, 'MODERATION')
+RETURNING *;
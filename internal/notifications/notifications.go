@@ -6,6 +6,7 @@ import (
 	"net/smtp"
 	"os"
 	"strings"
+	"time"
 
 	"loveguru/internal/config"
 )
@@ -141,6 +142,53 @@ The LoveGuru Team
 	return n.SendEmail(ctx, to, subject, body)
 }
 
+func (n *NotificationService) SendAdvisorRejectionEmail(ctx context.Context, to, name, reason string) error {
+	subject := "Update on Your LoveGuru Advisor Application"
+	body := fmt.Sprintf(`
+Dear %s,
+
+Thank you for your interest in becoming a LoveGuru advisor. After careful review, we are unable to approve your application at this time.
+
+Reason: %s
+
+You are welcome to update your application and resubmit it once the points above have been addressed.
+
+Best regards,
+The LoveGuru Team
+`, name, reason)
+
+	return n.SendEmail(ctx, to, subject, body)
+}
+
+func (n *NotificationService) SendAdvisorApplicationStatusEmail(ctx context.Context, to, name, status, note string) error {
+	var subject, message string
+	switch status {
+	case "SUBMITTED":
+		subject = "We Received Your LoveGuru Advisor Application"
+		message = "We have received your application and our team will review it shortly."
+	case "UNDER_REVIEW":
+		subject = "Your LoveGuru Advisor Application Is Under Review"
+		message = "A member of our team is now reviewing your application."
+	case "NEEDS_CHANGES":
+		subject = "Your LoveGuru Advisor Application Needs Changes"
+		message = "Our reviewers have asked for some changes before your application can be approved:\n\n" + note + "\n\nPlease update your application and resubmit it."
+	default:
+		subject = "Update on Your LoveGuru Advisor Application"
+		message = "There has been an update to your application."
+	}
+
+	body := fmt.Sprintf(`
+Dear %s,
+
+%s
+
+Best regards,
+The LoveGuru Team
+`, name, message)
+
+	return n.SendEmail(ctx, to, subject, body)
+}
+
 func (n *NotificationService) SendSessionReminder(ctx context.Context, to, advisorName, sessionType string, sessionTime string) error {
 	subject := fmt.Sprintf("Upcoming %s Session Reminder", sessionType)
 	body := fmt.Sprintf(`
@@ -269,6 +317,22 @@ func (n *NotificationService) SendChatNotification(deviceTokens []string, sender
 	return n.SendPushNotification(deviceTokens, "all", title, body, data)
 }
 
+// SendChatBurstNotification sends one push notification for several chat messages
+// from the same sender
+func (n *NotificationService) SendChatBurstNotification(deviceTokens []string, senderName string, count int, sessionID string) error {
+	title := "New Messages"
+	body := fmt.Sprintf("%s sent you %d messages", senderName, count)
+
+	data := map[string]interface{}{
+		"type":       "chat",
+		"session_id": sessionID,
+		"sender":     senderName,
+		"count":      count,
+	}
+
+	return n.SendPushNotification(deviceTokens, "all", title, body, data)
+}
+
 // SendCallNotification sends a push notification for call requests
 func (n *NotificationService) SendCallNotification(deviceTokens []string, callerName, callType, sessionID string) error {
 	title := "Incoming Call"
@@ -301,6 +365,9 @@ func (n *NotificationService) SendSessionUpdateNotification(deviceTokens []strin
 	case "rejected":
 		title = "Session Rejected"
 		body = fmt.Sprintf("%s is currently unavailable for a session", advisorName)
+	case "expired":
+		title = "No Response"
+		body = fmt.Sprintf("%s did not respond in time. You can choose another advisor", advisorName)
 	default:
 		title = "Session Update"
 		body = fmt.Sprintf("Update regarding your session with %s", advisorName)
@@ -316,6 +383,35 @@ func (n *NotificationService) SendSessionUpdateNotification(deviceTokens []strin
 	return n.SendPushNotification(deviceTokens, "all", title, body, data)
 }
 
+// SendSessionRequestNotification asks an advisor to accept or decline a new chat
+func (n *NotificationService) SendSessionRequestNotification(deviceTokens []string, userName, sessionID string, expiresIn time.Duration) error {
+	title := "New Chat Request"
+	body := fmt.Sprintf("%s wants to chat. Accept within %d seconds", userName, int(expiresIn.Seconds()))
+
+	data := map[string]interface{}{
+		"type":       "session_request",
+		"session_id": sessionID,
+		"user":       userName,
+	}
+
+	return n.SendPushNotification(deviceTokens, "all", title, body, data)
+}
+
+// SendQueueOfferNotification tells a queued user that the advisor is ready for them
+func (n *NotificationService) SendQueueOfferNotification(deviceTokens []string, advisorName, entryID, sessionID string, expiresIn time.Duration) error {
+	title := "It's Your Turn"
+	body := fmt.Sprintf("%s is ready for you. Join within %d seconds to keep your place", advisorName, int(expiresIn.Seconds()))
+
+	data := map[string]interface{}{
+		"type":       "queue",
+		"entry_id":   entryID,
+		"session_id": sessionID,
+		"advisor":    advisorName,
+	}
+
+	return n.SendPushNotification(deviceTokens, "all", title, body, data)
+}
+
 // ValidateDeviceToken validates if a device token looks valid
 func (n *NotificationService) ValidateDeviceToken(token string) bool {
 	if token == "" {
@@ -0,0 +1,85 @@
+-- name: RefreshSessionMetrics :execrows
+-- Recomputes metrics for advisor sessions that finished since the given time. Declined and expired
+-- requests count as not accepted. Sessions cancelled because a queued user never accepted the offer,
+-- or requests the user withdrew before the advisor answered, are not held against the advisor.
+INSERT INTO advisor_session_metrics (session_id, advisor_id, session_type, status, started_at, ended_at, duration_seconds,
+                                     first_response_seconds, accepted, repeat_client, missed_call, computed_at)
+SELECT s.id,
+       s.advisor_id::uuid,
+       s.type,
+       s.status,
+       s.started_at,
+       s.ended_at,
+       GREATEST(EXTRACT(EPOCH FROM (s.ended_at - s.started_at)), 0)::INTEGER,
+       CASE WHEN s.type = 'CHAT' AND adv.first_at IS NOT NULL
+            THEN GREATEST(EXTRACT(EPOCH FROM (adv.first_at - COALESCE(usr.first_at, s.started_at))), 0)::INTEGER
+       END,
+       CASE WHEN s.type = 'CALL' THEN calls.answered ELSE adv.first_at IS NOT NULL END,
+       EXISTS (
+           SELECT 1 FROM sessions prev
+           WHERE prev.user_id = s.user_id AND prev.advisor_id = s.advisor_id
+             AND prev.status = 'ENDED' AND prev.started_at < s.started_at
+       ),
+       s.type = 'CALL' AND NOT calls.answered,
+       NOW()
+FROM sessions s
+LEFT JOIN LATERAL (
+    SELECT MIN(m.created_at) AS first_at FROM chat_messages m WHERE m.session_id = s.id AND m.sender_type = 'USER'
+) usr ON TRUE
+LEFT JOIN LATERAL (
+    SELECT MIN(m.created_at) AS first_at FROM chat_messages m WHERE m.session_id = s.id AND m.sender_type = 'ADVISOR'
+) adv ON TRUE
+CROSS JOIN LATERAL (
+    SELECT EXISTS (SELECT 1 FROM call_logs cl WHERE cl.session_id = s.id AND COALESCE(cl.duration_seconds, 0) > 0) AS answered
+) calls
+WHERE s.advisor_id IS NOT NULL
+  AND s.type IN ('CHAT', 'CALL')
+  AND s.status IN ('ENDED', 'CANCELLED', 'DECLINED', 'EXPIRED')
+  AND NOT (s.status = 'CANCELLED' AND s.requested_at IS NOT NULL AND s.accepted_at IS NULL)
+  AND s.started_at IS NOT NULL
+  AND s.ended_at >= sqlc.arg(since)
+  AND NOT EXISTS (
+      SELECT 1 FROM advisor_queue_entries q
+      WHERE q.session_id = s.id AND q.status IN ('LEFT', 'EXPIRED')
+  )
+ON CONFLICT (session_id) DO UPDATE SET
+    status = EXCLUDED.status,
+    ended_at = EXCLUDED.ended_at,
+    duration_seconds = EXCLUDED.duration_seconds,
+    first_response_seconds = EXCLUDED.first_response_seconds,
+    accepted = EXCLUDED.accepted,
+    repeat_client = EXCLUDED.repeat_client,
+    missed_call = EXCLUDED.missed_call,
+    computed_at = EXCLUDED.computed_at;
+
+-- name: GetAdvisorPeriodStats :one
+SELECT COUNT(*)::INTEGER AS session_count,
+       COUNT(*) FILTER (WHERE accepted)::INTEGER AS accepted_count,
+       COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY first_response_seconds), 0)::float8 AS median_first_response_seconds,
+       COALESCE(AVG(duration_seconds) FILTER (WHERE accepted AND status = 'ENDED'), 0)::float8 AS average_session_seconds,
+       COUNT(*) FILTER (WHERE repeat_client)::INTEGER AS repeat_client_count,
+       COUNT(*) FILTER (WHERE session_type = 'CALL')::INTEGER AS call_count,
+       COUNT(*) FILTER (WHERE missed_call)::INTEGER AS missed_calls
+FROM advisor_session_metrics
+WHERE advisor_id = sqlc.arg(advisor_id)
+  AND started_at >= sqlc.arg(from_time)
+  AND started_at < sqlc.arg(to_time);
+
+-- name: GetAdvisorRatingStats :one
+SELECT COALESCE(AVG(rating), 0)::float8 AS average_rating,
+       COUNT(*)::INTEGER AS rating_count
+FROM ratings
+WHERE advisor_id = sqlc.arg(advisor_id)
+  AND created_at >= sqlc.arg(from_time)
+  AND created_at < sqlc.arg(to_time);
+
+-- name: GetAdvisorRatingTrend :many
+SELECT date_trunc('week', created_at)::timestamptz AS week_start,
+       AVG(rating)::float8 AS average_rating,
+       COUNT(*)::INTEGER AS rating_count
+FROM ratings
+WHERE advisor_id = sqlc.arg(advisor_id)
+  AND created_at >= sqlc.arg(from_time)
+  AND created_at < sqlc.arg(to_time)
+GROUP BY week_start
+ORDER BY week_start;
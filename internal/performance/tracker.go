@@ -0,0 +1,147 @@
+package performance
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/proto/common"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// refreshInterval is how often newly finished sessions are folded into the metrics
+	refreshInterval = 5 * time.Minute
+	// refreshOverlap re-processes a little of the previous window so sessions that were
+	// committed while the last refresh ran are not skipped
+	refreshOverlap = time.Minute
+)
+
+// Tracker maintains per-session advisor metrics and builds period reports from them
+type Tracker struct {
+	repo *db.Queries
+
+	mu          sync.Mutex
+	refreshedAt time.Time // zero until the first full refresh
+}
+
+func NewTracker(repo *db.Queries) *Tracker {
+	return &Tracker{repo: repo}
+}
+
+// Run refreshes metrics until ctx is cancelled. The first refresh covers all history;
+// later ones only sessions that finished since the previous refresh.
+func (t *Tracker) Run(ctx context.Context) {
+	t.Refresh(ctx)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.Refresh(ctx)
+		}
+	}
+}
+
+// Refresh folds sessions that finished since the last refresh into the metrics
+func (t *Tracker) Refresh(ctx context.Context) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	started := time.Now()
+	since := sql.NullTime{Time: time.Unix(0, 0), Valid: true}
+	if !t.refreshedAt.IsZero() {
+		since.Time = t.refreshedAt.Add(-refreshOverlap)
+	}
+
+	n, err := t.repo.RefreshSessionMetrics(ctx, since)
+	if err != nil {
+		log.Printf("Error refreshing advisor metrics: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("Refreshed metrics for %d advisor sessions", n)
+	}
+
+	t.refreshedAt = started
+}
+
+// Report returns the advisor's stats for [from, to) alongside the period of the same
+// length immediately before it
+func (t *Tracker) Report(ctx context.Context, advisorUserID uuid.UUID, from, to time.Time) (*common.AdvisorStatsReport, error) {
+	current, err := t.stats(ctx, advisorUserID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	prevFrom := from.Add(-to.Sub(from))
+	previous, err := t.stats(ctx, advisorUserID, prevFrom, from)
+	if err != nil {
+		return nil, err
+	}
+
+	trend, err := t.repo.GetAdvisorRatingTrend(ctx, db.GetAdvisorRatingTrendParams{
+		AdvisorID: advisorUserID,
+		FromTime:  sql.NullTime{Time: from, Valid: true},
+		ToTime:    sql.NullTime{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &common.AdvisorStatsReport{Current: current, Previous: previous}
+	for _, p := range trend {
+		report.RatingTrend = append(report.RatingTrend, &common.RatingTrendPoint{
+			WeekStart:     p.WeekStart.Format("2006-01-02T15:04:05Z"),
+			AverageRating: p.AverageRating,
+			RatingCount:   p.RatingCount,
+		})
+	}
+
+	return report, nil
+}
+
+func (t *Tracker) stats(ctx context.Context, advisorUserID uuid.UUID, from, to time.Time) (*common.AdvisorStats, error) {
+	s, err := t.repo.GetAdvisorPeriodStats(ctx, db.GetAdvisorPeriodStatsParams{
+		AdvisorID: advisorUserID,
+		FromTime:  from,
+		ToTime:    to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := t.repo.GetAdvisorRatingStats(ctx, db.GetAdvisorRatingStatsParams{
+		AdvisorID: advisorUserID,
+		FromTime:  sql.NullTime{Time: from, Valid: true},
+		ToTime:    sql.NullTime{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &common.AdvisorStats{
+		From:                       from.Format("2006-01-02T15:04:05Z"),
+		To:                         to.Format("2006-01-02T15:04:05Z"),
+		SessionCount:               s.SessionCount,
+		MedianFirstResponseSeconds: s.MedianFirstResponseSeconds,
+		AverageSessionSeconds:      s.AverageSessionSeconds,
+		MissedCalls:                s.MissedCalls,
+		AverageRating:              r.AverageRating,
+		RatingCount:                r.RatingCount,
+	}
+	if s.SessionCount > 0 {
+		stats.AcceptanceRate = float64(s.AcceptedCount) / float64(s.SessionCount)
+		stats.RepeatClientRate = float64(s.RepeatClientCount) / float64(s.SessionCount)
+	}
+
+	return stats, nil
+}
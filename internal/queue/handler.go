@@ -0,0 +1,35 @@
+package queue
+
+import (
+	"context"
+	"loveguru/proto/queue"
+)
+
+type Handler struct {
+	queue.UnimplementedQueueServiceServer
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) JoinQueue(ctx context.Context, req *queue.JoinQueueRequest) (*queue.JoinQueueResponse, error) {
+	return h.service.JoinQueue(ctx, req)
+}
+
+func (h *Handler) LeaveQueue(ctx context.Context, req *queue.LeaveQueueRequest) (*queue.LeaveQueueResponse, error) {
+	return h.service.LeaveQueue(ctx, req)
+}
+
+func (h *Handler) AcceptQueueOffer(ctx context.Context, req *queue.AcceptQueueOfferRequest) (*queue.AcceptQueueOfferResponse, error) {
+	return h.service.AcceptQueueOffer(ctx, req)
+}
+
+func (h *Handler) WatchQueuePosition(req *queue.WatchQueuePositionRequest, stream queue.QueueService_WatchQueuePositionServer) error {
+	return h.service.WatchQueuePosition(req, stream)
+}
+
+func (h *Handler) WatchAdvisorQueue(req *queue.WatchAdvisorQueueRequest, stream queue.QueueService_WatchAdvisorQueueServer) error {
+	return h.service.WatchAdvisorQueue(req, stream)
+}
@@ -0,0 +1,88 @@
+-- name: JoinAdvisorQueue :one
+INSERT INTO advisor_queue_entries (advisor_id, user_id)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetQueueEntryByID :one
+SELECT * FROM advisor_queue_entries WHERE id = $1;
+
+-- name: GetActiveQueueEntry :one
+SELECT * FROM advisor_queue_entries
+WHERE advisor_id = $1 AND user_id = $2 AND status IN ('WAITING', 'OFFERED');
+
+-- name: GetQueuePosition :one
+-- 1-based position among entries still waiting or holding an offer for the advisor
+SELECT COUNT(*)::INTEGER AS position
+FROM advisor_queue_entries q
+WHERE q.advisor_id = sqlc.arg(advisor_id)
+  AND q.status IN ('WAITING', 'OFFERED')
+  AND q.created_at <= sqlc.arg(created_at);
+
+-- name: GetQueueLength :one
+SELECT COUNT(*)::INTEGER AS length
+FROM advisor_queue_entries
+WHERE advisor_id = $1 AND status IN ('WAITING', 'OFFERED');
+
+-- name: GetAverageSessionSeconds :one
+-- Average length of the advisor's most recent ended sessions, used to estimate waits
+SELECT COALESCE(AVG(EXTRACT(EPOCH FROM (s.ended_at - s.started_at))), 0)::float8 AS average_seconds
+FROM (
+    SELECT started_at, ended_at FROM sessions
+    WHERE advisor_id = $1 AND status = 'ENDED' AND ended_at IS NOT NULL
+    ORDER BY ended_at DESC
+    LIMIT 20
+) s;
+
+-- name: LeaveAdvisorQueue :one
+UPDATE advisor_queue_entries
+SET status = 'LEFT', updated_at = NOW()
+WHERE id = $1 AND user_id = $2 AND status IN ('WAITING', 'OFFERED')
+RETURNING *;
+
+-- name: ListQueueHeadsReadyForOffer :many
+-- Front of each queue whose advisor is online, below chat capacity, not on a call and has no outstanding offer
+SELECT DISTINCT ON (q.advisor_id) q.*
+FROM advisor_queue_entries q
+JOIN advisors a ON a.user_id = q.advisor_id
+WHERE q.status = 'WAITING'
+  AND a.status = 'ONLINE'
+  AND NOT EXISTS (SELECT 1 FROM advisor_queue_entries o WHERE o.advisor_id = q.advisor_id AND o.status = 'OFFERED')
+  AND NOT EXISTS (SELECT 1 FROM sessions s WHERE s.advisor_id = q.advisor_id AND s.status = 'ONGOING' AND s.type = 'CALL')
+  AND (SELECT COUNT(*) FROM sessions s WHERE s.advisor_id = q.advisor_id AND s.status IN ('REQUESTED', 'ONGOING')) < a.max_concurrent_chats
+ORDER BY q.advisor_id, q.created_at;
+
+-- name: OfferQueueEntry :one
+UPDATE advisor_queue_entries
+SET status = 'OFFERED', session_id = $2, offered_at = NOW(), offer_expires_at = $3, updated_at = NOW()
+WHERE id = $1 AND status = 'WAITING'
+RETURNING *;
+
+-- name: AcceptQueueOffer :one
+-- Queued sessions are REQUESTED when the offer is made and start once the user accepts,
+-- so billing runs from acceptance
+WITH accepted AS (
+    UPDATE advisor_queue_entries q
+    SET status = 'ACCEPTED', updated_at = NOW()
+    FROM sessions s
+    WHERE q.id = $1 AND q.user_id = $2 AND q.status = 'OFFERED' AND q.offer_expires_at > NOW()
+      AND s.id = q.session_id AND s.status = 'REQUESTED'
+    RETURNING q.session_id
+)
+UPDATE sessions SET status = 'ONGOING', accepted_at = NOW(), started_at = NOW()
+FROM accepted
+WHERE sessions.id = accepted.session_id AND sessions.status = 'REQUESTED'
+RETURNING sessions.*;
+
+-- name: ExpireQueueOffers :many
+-- Offers also expire once their session is no longer waiting, e.g. after the request
+-- timeout or when the user withdrew it
+UPDATE advisor_queue_entries q
+SET status = 'EXPIRED', updated_at = NOW()
+WHERE q.status = 'OFFERED'
+  AND (q.offer_expires_at <= NOW()
+       OR NOT EXISTS (SELECT 1 FROM sessions s WHERE s.id = q.session_id AND s.status = 'REQUESTED'))
+RETURNING q.*;
+
+-- name: CancelSession :exec
+UPDATE sessions SET status = 'CANCELLED', ended_at = NOW()
+WHERE id = $1 AND status = 'REQUESTED';
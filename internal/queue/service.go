@@ -0,0 +1,531 @@
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"loveguru/internal/advisor"
+	"loveguru/internal/chat"
+	"loveguru/internal/db"
+	"loveguru/internal/grpc/middleware"
+	"loveguru/internal/notifications"
+	"loveguru/proto/common"
+	"loveguru/proto/queue"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// offerTTL is how long the user at the front has to accept before losing their place
+	offerTTL = 2 * time.Minute
+	// dispatchInterval is how often queues are checked for free advisors and expired offers
+	dispatchInterval = 5 * time.Second
+	// refreshInterval re-sends queue status so wait estimates stay current
+	refreshInterval = 30 * time.Second
+	// defaultSessionSeconds is the wait estimate per queued user when the advisor has no history
+	defaultSessionSeconds = 15 * 60
+
+	// queueChangedEvent tells every instance that an advisor's queue changed
+	queueChangedEvent = "QUEUE_CHANGED"
+)
+
+// Queue entry statuses as stored in advisor_queue_entries.status
+const (
+	EntryWaiting  = "WAITING"
+	EntryOffered  = "OFFERED"
+	EntryAccepted = "ACCEPTED"
+	EntryLeft     = "LEFT"
+	EntryExpired  = "EXPIRED"
+)
+
+// Service manages per-advisor FIFO queues. Queue changes are published through the chat
+// backend, so watchers are woken on whichever instance they are connected to; Run drives
+// offers to the front of each queue.
+type Service struct {
+	repo     *db.Queries
+	notifier *notifications.NotificationService
+	capacity *advisor.Capacity
+	backend  chat.Backend
+
+	mu       sync.Mutex
+	watchers map[uuid.UUID]map[chan struct{}]struct{} // keyed by advisor user ID
+}
+
+func NewService(repo *db.Queries, notifier *notifications.NotificationService, capacity *advisor.Capacity, backend chat.Backend) *Service {
+	return &Service{
+		repo:     repo,
+		notifier: notifier,
+		capacity: capacity,
+		backend:  backend,
+		watchers: make(map[uuid.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+func (s *Service) JoinQueue(ctx context.Context, req *queue.JoinQueueRequest) (*queue.JoinQueueResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	aid, err := uuid.Parse(req.AdvisorId)
+	if err != nil {
+		return nil, err
+	}
+	if aid == uid {
+		return nil, errors.New("cannot queue for yourself")
+	}
+
+	a, err := s.repo.GetAdvisorByUserID(ctx, aid)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("advisor not found")
+		}
+		return nil, err
+	}
+	if a.Status.String != "ONLINE" && a.Status.String != "BUSY" {
+		return nil, errors.New("advisor is not available")
+	}
+
+	// Joining twice returns the existing place in line
+	entry, err := s.repo.GetActiveQueueEntry(ctx, db.GetActiveQueueEntryParams{AdvisorID: aid, UserID: uid})
+	if db.IsNotFound(err) {
+		entry, err = s.repo.JoinAdvisorQueue(ctx, db.JoinAdvisorQueueParams{AdvisorID: aid, UserID: uid})
+		if err == nil {
+			s.notify(aid)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.queueStatus(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queue.JoinQueueResponse{Status: status}, nil
+}
+
+func (s *Service) LeaveQueue(ctx context.Context, req *queue.LeaveQueueRequest) (*queue.LeaveQueueResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eid, err := uuid.Parse(req.EntryId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.LeaveAdvisorQueue(ctx, db.LeaveAdvisorQueueParams{ID: eid, UserID: uid})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("not in queue")
+		}
+		return nil, err
+	}
+
+	// Leaving after the offer was made releases the session created for it
+	if entry.SessionID.Valid {
+		if err := s.repo.CancelSession(ctx, entry.SessionID.UUID); err != nil {
+			log.Printf("Error cancelling queued session %s: %v", entry.SessionID.UUID, err)
+		}
+		s.capacity.Sync(ctx, entry.AdvisorID)
+	}
+	s.notify(entry.AdvisorID)
+
+	return &queue.LeaveQueueResponse{Success: true}, nil
+}
+
+func (s *Service) AcceptQueueOffer(ctx context.Context, req *queue.AcceptQueueOfferRequest) (*queue.AcceptQueueOfferResponse, error) {
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eid, err := uuid.Parse(req.EntryId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accepting the offer starts its session, like an advisor accepting a request
+	session, err := s.repo.AcceptQueueOffer(ctx, db.AcceptQueueOfferParams{ID: eid, UserID: uid})
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, errors.New("no pending offer, it may have expired")
+		}
+		return nil, err
+	}
+	s.notify(session.AdvisorID.UUID)
+
+	return &queue.AcceptQueueOfferResponse{
+		Session: &common.Session{
+			Id:        session.ID.String(),
+			UserId:    session.UserID.String(),
+			AdvisorId: session.AdvisorID.UUID.String(),
+			Type:      common.SessionType(common.SessionType_value[session.Type]),
+			StartedAt: session.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
+			EndedAt:   session.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
+			Status:    common.SessionStatus(common.SessionStatus_value[session.Status.String]),
+			Pricing:   advisor.MapSessionPricing(session),
+		},
+	}, nil
+}
+
+// WatchQueuePosition streams the caller's position until the entry leaves the queue
+func (s *Service) WatchQueuePosition(req *queue.WatchQueuePositionRequest, stream queue.QueueService_WatchQueuePositionServer) error {
+	ctx := stream.Context()
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	eid, err := uuid.Parse(req.EntryId)
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.repo.GetQueueEntryByID(ctx, eid)
+	if err != nil {
+		return err
+	}
+	if entry.UserID != uid {
+		return errors.New("unauthorized")
+	}
+
+	changes := s.subscribe(entry.AdvisorID)
+	defer s.unsubscribe(entry.AdvisorID, changes)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		entry, err = s.repo.GetQueueEntryByID(ctx, eid)
+		if err != nil {
+			return err
+		}
+
+		status, err := s.queueStatus(ctx, entry)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(status); err != nil {
+			return err
+		}
+
+		if entry.Status != EntryWaiting && entry.Status != EntryOffered {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		case <-ticker.C:
+		}
+	}
+}
+
+// WatchAdvisorQueue streams the calling advisor's queue length as it changes
+func (s *Service) WatchAdvisorQueue(req *queue.WatchAdvisorQueueRequest, stream queue.QueueService_WatchAdvisorQueueServer) error {
+	ctx := stream.Context()
+	uid, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.repo.GetAdvisorByUserID(ctx, uid); err != nil {
+		if db.IsNotFound(err) {
+			return errors.New("not an advisor")
+		}
+		return err
+	}
+
+	changes := s.subscribe(uid)
+	defer s.unsubscribe(uid, changes)
+
+	last := int32(-1)
+	for {
+		length, err := s.repo.GetQueueLength(ctx, uid)
+		if err != nil {
+			return err
+		}
+		if length != last {
+			if err := stream.Send(&queue.AdvisorQueueStatus{QueueLength: length}); err != nil {
+				return err
+			}
+			last = length
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+	}
+}
+
+// Run expires unanswered offers and offers a session to the front of each queue
+// whose advisor is free, until ctx is cancelled
+func (s *Service) Run(ctx context.Context) {
+	go s.relay(ctx)
+
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.expireOffers(ctx)
+			s.dispatch(ctx)
+		}
+	}
+}
+
+func (s *Service) expireOffers(ctx context.Context) {
+	expired, err := s.repo.ExpireQueueOffers(ctx)
+	if err != nil {
+		log.Printf("Error expiring queue offers: %v", err)
+		return
+	}
+
+	for _, e := range expired {
+		if e.SessionID.Valid {
+			if err := s.repo.CancelSession(ctx, e.SessionID.UUID); err != nil {
+				log.Printf("Error cancelling queued session %s: %v", e.SessionID.UUID, err)
+			}
+			s.capacity.Sync(ctx, e.AdvisorID)
+		}
+		s.notify(e.AdvisorID)
+	}
+}
+
+func (s *Service) dispatch(ctx context.Context) {
+	heads, err := s.repo.ListQueueHeadsReadyForOffer(ctx)
+	if err != nil {
+		log.Printf("Error listing queue heads: %v", err)
+		return
+	}
+
+	for _, head := range heads {
+		if err := s.offer(ctx, head); err != nil {
+			log.Printf("Error offering session for queue entry %s: %v", head.ID, err)
+		}
+	}
+}
+
+// offer creates a session request for the user at the front of the queue and gives them
+// offerTTL to accept it. Until then the request holds the advisor's capacity, and it
+// expires with the session request timeout like any other.
+func (s *Service) offer(ctx context.Context, entry db.AdvisorQueueEntry) error {
+	sessionType := common.SessionType_CHAT.String()
+	session, err := s.capacity.Reserve(ctx, entry.AdvisorID, sessionType, func(q *db.Queries) (db.Session, error) {
+		return q.CreateSession(ctx, db.CreateSessionParams{
+			UserID:      entry.UserID,
+			AdvisorID:   uuid.NullUUID{UUID: entry.AdvisorID, Valid: true},
+			Type:        sessionType,
+			Status:      common.SessionStatus_REQUESTED.String(),
+			PricingType: advisor.PricingChat,
+		})
+	})
+	if err != nil {
+		// Someone started a session directly since the queue was checked
+		if err == advisor.ErrAtCapacity {
+			return nil
+		}
+		return err
+	}
+
+	expiresAt := time.Now().Add(offerTTL)
+	offered, err := s.repo.OfferQueueEntry(ctx, db.OfferQueueEntryParams{
+		ID:             entry.ID,
+		SessionID:      uuid.NullUUID{UUID: session.ID, Valid: true},
+		OfferExpiresAt: sql.NullTime{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		// The user left or another instance made the offer first
+		if cancelErr := s.repo.CancelSession(ctx, session.ID); cancelErr != nil {
+			log.Printf("Error cancelling queued session %s: %v", session.ID, cancelErr)
+		}
+		s.capacity.Sync(ctx, entry.AdvisorID)
+		if db.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	s.notify(offered.AdvisorID)
+	s.pushOffer(ctx, offered)
+
+	return nil
+}
+
+// pushOffer notifies the user's devices that it is their turn. Failures are logged;
+// the user still sees the offer on the position stream.
+func (s *Service) pushOffer(ctx context.Context, entry db.AdvisorQueueEntry) {
+	if s.notifier == nil {
+		return
+	}
+
+	tokens, err := s.repo.GetUserDeviceTokens(ctx, entry.UserID)
+	if err != nil {
+		log.Printf("Error loading device tokens for %s: %v", entry.UserID, err)
+		return
+	}
+
+	var deviceTokens []string
+	if tokens.FcmToken.Valid {
+		deviceTokens = append(deviceTokens, tokens.FcmToken.String)
+	}
+	if tokens.ApnsToken.Valid {
+		deviceTokens = append(deviceTokens, tokens.ApnsToken.String)
+	}
+	if len(deviceTokens) == 0 {
+		return
+	}
+
+	advisorName := "Your advisor"
+	if u, err := s.repo.GetUserByID(ctx, entry.AdvisorID); err == nil {
+		advisorName = u.DisplayName
+	}
+
+	err = s.notifier.SendQueueOfferNotification(deviceTokens, advisorName, entry.ID.String(), entry.SessionID.UUID.String(), offerTTL)
+	if err != nil {
+		log.Printf("Error sending queue notification to %s: %v", entry.UserID, err)
+	}
+}
+
+// queueStatus computes the entry's position and estimated wait from the
+// advisor's recent session lengths
+func (s *Service) queueStatus(ctx context.Context, entry db.AdvisorQueueEntry) (*queue.QueueStatus, error) {
+	status := &queue.QueueStatus{Entry: mapEntry(entry)}
+	if entry.Status != EntryWaiting && entry.Status != EntryOffered {
+		return status, nil
+	}
+
+	position, err := s.repo.GetQueuePosition(ctx, db.GetQueuePositionParams{
+		AdvisorID: entry.AdvisorID,
+		CreatedAt: entry.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	status.Position = position
+
+	if entry.Status == EntryWaiting {
+		average, err := s.repo.GetAverageSessionSeconds(ctx, uuid.NullUUID{UUID: entry.AdvisorID, Valid: true})
+		if err != nil {
+			return nil, err
+		}
+		if average <= 0 {
+			average = defaultSessionSeconds
+		}
+		status.EstimatedWaitSeconds = int32(average * float64(position))
+	}
+
+	return status, nil
+}
+
+func (s *Service) subscribe(advisorID uuid.UUID) chan struct{} {
+	ch := make(chan struct{}, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.watchers[advisorID] == nil {
+		s.watchers[advisorID] = make(map[chan struct{}]struct{})
+	}
+	s.watchers[advisorID][ch] = struct{}{}
+
+	return ch
+}
+
+func (s *Service) unsubscribe(advisorID uuid.UUID, ch chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.watchers[advisorID], ch)
+	if len(s.watchers[advisorID]) == 0 {
+		delete(s.watchers, advisorID)
+	}
+}
+
+// notify tells every instance that the advisor's queue changed
+func (s *Service) notify(advisorID uuid.UUID) {
+	err := s.backend.Publish(context.Background(), chat.Event{
+		Message: chat.Message{
+			Type:      queueChangedEvent,
+			Timestamp: time.Now(),
+			Data:      map[string]interface{}{"advisor_id": advisorID.String()},
+		},
+	})
+	if err != nil {
+		// Watchers on this instance are still woken; the others catch up on their refresh
+		log.Printf("Error publishing queue change for advisor %s: %v", advisorID, err)
+		s.wake(advisorID)
+	}
+}
+
+// relay wakes the watchers on this instance of queues changed on any instance, until
+// ctx is cancelled. Events for chat sessions are ignored.
+func (s *Service) relay(ctx context.Context) {
+	for event := range s.backend.Subscribe(ctx) {
+		if event.Message.Type != queueChangedEvent {
+			continue
+		}
+		data, _ := event.Message.Data.(map[string]interface{})
+		id, _ := data["advisor_id"].(string)
+		advisorID, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+		s.wake(advisorID)
+	}
+}
+
+// wake wakes every watcher of the advisor's queue on this instance without blocking
+func (s *Service) wake(advisorID uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.watchers[advisorID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return uuid.Nil, errors.New("unauthenticated")
+	}
+	return uuid.Parse(userInfo.ID)
+}
+
+func mapEntry(e db.AdvisorQueueEntry) *queue.QueueEntry {
+	entry := &queue.QueueEntry{
+		Id:        e.ID.String(),
+		AdvisorId: e.AdvisorID.String(),
+		UserId:    e.UserID.String(),
+		Status:    e.Status,
+		CreatedAt: e.CreatedAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if e.SessionID.Valid {
+		entry.SessionId = e.SessionID.UUID.String()
+	}
+	if e.OfferedAt.Valid {
+		entry.OfferedAt = e.OfferedAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	if e.OfferExpiresAt.Valid {
+		entry.OfferExpiresAt = e.OfferExpiresAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+	return entry
+}
@@ -0,0 +1,270 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"loveguru/internal/db"
+	"loveguru/internal/encryption"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// candidatePoolSize bounds how many advisors are scored per request
+	candidatePoolSize = 200
+	// promptHistorySize is how many recent AI prompts are scanned for interests
+	promptHistorySize = 50
+	// maxReasons caps the explanations returned per advisor
+	maxReasons = 3
+
+	// Bayesian prior for the popularity score so a single 5-star rating does not
+	// outrank a long track record
+	priorRating = 3.5
+	priorWeight = 5.0
+)
+
+// Signal weights, relative to a popularity score in [0, 1]
+const (
+	weightLanguage      = 1.0
+	weightInterest      = 1.5
+	weightRatedHighly   = 2.0
+	weightPastSession   = 0.5
+	weightRatedPoorly   = -3.0
+	weightCollaborative = 0.5
+	weightOnline        = 0.2
+)
+
+// Recommendation is a scored advisor with the reasons it was recommended
+type Recommendation struct {
+	Advisor db.GetRecommendedAdvisorsRow
+	Score   float64
+	Reasons []string
+}
+
+// Engine ranks advisors for a user. Users without any history get a popularity ranking.
+type Engine struct {
+	repo    *db.Queries
+	keyring *encryption.Keyring
+}
+
+func NewEngine(repo *db.Queries, keyring *encryption.Keyring) *Engine {
+	return &Engine{repo: repo, keyring: keyring}
+}
+
+// profile holds what is known about the user's preferences
+type profile struct {
+	languages     map[string]struct{}
+	interests     map[string]struct{}
+	history       map[uuid.UUID]db.GetUserAdvisorHistoryRow
+	collaborative map[uuid.UUID]int32
+}
+
+func (p *profile) empty() bool {
+	return len(p.languages) == 0 && len(p.interests) == 0 && len(p.history) == 0 && len(p.collaborative) == 0
+}
+
+type reason struct {
+	text   string
+	weight float64
+}
+
+// Recommend returns up to limit advisors for the user, best first. languages are
+// the user's preferred languages as reported by the client and may be empty.
+func (e *Engine) Recommend(ctx context.Context, userID uuid.UUID, languages []string, limit int) ([]Recommendation, error) {
+	candidates, err := e.repo.GetRecommendedAdvisors(ctx, db.GetRecommendedAdvisorsParams{
+		UserID:   userID,
+		RowLimit: candidatePoolSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := e.buildProfile(ctx, userID, languages, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]Recommendation, 0, len(candidates))
+	for _, c := range candidates {
+		recs = append(recs, score(c, p))
+	}
+
+	sort.SliceStable(recs, func(i, j int) bool {
+		return recs[i].Score > recs[j].Score
+	})
+
+	if limit > 0 && len(recs) > limit {
+		recs = recs[:limit]
+	}
+
+	return recs, nil
+}
+
+func (e *Engine) buildProfile(ctx context.Context, userID uuid.UUID, languages []string, candidates []db.GetRecommendedAdvisorsRow) (*profile, error) {
+	p := &profile{
+		languages:     make(map[string]struct{}),
+		interests:     make(map[string]struct{}),
+		history:       make(map[uuid.UUID]db.GetUserAdvisorHistoryRow),
+		collaborative: make(map[uuid.UUID]int32),
+	}
+
+	for _, l := range languages {
+		if l = normalize(l); l != "" {
+			p.languages[l] = struct{}{}
+		}
+	}
+
+	history, err := e.repo.GetUserAdvisorHistory(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range history {
+		p.history[h.AdvisorID] = h
+	}
+
+	collaborative, err := e.repo.GetCollaborativeAdvisorScores(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range collaborative {
+		p.collaborative[c.AdvisorID] = c.SimilarUsers
+	}
+
+	rows, err := e.repo.GetRecentAIPrompts(ctx, db.GetRecentAIPromptsParams{
+		UserID: userID,
+		Limit:  promptHistorySize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	prompts := make([]string, 0, len(rows))
+	for _, row := range rows {
+		prompt, err := e.keyring.Open(ctx, row.Prompt, row.ContentKeyID)
+		if err != nil {
+			return nil, err
+		}
+		prompts = append(prompts, prompt)
+	}
+
+	// Interests are the specializations the user talked to the AI about, plus those
+	// of advisors they rated highly
+	for _, c := range candidates {
+		liked := false
+		if h, ok := p.history[c.UserID]; ok && h.RatingCount > 0 && h.AverageGivenRating >= 4 {
+			liked = true
+		}
+		for _, spec := range c.Specializations {
+			key := normalize(spec)
+			if key == "" {
+				continue
+			}
+			if liked || mentioned(prompts, key) {
+				p.interests[key] = struct{}{}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// score combines popularity with the user's signals for one advisor
+func score(c db.GetRecommendedAdvisorsRow, p *profile) Recommendation {
+	var reasons []reason
+
+	popularity := (priorRating*priorWeight + c.AverageRating*float64(c.RatingCount)) / (priorWeight + float64(c.RatingCount)) / 5
+	total := popularity
+
+	topRated := c.RatingCount >= 3 && c.AverageRating >= 4.5
+
+	for _, l := range c.Languages {
+		if _, ok := p.languages[normalize(l)]; ok {
+			total += weightLanguage
+			reasons = append(reasons, reason{fmt.Sprintf("speaks %s", l), weightLanguage})
+			break
+		}
+	}
+
+	matched := 0
+	for _, spec := range c.Specializations {
+		if _, ok := p.interests[normalize(spec)]; !ok {
+			continue
+		}
+		matched++
+		if topRated {
+			reasons = append(reasons, reason{fmt.Sprintf("rated highly for %s", spec), weightInterest})
+		} else {
+			reasons = append(reasons, reason{fmt.Sprintf("specializes in %s", spec), weightInterest})
+		}
+	}
+	// Diminishing returns so advisors listing every specialization do not dominate
+	total += weightInterest * math.Log1p(float64(matched)) / math.Ln2
+
+	if h, ok := p.history[c.UserID]; ok {
+		switch {
+		case h.RatingCount > 0 && h.AverageGivenRating >= 4:
+			total += weightRatedHighly
+			reasons = append(reasons, reason{"you rated them highly before", weightRatedHighly})
+		case h.RatingCount > 0 && h.AverageGivenRating <= 2:
+			total += weightRatedPoorly
+		default:
+			w := weightPastSession * math.Min(float64(h.SessionCount), 3)
+			total += w
+			reasons = append(reasons, reason{"you have talked before", w})
+		}
+	}
+
+	if n, ok := p.collaborative[c.UserID]; ok {
+		w := weightCollaborative * math.Min(float64(n), 4)
+		total += w
+		reasons = append(reasons, reason{"liked by users with similar taste", w})
+	}
+
+	if c.Status.String == "ONLINE" {
+		total += weightOnline
+	}
+
+	// Popularity explains the ranking when nothing personal applies, e.g. for new users
+	if len(reasons) == 0 || p.empty() {
+		switch {
+		case topRated:
+			reasons = append(reasons, reason{"top rated", popularity})
+		case c.RatingCount > 0:
+			reasons = append(reasons, reason{"popular with other users", popularity})
+		}
+	}
+
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return reasons[i].weight > reasons[j].weight
+	})
+	if len(reasons) > maxReasons {
+		reasons = reasons[:maxReasons]
+	}
+
+	rec := Recommendation{Advisor: c, Score: total}
+	for _, r := range reasons {
+		rec.Reasons = append(rec.Reasons, r.text)
+	}
+	return rec
+}
+
+// mentioned reports whether any prompt mentions the term, ignoring case and a plural "s"
+func mentioned(prompts []string, term string) bool {
+	stem := strings.TrimSuffix(term, "s")
+	if len(stem) < 3 {
+		return false
+	}
+	for _, prompt := range prompts {
+		if strings.Contains(strings.ToLower(prompt), stem) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
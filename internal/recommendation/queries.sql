@@ -1,16 +1,17 @@
 -- name: GetRecommendedAdvisors :many
-SELECT a.*, u.*, 
-       COALESCE(AVG(r.rating), 0) as average_rating
+-- Candidate pool for recommendations, most popular first, with the rating signals used for scoring
+SELECT a.*, u.*,
+       COALESCE(AVG(r.rating), 0)::float8 AS average_rating,
+       COUNT(r.id)::INTEGER AS rating_count
 FROM advisors a
 JOIN users u ON a.user_id = u.id
 LEFT JOIN ratings r ON a.user_id = r.advisor_id
-WHERE a.status = 'ONLINE' 
+WHERE a.status IN ('ONLINE', 'BUSY')
 AND a.is_verified = true
-AND ($1::text[] IS NULL OR a.specializations && $1::text[])
-AND ($2::text[] IS NULL OR a.languages && $2::text[])
+AND a.user_id <> sqlc.arg(user_id)
 GROUP BY a.id, u.id
-ORDER BY average_rating DESC, a.experience_years DESC
-LIMIT $3 OFFSET $4;
+ORDER BY average_rating DESC, rating_count DESC, a.experience_years DESC NULLS LAST
+LIMIT sqlc.arg(row_limit);
 
 -- name: GetUserSessionHistory :many
 SELECT s.*, a.user_id as advisor_user_id
@@ -25,4 +26,30 @@ SELECT r.*, u.display_name as reviewer_name
 FROM ratings r
 JOIN users u ON r.user_id = u.id
 WHERE r.advisor_id = $1
-ORDER BY r.created_at DESC;
\ No newline at end of file
+ORDER BY r.created_at DESC;
+
+-- name: GetUserAdvisorHistory :many
+-- Sessions the user had with each advisor and the ratings they gave
+SELECT s.advisor_id::uuid AS advisor_id,
+       COUNT(DISTINCT s.id)::INTEGER AS session_count,
+       COUNT(r.id)::INTEGER AS rating_count,
+       COALESCE(AVG(r.rating), 0)::float8 AS average_given_rating
+FROM sessions s
+LEFT JOIN ratings r ON r.session_id = s.id AND r.user_id = s.user_id
+WHERE s.user_id = $1 AND s.advisor_id IS NOT NULL AND s.status = 'ENDED'
+GROUP BY s.advisor_id;
+
+-- name: GetRecentAIPrompts :many
+SELECT prompt, content_key_id FROM ai_interactions
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: GetCollaborativeAdvisorScores :many
+-- Advisors rated 4+ by users who also rated 4+ an advisor the given user rated 4+
+SELECT r2.advisor_id, COUNT(DISTINCT r2.user_id)::INTEGER AS similar_users
+FROM ratings mine
+JOIN ratings r1 ON r1.advisor_id = mine.advisor_id AND r1.user_id <> mine.user_id AND r1.rating >= 4
+JOIN ratings r2 ON r2.user_id = r1.user_id AND r2.advisor_id <> mine.advisor_id AND r2.rating >= 4
+WHERE mine.user_id = $1 AND mine.rating >= 4
+GROUP BY r2.advisor_id;
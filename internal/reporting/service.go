@@ -18,7 +18,7 @@ type Service struct {
 
 type Report struct {
 	ID                string
-	ReportedBy        string
+	ReportedBy        sql.NullString // empty for flags raised by chat moderation
 	ReportedUserID    sql.NullString
 	ReportedAdvisorID sql.NullString
 	SessionID         sql.NullString
@@ -73,7 +73,7 @@ func (s *Service) ReportUser(ctx context.Context, req *ReportRequest) error {
 	}
 
 	_, err = s.repo.CreateAdminFlag(ctx, db.CreateAdminFlagParams{
-		ReportedBy:        reporterID,
+		ReportedBy:        uuid.NullUUID{UUID: reporterID, Valid: true},
 		ReportedUserID:    reportedUserID,
 		ReportedAdvisorID: reportedAdvisorID,
 		SessionID:         sessionID,
@@ -112,7 +112,7 @@ func (s *Service) GetUserReports(ctx context.Context, userID string) ([]Report,
 	for _, report := range reports {
 		reportList = append(reportList, Report{
 			ID:                report.ID.String(),
-			ReportedBy:        report.ReportedBy.String(),
+			ReportedBy:        sql.NullString{String: report.ReportedBy.UUID.String(), Valid: report.ReportedBy.Valid},
 			ReportedUserID:    sql.NullString{String: report.ReportedUserID.UUID.String(), Valid: report.ReportedUserID.Valid},
 			ReportedAdvisorID: sql.NullString{String: report.ReportedAdvisorID.UUID.String(), Valid: report.ReportedAdvisorID.Valid},
 			SessionID:         sql.NullString{String: report.SessionID.UUID.String(), Valid: report.SessionID.Valid},
@@ -135,7 +135,7 @@ func (s *Service) GetReportsByStatus(ctx context.Context, status string) ([]Repo
 	for _, report := range reports {
 		reportList = append(reportList, Report{
 			ID:                report.ID.String(),
-			ReportedBy:        report.ReportedBy.String(),
+			ReportedBy:        sql.NullString{String: report.ReportedBy.UUID.String(), Valid: report.ReportedBy.Valid},
 			ReportedUserID:    sql.NullString{String: report.ReportedUserID.UUID.String(), Valid: report.ReportedUserID.Valid},
 			ReportedAdvisorID: sql.NullString{String: report.ReportedAdvisorID.UUID.String(), Valid: report.ReportedAdvisorID.Valid},
 			SessionID:         sql.NullString{String: report.SessionID.UUID.String(), Valid: report.SessionID.Valid},
@@ -0,0 +1,18 @@
+-- name: ListTranscriptMessages :many
+SELECT * FROM chat_messages WHERE session_id = $1 ORDER BY created_at, id;
+
+-- name: ListTranscriptRevisions :many
+-- Edit and delete history of the session's messages, for admin exports
+SELECT r.* FROM chat_message_revisions r
+JOIN chat_messages m ON m.id = r.message_id
+WHERE m.session_id = $1
+ORDER BY r.created_at;
+
+-- name: ListTranscriptAttachments :many
+SELECT * FROM chat_attachments WHERE session_id = $1 AND message_id IS NOT NULL;
+
+-- name: ListSessionCallLogs :many
+SELECT * FROM call_logs WHERE session_id = $1 ORDER BY started_at NULLS LAST;
+
+-- name: ListSessionRatings :many
+SELECT * FROM ratings WHERE session_id = $1 ORDER BY created_at;
@@ -0,0 +1,203 @@
+package transcript
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Format is how a transcript is rendered
+type Format string
+
+const (
+	FormatJSON Format = "JSON"
+	FormatText Format = "TEXT"
+	FormatHTML Format = "HTML" // a standalone page meant for printing
+)
+
+// Document is a rendered transcript
+type Document struct {
+	Content  []byte
+	MimeType string
+	Filename string
+}
+
+// Render renders the transcript in the format
+func Render(t *Transcript, format Format) (Document, error) {
+	name := fmt.Sprintf("loveguru-session-%s", t.SessionID)
+
+	var buf bytes.Buffer
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(t); err != nil {
+			return Document{}, err
+		}
+		return Document{Content: buf.Bytes(), MimeType: "application/json", Filename: name + ".json"}, nil
+
+	case FormatText:
+		if err := textTranscript.Execute(&buf, t); err != nil {
+			return Document{}, err
+		}
+		return Document{Content: buf.Bytes(), MimeType: "text/plain; charset=utf-8", Filename: name + ".txt"}, nil
+
+	case FormatHTML:
+		if err := htmlTranscript.Execute(&buf, t); err != nil {
+			return Document{}, err
+		}
+		return Document{Content: buf.Bytes(), MimeType: "text/html; charset=utf-8", Filename: name + ".html"}, nil
+
+	default:
+		return Document{}, fmt.Errorf("unsupported transcript format %q", format)
+	}
+}
+
+var funcs = map[string]any{
+	"when":       formatTime,
+	"attachment": describeAttachment,
+	"duration":   formatDuration,
+	"stars": func(n int32) string {
+		return strings.Repeat("★", int(n)) + strings.Repeat("☆", 5-int(n))
+	},
+}
+
+func formatTime(t any) string {
+	switch v := t.(type) {
+	case time.Time:
+		return v.Format("2006-01-02 15:04 UTC")
+	case *time.Time:
+		if v != nil {
+			return v.Format("2006-01-02 15:04 UTC")
+		}
+	}
+	return "-"
+}
+
+func describeAttachment(a *Attachment) string {
+	kind := "Photo"
+	if a.Kind == "VOICE" {
+		kind = "Voice note"
+		if a.DurationMs > 0 {
+			kind += ", " + formatDuration(a.DurationMs/1000)
+		}
+	}
+	return fmt.Sprintf("[%s: %s, %d KB, id %s]", kind, a.MimeType, (a.SizeBytes+1023)/1024, a.ID)
+}
+
+func formatDuration(seconds int32) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+var textTranscript = template.Must(template.New("text").Funcs(funcs).Parse(`LoveGuru session transcript
+Session:  {{.SessionID}} ({{.Type}}, {{.Status}})
+User:     {{.User.Name}}
+{{- with .Advisor}}
+Advisor:  {{.Name}}{{end}}
+Started:  {{when .StartedAt}}
+Ended:    {{when .EndedAt}}
+Exported: {{when .ExportedAt}}
+
+Messages
+{{- range .Messages}}
+[{{when .SentAt}}] {{.SenderName}}:
+{{- if .DeletedAt}} (deleted {{when .DeletedAt}})
+{{- else}}{{with .Attachment}} {{attachment .}}{{end}}{{with .Content}} {{.}}{{end}}{{with .EditedAt}} (edited {{when .}}){{end}}{{end}}
+{{- range .Revisions}}
+    {{.Action}} {{when .At}}, before: {{.Content}}
+{{- end}}
+{{- else}}
+No messages.
+{{- end}}
+{{- with .Calls}}
+
+Calls
+{{- range .}}
+{{when .StartedAt}} to {{when .EndedAt}}, {{duration .DurationSeconds}}{{with .Status}}, {{.}}{{end}}
+{{- end}}
+{{- end}}
+{{- with .Ratings}}
+
+Rating
+{{- range .}}
+{{stars .Rating}} {{.Rating}}/5{{with .Review}} "{{.}}"{{end}}
+{{- end}}
+{{- end}}
+`))
+
+var htmlTranscript = htmltemplate.Must(htmltemplate.New("html").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>LoveGuru session transcript</title>
+<style>
+  body { font-family: Georgia, serif; max-width: 46rem; margin: 2rem auto; color: #222; }
+  h1 { font-size: 1.4rem; }
+  h2 { font-size: 1.1rem; margin-top: 2rem; border-bottom: 1px solid #ccc; }
+  dl { display: grid; grid-template-columns: 7rem 1fr; gap: .2rem; }
+  dt { color: #666; }
+  .message { margin: .6rem 0; page-break-inside: avoid; }
+  .meta { color: #666; font-size: .85rem; }
+  .content { white-space: pre-wrap; }
+  .deleted { color: #999; font-style: italic; }
+  .revision { margin-left: 1.5rem; color: #a33; font-size: .9rem; white-space: pre-wrap; }
+  @media print { body { margin: 0; } }
+</style>
+</head>
+<body>
+<h1>LoveGuru session transcript</h1>
+<dl>
+  <dt>Session</dt><dd>{{.SessionID}} ({{.Type}}, {{.Status}})</dd>
+  <dt>User</dt><dd>{{.User.Name}}</dd>
+  {{- with .Advisor}}
+  <dt>Advisor</dt><dd>{{.Name}}</dd>
+  {{- end}}
+  <dt>Started</dt><dd>{{when .StartedAt}}</dd>
+  <dt>Ended</dt><dd>{{when .EndedAt}}</dd>
+  <dt>Exported</dt><dd>{{when .ExportedAt}}</dd>
+</dl>
+
+<h2>Messages</h2>
+{{- range .Messages}}
+<div class="message">
+  <div class="meta">{{when .SentAt}} · <strong>{{.SenderName}}</strong>{{with .EditedAt}} · edited {{when .}}{{end}}</div>
+  {{- if .DeletedAt}}
+  <div class="deleted">Deleted {{when .DeletedAt}}</div>
+  {{- else}}
+  {{- with .Attachment}}
+  <div class="meta">{{attachment .}}</div>
+  {{- end}}
+  {{- with .Content}}
+  <div class="content">{{.}}</div>
+  {{- end}}
+  {{- end}}
+  {{- range .Revisions}}
+  <div class="revision">{{.Action}} {{when .At}}, before: {{.Content}}</div>
+  {{- end}}
+</div>
+{{- else}}
+<p>No messages.</p>
+{{- end}}
+{{- with .Calls}}
+
+<h2>Calls</h2>
+<ul>
+{{- range .}}
+  <li>{{when .StartedAt}} to {{when .EndedAt}}, {{duration .DurationSeconds}}{{with .Status}}, {{.}}{{end}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- with .Ratings}}
+
+<h2>Rating</h2>
+{{- range .}}
+<p>{{stars .Rating}} {{.Rating}}/5{{with .Review}}<br>“{{.}}”{{end}}</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,214 @@
+package transcript
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"loveguru/internal/db"
+	"loveguru/internal/encryption"
+
+	"github.com/google/uuid"
+)
+
+// Transcript is everything recorded about a session, in the order it happened
+type Transcript struct {
+	SessionID  string       `json:"session_id"`
+	Type       string       `json:"type"`
+	Status     string       `json:"status"`
+	StartedAt  *time.Time   `json:"started_at,omitempty"`
+	EndedAt    *time.Time   `json:"ended_at,omitempty"`
+	User       Participant  `json:"user"`
+	Advisor    *Participant `json:"advisor,omitempty"`
+	Messages   []Message    `json:"messages"`
+	Calls      []Call       `json:"calls,omitempty"`
+	Ratings    []Rating     `json:"ratings,omitempty"`
+	ExportedAt time.Time    `json:"exported_at"`
+}
+
+type Participant struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Message struct {
+	ID         string      `json:"id"`
+	SenderID   string      `json:"sender_id"`
+	SenderType string      `json:"sender_type"`
+	SenderName string      `json:"sender_name"`
+	Kind       string      `json:"kind"`
+	Content    string      `json:"content"` // empty for deleted messages
+	SentAt     time.Time   `json:"sent_at"`
+	EditedAt   *time.Time  `json:"edited_at,omitempty"`
+	DeletedAt  *time.Time  `json:"deleted_at,omitempty"`
+	Attachment *Attachment `json:"attachment,omitempty"`
+	Revisions  []Revision  `json:"revisions,omitempty"` // admin exports only
+}
+
+// Attachment references a file sent in the chat; the file itself is not exported
+type Attachment struct {
+	ID         string `json:"id"`
+	Kind       string `json:"kind"`
+	MimeType   string `json:"mime_type"`
+	SizeBytes  int64  `json:"size_bytes"`
+	DurationMs int32  `json:"duration_ms,omitempty"`
+}
+
+// Revision is the content a message had before it was edited or deleted
+type Revision struct {
+	Action  string    `json:"action"`
+	Content string    `json:"content"`
+	At      time.Time `json:"at"`
+}
+
+type Call struct {
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	DurationSeconds int32      `json:"duration_seconds"`
+	Status          string     `json:"status,omitempty"`
+}
+
+type Rating struct {
+	Rating    int32     `json:"rating"`
+	Review    string    `json:"review,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Exporter assembles session transcripts. Callers check who may read the session.
+type Exporter struct {
+	repo    *db.Queries
+	keyring *encryption.Keyring
+}
+
+func NewExporter(repo *db.Queries, keyring *encryption.Keyring) *Exporter {
+	return &Exporter{repo: repo, keyring: keyring}
+}
+
+// Build assembles the transcript of a session. With revisions, the content messages had
+// before they were edited or deleted is included, as evidence for admins.
+func (e *Exporter) Build(ctx context.Context, session db.Session, revisions bool) (*Transcript, error) {
+	t := &Transcript{
+		SessionID:  session.ID.String(),
+		Type:       session.Type,
+		Status:     session.Status.String,
+		StartedAt:  timePtr(session.StartedAt),
+		EndedAt:    timePtr(session.EndedAt),
+		ExportedAt: time.Now().UTC(),
+	}
+
+	names := make(map[uuid.UUID]string)
+	user, err := e.repo.GetUserByID(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	names[user.ID] = user.DisplayName
+	t.User = Participant{ID: user.ID.String(), Name: user.DisplayName}
+
+	if session.AdvisorID.Valid {
+		advisor, err := e.repo.GetUserByID(ctx, session.AdvisorID.UUID)
+		if err != nil {
+			return nil, err
+		}
+		names[advisor.ID] = advisor.DisplayName
+		t.Advisor = &Participant{ID: advisor.ID.String(), Name: advisor.DisplayName}
+	}
+
+	messages, err := e.repo.ListTranscriptMessages(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := e.repo.ListTranscriptAttachments(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	byMessage := make(map[uuid.UUID]*Attachment, len(attachments))
+	for _, a := range attachments {
+		byMessage[a.MessageID.UUID] = &Attachment{
+			ID:         a.ID.String(),
+			Kind:       a.Kind,
+			MimeType:   a.MimeType,
+			SizeBytes:  a.SizeBytes,
+			DurationMs: a.DurationMs.Int32,
+		}
+	}
+
+	history := make(map[uuid.UUID][]Revision)
+	if revisions {
+		revs, err := e.repo.ListTranscriptRevisions(ctx, session.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range revs {
+			content, err := e.keyring.Open(ctx, r.Content, r.ContentKeyID)
+			if err != nil {
+				return nil, err
+			}
+			history[r.MessageID] = append(history[r.MessageID], Revision{
+				Action:  r.Action,
+				Content: content,
+				At:      r.CreatedAt.Time.UTC(),
+			})
+		}
+	}
+
+	t.Messages = make([]Message, 0, len(messages))
+	for _, m := range messages {
+		name, ok := names[m.SenderID]
+		if !ok {
+			name = m.SenderType
+		}
+		content, err := e.keyring.Open(ctx, m.Content, m.ContentKeyID)
+		if err != nil {
+			return nil, err
+		}
+		t.Messages = append(t.Messages, Message{
+			ID:         m.ID.String(),
+			SenderID:   m.SenderID.String(),
+			SenderType: m.SenderType,
+			SenderName: name,
+			Kind:       m.Kind,
+			Content:    content,
+			SentAt:     m.CreatedAt.Time.UTC(),
+			EditedAt:   timePtr(m.EditedAt),
+			DeletedAt:  timePtr(m.DeletedAt),
+			Attachment: byMessage[m.ID],
+			Revisions:  history[m.ID],
+		})
+	}
+
+	calls, err := e.repo.ListSessionCallLogs(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range calls {
+		t.Calls = append(t.Calls, Call{
+			StartedAt:       timePtr(c.StartedAt),
+			EndedAt:         timePtr(c.EndedAt),
+			DurationSeconds: c.DurationSeconds.Int32,
+			Status:          c.Status.String,
+		})
+	}
+
+	ratings, err := e.repo.ListSessionRatings(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ratings {
+		t.Ratings = append(t.Ratings, Rating{
+			Rating:    r.Rating,
+			Review:    r.ReviewText.String,
+			CreatedAt: r.CreatedAt.Time.UTC(),
+		})
+	}
+
+	return t, nil
+}
+
+func timePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	utc := t.Time.UTC()
+	return &utc
+}
@@ -26,6 +26,10 @@ func (h *Handler) GetSessions(ctx context.Context, req *user.GetSessionsRequest)
 	return h.service.GetSessions(ctx, req)
 }
 
+func (h *Handler) GetBalance(ctx context.Context, req *user.GetBalanceRequest) (*user.GetBalanceResponse, error) {
+	return h.service.GetBalance(ctx, req)
+}
+
 // TODO: Implement these methods once protobuf types are generated
 /*
 func (h *Handler) CreateAnonymousProfile(ctx context.Context, req *user.CreateAnonymousProfileRequest) (*user.CreateAnonymousProfileResponse, error) {
@@ -24,4 +24,9 @@ FROM users u
 JOIN sessions s ON (s.user_id = u.id OR s.advisor_id = u.id)
 WHERE s.id = $1
   AND u.id != $2
-  AND (u.fcm_token IS NOT NULL OR u.apns_token IS NOT NULL);
\ No newline at end of file
+  AND (u.fcm_token IS NOT NULL OR u.apns_token IS NOT NULL);
+-- name: GetUserBalance :one
+SELECT COALESCE((SELECT balance FROM user_balances WHERE user_id = $1), 0)::text;
+
+-- name: ListBalanceTransactions :many
+SELECT * FROM balance_transactions WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2;
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"time"
 
+	"loveguru/internal/advisor"
 	"loveguru/internal/db"
+	"loveguru/internal/earnings"
 	"loveguru/internal/grpc/middleware"
 	"loveguru/proto/common"
 	"loveguru/proto/user"
@@ -96,13 +98,16 @@ func (s *Service) GetSessions(ctx context.Context, req *user.GetSessionsRequest)
 	var sess []*common.Session
 	for _, s := range sessions {
 		sess = append(sess, &common.Session{
-			Id:        s.ID.String(),
-			UserId:    s.UserID.String(),
-			AdvisorId: s.AdvisorID.UUID.String(),
-			Type:      common.SessionType(common.SessionType_value[s.Type]),
-			StartedAt: s.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
-			EndedAt:   s.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
-			Status:    common.SessionStatus(common.SessionStatus_value[s.Status.String]),
+			Id:            s.ID.String(),
+			UserId:        s.UserID.String(),
+			AdvisorId:     s.AdvisorID.UUID.String(),
+			Type:          common.SessionType(common.SessionType_value[s.Type]),
+			StartedAt:     s.StartedAt.Time.Format("2006-01-02T15:04:05Z"),
+			EndedAt:       s.EndedAt.Time.Format("2006-01-02T15:04:05Z"),
+			Status:        common.SessionStatus(common.SessionStatus_value[s.Status.String]),
+			Pricing:       advisor.MapSessionPricing(s),
+			BilledMinutes: s.BilledMinutes,
+			BilledAmount:  earnings.ParseAmount(s.BilledAmount),
 		})
 	}
 
@@ -111,6 +116,43 @@ func (s *Service) GetSessions(ctx context.Context, req *user.GetSessionsRequest)
 	}, nil
 }
 
+// GetBalance returns what the caller has left to spend on paid chats and how it changed
+func (s *Service) GetBalance(ctx context.Context, req *user.GetBalanceRequest) (*user.GetBalanceResponse, error) {
+	userInfo, ok := middleware.GetUserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("unauthenticated")
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	balance, err := s.repo.GetUserBalance(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.repo.ListBalanceTransactions(ctx, db.ListBalanceTransactionsParams{UserID: userID, Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &user.GetBalanceResponse{Balance: earnings.ParseAmount(balance)}
+	for _, t := range transactions {
+		resp.Transactions = append(resp.Transactions, earnings.MapBalanceTransaction(t))
+	}
+	return resp, nil
+}
+
 // TODO: Implement these methods once protobuf types are generated
 /*
 func (s *Service) CreateAnonymousProfile(ctx context.Context, req *user.CreateAnonymousProfileRequest) (*user.CreateAnonymousProfileResponse, error) {
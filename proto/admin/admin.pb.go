@@ -32,6 +32,8 @@ type AdminFlag struct {
 	SessionId         string                 `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	CreatedAt         string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Source            string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`                         // USER for reports, MODERATION for messages flagged automatically
+	MessageId         string                 `protobuf:"bytes,10,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // the flagged message, for MODERATION flags
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -122,6 +124,20 @@ func (x *AdminFlag) GetStatus() string {
 	return ""
 }
 
+func (x *AdminFlag) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AdminFlag) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
 type GetPendingAdvisorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -490,47 +506,2748 @@ func (x *BlockUserResponse) GetSuccess() bool {
 	return false
 }
 
-var File_proto_admin_proto protoreflect.FileDescriptor
+type GetAdvisorApplicationsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        common.ApplicationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=loveguru.common.ApplicationStatus" json:"status,omitempty"`
+	Limit         int32                    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x11proto/admin.proto\x12\x0eloveguru.admin\x1a\x12proto/common.proto\"\x84\x02\n" +
-	"\tAdminFlag\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
-	"\vreported_by\x18\x02 \x01(\tR\n" +
-	"reportedBy\x12(\n" +
-	"\x10reported_user_id\x18\x03 \x01(\tR\x0ereportedUserId\x12.\n" +
-	"\x13reported_advisor_id\x18\x04 \x01(\tR\x11reportedAdvisorId\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x06 \x01(\tR\tsessionId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06status\"I\n" +
-	"\x19GetPendingAdvisorsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"R\n" +
-	"\x1aGetPendingAdvisorsResponse\x124\n" +
-	"\badvisors\x18\x01 \x03(\v2\x18.loveguru.common.AdvisorR\badvisors\"6\n" +
-	"\x15ApproveAdvisorRequest\x12\x1d\n" +
-	"\n" +
-	"advisor_id\x18\x01 \x01(\tR\tadvisorId\"2\n" +
-	"\x16ApproveAdvisorResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
-	"\x0fGetFlagsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"C\n" +
-	"\x10GetFlagsResponse\x12/\n" +
-	"\x05flags\x18\x01 \x03(\v2\x19.loveguru.admin.AdminFlagR\x05flags\"+\n" +
-	"\x10BlockUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"-\n" +
-	"\x11BlockUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xfd\x02\n" +
+func (x *GetAdvisorApplicationsRequest) Reset() {
+	*x = GetAdvisorApplicationsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdvisorApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvisorApplicationsRequest) ProtoMessage() {}
+
+func (x *GetAdvisorApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvisorApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdvisorApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAdvisorApplicationsRequest) GetStatus() common.ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return common.ApplicationStatus(0)
+}
+
+func (x *GetAdvisorApplicationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAdvisorApplicationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetAdvisorApplicationsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Applications  []*common.AdvisorApplication `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdvisorApplicationsResponse) Reset() {
+	*x = GetAdvisorApplicationsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdvisorApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvisorApplicationsResponse) ProtoMessage() {}
+
+func (x *GetAdvisorApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvisorApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdvisorApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAdvisorApplicationsResponse) GetApplications() []*common.AdvisorApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type ReviewAdvisorApplicationRequest struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	ApplicationId   string                   `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Status          common.ApplicationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=loveguru.common.ApplicationStatus" json:"status,omitempty"` // UNDER_REVIEW, NEEDS_CHANGES, APPROVED or REJECTED
+	ReviewerNotes   string                   `protobuf:"bytes,3,opt,name=reviewer_notes,json=reviewerNotes,proto3" json:"reviewer_notes,omitempty"`
+	RejectionReason string                   `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReviewAdvisorApplicationRequest) Reset() {
+	*x = ReviewAdvisorApplicationRequest{}
+	mi := &file_proto_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAdvisorApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAdvisorApplicationRequest) ProtoMessage() {}
+
+func (x *ReviewAdvisorApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAdvisorApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewAdvisorApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReviewAdvisorApplicationRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ReviewAdvisorApplicationRequest) GetStatus() common.ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return common.ApplicationStatus(0)
+}
+
+func (x *ReviewAdvisorApplicationRequest) GetReviewerNotes() string {
+	if x != nil {
+		return x.ReviewerNotes
+	}
+	return ""
+}
+
+func (x *ReviewAdvisorApplicationRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type ReviewAdvisorApplicationResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Application   *common.AdvisorApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAdvisorApplicationResponse) Reset() {
+	*x = ReviewAdvisorApplicationResponse{}
+	mi := &file_proto_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAdvisorApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAdvisorApplicationResponse) ProtoMessage() {}
+
+func (x *ReviewAdvisorApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAdvisorApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewAdvisorApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewAdvisorApplicationResponse) GetApplication() *common.AdvisorApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type GetCredentialDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCredentialDocumentRequest) Reset() {
+	*x = GetCredentialDocumentRequest{}
+	mi := &file_proto_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialDocumentRequest) ProtoMessage() {}
+
+func (x *GetCredentialDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCredentialDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetCredentialDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCredentialDocumentRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+type GetCredentialDocumentResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Document      *common.CredentialDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Content       []byte                     `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCredentialDocumentResponse) Reset() {
+	*x = GetCredentialDocumentResponse{}
+	mi := &file_proto_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialDocumentResponse) ProtoMessage() {}
+
+func (x *GetCredentialDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCredentialDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetCredentialDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCredentialDocumentResponse) GetDocument() *common.CredentialDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *GetCredentialDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type CreatePayoutBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdvisorId     string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	PeriodEnd     string                 `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"` // RFC3339, defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayoutBatchRequest) Reset() {
+	*x = CreatePayoutBatchRequest{}
+	mi := &file_proto_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutBatchRequest) ProtoMessage() {}
+
+func (x *CreatePayoutBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePayoutBatchRequest) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *CreatePayoutBatchRequest) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+type CreatePayoutBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *common.PayoutBatch    `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayoutBatchResponse) Reset() {
+	*x = CreatePayoutBatchResponse{}
+	mi := &file_proto_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutBatchResponse) ProtoMessage() {}
+
+func (x *CreatePayoutBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreatePayoutBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreatePayoutBatchResponse) GetBatch() *common.PayoutBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type MarkPayoutBatchPaidRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BatchId          string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	PaymentReference string                 `protobuf:"bytes,2,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MarkPayoutBatchPaidRequest) Reset() {
+	*x = MarkPayoutBatchPaidRequest{}
+	mi := &file_proto_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPayoutBatchPaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPayoutBatchPaidRequest) ProtoMessage() {}
+
+func (x *MarkPayoutBatchPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPayoutBatchPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkPayoutBatchPaidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarkPayoutBatchPaidRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *MarkPayoutBatchPaidRequest) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+type MarkPayoutBatchPaidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *common.PayoutBatch    `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPayoutBatchPaidResponse) Reset() {
+	*x = MarkPayoutBatchPaidResponse{}
+	mi := &file_proto_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPayoutBatchPaidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPayoutBatchPaidResponse) ProtoMessage() {}
+
+func (x *MarkPayoutBatchPaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPayoutBatchPaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkPayoutBatchPaidResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MarkPayoutBatchPaidResponse) GetBatch() *common.PayoutBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type GetPayoutBatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdvisorId     string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutBatchesRequest) Reset() {
+	*x = GetPayoutBatchesRequest{}
+	mi := &file_proto_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutBatchesRequest) ProtoMessage() {}
+
+func (x *GetPayoutBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutBatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPayoutBatchesRequest) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *GetPayoutBatchesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetPayoutBatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPayoutBatchesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetPayoutBatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batches       []*common.PayoutBatch  `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutBatchesResponse) Reset() {
+	*x = GetPayoutBatchesResponse{}
+	mi := &file_proto_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutBatchesResponse) ProtoMessage() {}
+
+func (x *GetPayoutBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutBatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPayoutBatchesResponse) GetBatches() []*common.PayoutBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type CommissionTier struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Tier              string                 `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	CommissionPercent float64                `protobuf:"fixed64,2,opt,name=commission_percent,json=commissionPercent,proto3" json:"commission_percent,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CommissionTier) Reset() {
+	*x = CommissionTier{}
+	mi := &file_proto_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommissionTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommissionTier) ProtoMessage() {}
+
+func (x *CommissionTier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommissionTier.ProtoReflect.Descriptor instead.
+func (*CommissionTier) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CommissionTier) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *CommissionTier) GetCommissionPercent() float64 {
+	if x != nil {
+		return x.CommissionPercent
+	}
+	return 0
+}
+
+func (x *CommissionTier) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetCommissionTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommissionTiersRequest) Reset() {
+	*x = GetCommissionTiersRequest{}
+	mi := &file_proto_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommissionTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommissionTiersRequest) ProtoMessage() {}
+
+func (x *GetCommissionTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommissionTiersRequest.ProtoReflect.Descriptor instead.
+func (*GetCommissionTiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{22}
+}
+
+type GetCommissionTiersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tiers         []*CommissionTier      `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommissionTiersResponse) Reset() {
+	*x = GetCommissionTiersResponse{}
+	mi := &file_proto_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommissionTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommissionTiersResponse) ProtoMessage() {}
+
+func (x *GetCommissionTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommissionTiersResponse.ProtoReflect.Descriptor instead.
+func (*GetCommissionTiersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCommissionTiersResponse) GetTiers() []*CommissionTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type SetCommissionTierRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Tier              string                 `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	CommissionPercent float64                `protobuf:"fixed64,2,opt,name=commission_percent,json=commissionPercent,proto3" json:"commission_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetCommissionTierRequest) Reset() {
+	*x = SetCommissionTierRequest{}
+	mi := &file_proto_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommissionTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommissionTierRequest) ProtoMessage() {}
+
+func (x *SetCommissionTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommissionTierRequest.ProtoReflect.Descriptor instead.
+func (*SetCommissionTierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetCommissionTierRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *SetCommissionTierRequest) GetCommissionPercent() float64 {
+	if x != nil {
+		return x.CommissionPercent
+	}
+	return 0
+}
+
+type SetCommissionTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tier          *CommissionTier        `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommissionTierResponse) Reset() {
+	*x = SetCommissionTierResponse{}
+	mi := &file_proto_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommissionTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommissionTierResponse) ProtoMessage() {}
+
+func (x *SetCommissionTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommissionTierResponse.ProtoReflect.Descriptor instead.
+func (*SetCommissionTierResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetCommissionTierResponse) GetTier() *CommissionTier {
+	if x != nil {
+		return x.Tier
+	}
+	return nil
+}
+
+type SetAdvisorTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdvisorId     string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	Tier          string                 `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAdvisorTierRequest) Reset() {
+	*x = SetAdvisorTierRequest{}
+	mi := &file_proto_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAdvisorTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAdvisorTierRequest) ProtoMessage() {}
+
+func (x *SetAdvisorTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAdvisorTierRequest.ProtoReflect.Descriptor instead.
+func (*SetAdvisorTierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetAdvisorTierRequest) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *SetAdvisorTierRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+type SetAdvisorTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAdvisorTierResponse) Reset() {
+	*x = SetAdvisorTierResponse{}
+	mi := &file_proto_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAdvisorTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAdvisorTierResponse) ProtoMessage() {}
+
+func (x *SetAdvisorTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAdvisorTierResponse.ProtoReflect.Descriptor instead.
+func (*SetAdvisorTierResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetAdvisorTierResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetPlatformTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformTemplatesRequest) Reset() {
+	*x = GetPlatformTemplatesRequest{}
+	mi := &file_proto_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformTemplatesRequest) ProtoMessage() {}
+
+func (x *GetPlatformTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{28}
+}
+
+type GetPlatformTemplatesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Templates     []*common.MessageTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlatformTemplatesResponse) Reset() {
+	*x = GetPlatformTemplatesResponse{}
+	mi := &file_proto_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlatformTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlatformTemplatesResponse) ProtoMessage() {}
+
+func (x *GetPlatformTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlatformTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetPlatformTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPlatformTemplatesResponse) GetTemplates() []*common.MessageTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreatePlatformTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlatformTemplateRequest) Reset() {
+	*x = CreatePlatformTemplateRequest{}
+	mi := &file_proto_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlatformTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlatformTemplateRequest) ProtoMessage() {}
+
+func (x *CreatePlatformTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlatformTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlatformTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePlatformTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePlatformTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreatePlatformTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type CreatePlatformTemplateResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Template      *common.MessageTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlatformTemplateResponse) Reset() {
+	*x = CreatePlatformTemplateResponse{}
+	mi := &file_proto_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlatformTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlatformTemplateResponse) ProtoMessage() {}
+
+func (x *CreatePlatformTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlatformTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlatformTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePlatformTemplateResponse) GetTemplate() *common.MessageTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdatePlatformTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformTemplateRequest) Reset() {
+	*x = UpdatePlatformTemplateRequest{}
+	mi := &file_proto_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformTemplateRequest) ProtoMessage() {}
+
+func (x *UpdatePlatformTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdatePlatformTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePlatformTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePlatformTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdatePlatformTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdatePlatformTemplateRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdatePlatformTemplateResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Template      *common.MessageTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformTemplateResponse) Reset() {
+	*x = UpdatePlatformTemplateResponse{}
+	mi := &file_proto_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformTemplateResponse) ProtoMessage() {}
+
+func (x *UpdatePlatformTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdatePlatformTemplateResponse) GetTemplate() *common.MessageTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeletePlatformTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformTemplateRequest) Reset() {
+	*x = DeletePlatformTemplateRequest{}
+	mi := &file_proto_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformTemplateRequest) ProtoMessage() {}
+
+func (x *DeletePlatformTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlatformTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeletePlatformTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePlatformTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlatformTemplateResponse) Reset() {
+	*x = DeletePlatformTemplateResponse{}
+	mi := &file_proto_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlatformTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlatformTemplateResponse) ProtoMessage() {}
+
+func (x *DeletePlatformTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlatformTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlatformTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeletePlatformTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetAdvisorStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdvisorId     string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // RFC3339, defaults to 30 days ago
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339, defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdvisorStatsRequest) Reset() {
+	*x = GetAdvisorStatsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdvisorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvisorStatsRequest) ProtoMessage() {}
+
+func (x *GetAdvisorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvisorStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdvisorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetAdvisorStatsRequest) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *GetAdvisorStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAdvisorStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetAdvisorStatsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Report        *common.AdvisorStatsReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdvisorStatsResponse) Reset() {
+	*x = GetAdvisorStatsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdvisorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvisorStatsResponse) ProtoMessage() {}
+
+func (x *GetAdvisorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvisorStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdvisorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAdvisorStatsResponse) GetReport() *common.AdvisorStatsReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type GetMessageHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageHistoryRequest) Reset() {
+	*x = GetMessageHistoryRequest{}
+	mi := &file_proto_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageHistoryRequest) ProtoMessage() {}
+
+func (x *GetMessageHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMessageHistoryRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type MessageRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`   // EDIT or DELETE
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // content before the action
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRevision) Reset() {
+	*x = MessageRevision{}
+	mi := &file_proto_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRevision) ProtoMessage() {}
+
+func (x *MessageRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRevision.ProtoReflect.Descriptor instead.
+func (*MessageRevision) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MessageRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetMessageHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *common.ChatMessage    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Revisions     []*MessageRevision     `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageHistoryResponse) Reset() {
+	*x = GetMessageHistoryResponse{}
+	mi := &file_proto_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageHistoryResponse) ProtoMessage() {}
+
+func (x *GetMessageHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetMessageHistoryResponse) GetMessage() *common.ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *GetMessageHistoryResponse) GetRevisions() []*MessageRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type ModerationRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Detector      string                 `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"` // PHONE, EMAIL, PAYMENT_HANDLE, URL or PROFANITY
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`     // ALLOW, MASK, BLOCK or FLAG
+	Notice        string                 `protobuf:"bytes,3,opt,name=notice,proto3" json:"notice,omitempty"`     // shown to the sender of a blocked message, a default when empty
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationRule) Reset() {
+	*x = ModerationRule{}
+	mi := &file_proto_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationRule) ProtoMessage() {}
+
+func (x *ModerationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationRule.ProtoReflect.Descriptor instead.
+func (*ModerationRule) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ModerationRule) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+func (x *ModerationRule) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetModerationRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationRulesRequest) Reset() {
+	*x = GetModerationRulesRequest{}
+	mi := &file_proto_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationRulesRequest) ProtoMessage() {}
+
+func (x *GetModerationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{42}
+}
+
+type GetModerationRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ModerationRule      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Terms         []string               `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms,omitempty"` // the PROFANITY lexicon
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationRulesResponse) Reset() {
+	*x = GetModerationRulesResponse{}
+	mi := &file_proto_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationRulesResponse) ProtoMessage() {}
+
+func (x *GetModerationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetModerationRulesResponse) GetRules() []*ModerationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetModerationRulesResponse) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type SetModerationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Detector      string                 `protobuf:"bytes,1,opt,name=detector,proto3" json:"detector,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Notice        string                 `protobuf:"bytes,3,opt,name=notice,proto3" json:"notice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetModerationRuleRequest) Reset() {
+	*x = SetModerationRuleRequest{}
+	mi := &file_proto_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetModerationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetModerationRuleRequest) ProtoMessage() {}
+
+func (x *SetModerationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetModerationRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetModerationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetModerationRuleRequest) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+func (x *SetModerationRuleRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SetModerationRuleRequest) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+type SetModerationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *ModerationRule        `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetModerationRuleResponse) Reset() {
+	*x = SetModerationRuleResponse{}
+	mi := &file_proto_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetModerationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetModerationRuleResponse) ProtoMessage() {}
+
+func (x *SetModerationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetModerationRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetModerationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetModerationRuleResponse) GetRule() *ModerationRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type AddModerationTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []string               `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddModerationTermsRequest) Reset() {
+	*x = AddModerationTermsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddModerationTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddModerationTermsRequest) ProtoMessage() {}
+
+func (x *AddModerationTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddModerationTermsRequest.ProtoReflect.Descriptor instead.
+func (*AddModerationTermsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddModerationTermsRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type AddModerationTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"` // terms that were not in the lexicon yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddModerationTermsResponse) Reset() {
+	*x = AddModerationTermsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddModerationTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddModerationTermsResponse) ProtoMessage() {}
+
+func (x *AddModerationTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddModerationTermsResponse.ProtoReflect.Descriptor instead.
+func (*AddModerationTermsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddModerationTermsResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type RemoveModerationTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []string               `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveModerationTermsRequest) Reset() {
+	*x = RemoveModerationTermsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveModerationTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveModerationTermsRequest) ProtoMessage() {}
+
+func (x *RemoveModerationTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveModerationTermsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveModerationTermsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveModerationTermsRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type RemoveModerationTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int32                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveModerationTermsResponse) Reset() {
+	*x = RemoveModerationTermsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveModerationTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveModerationTermsResponse) ProtoMessage() {}
+
+func (x *RemoveModerationTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveModerationTermsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveModerationTermsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveModerationTermsResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// Admin exports include what messages said before they were edited or deleted
+type ExportSessionTranscriptRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FlagId        string                  `protobuf:"bytes,2,opt,name=flag_id,json=flagId,proto3" json:"flag_id,omitempty"` // instead of session_id, the session of the flag under investigation
+	Format        common.TranscriptFormat `protobuf:"varint,3,opt,name=format,proto3,enum=loveguru.common.TranscriptFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSessionTranscriptRequest) Reset() {
+	*x = ExportSessionTranscriptRequest{}
+	mi := &file_proto_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSessionTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSessionTranscriptRequest) ProtoMessage() {}
+
+func (x *ExportSessionTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSessionTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*ExportSessionTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportSessionTranscriptRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ExportSessionTranscriptRequest) GetFlagId() string {
+	if x != nil {
+		return x.FlagId
+	}
+	return ""
+}
+
+func (x *ExportSessionTranscriptRequest) GetFormat() common.TranscriptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return common.TranscriptFormat(0)
+}
+
+type ExportSessionTranscriptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSessionTranscriptResponse) Reset() {
+	*x = ExportSessionTranscriptResponse{}
+	mi := &file_proto_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSessionTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSessionTranscriptResponse) ProtoMessage() {}
+
+func (x *ExportSessionTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSessionTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*ExportSessionTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportSessionTranscriptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportSessionTranscriptResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExportSessionTranscriptResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// Users spend their balance on paid chats
+type CreditUserBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // positive
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`       // e.g. the payment reference
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditUserBalanceRequest) Reset() {
+	*x = CreditUserBalanceRequest{}
+	mi := &file_proto_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditUserBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditUserBalanceRequest) ProtoMessage() {}
+
+func (x *CreditUserBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditUserBalanceRequest.ProtoReflect.Descriptor instead.
+func (*CreditUserBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreditUserBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreditUserBalanceRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreditUserBalanceRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreditUserBalanceResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Transaction   *common.BalanceTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditUserBalanceResponse) Reset() {
+	*x = CreditUserBalanceResponse{}
+	mi := &file_proto_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditUserBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditUserBalanceResponse) ProtoMessage() {}
+
+func (x *CreditUserBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditUserBalanceResponse.ProtoReflect.Descriptor instead.
+func (*CreditUserBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreditUserBalanceResponse) GetTransaction() *common.BalanceTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type RotateEncryptionKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyRequest) Reset() {
+	*x = RotateEncryptionKeyRequest{}
+	mi := &file_proto_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{54}
+}
+
+type RotateEncryptionKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *EncryptionKey         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // the new active key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyResponse) Reset() {
+	*x = RotateEncryptionKeyResponse{}
+	mi := &file_proto_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RotateEncryptionKeyResponse) GetKey() *EncryptionKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type GetEncryptionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEncryptionStatusRequest) Reset() {
+	*x = GetEncryptionStatusRequest{}
+	mi := &file_proto_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEncryptionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncryptionStatusRequest) ProtoMessage() {}
+
+func (x *GetEncryptionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncryptionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEncryptionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{56}
+}
+
+type EncryptionKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`                                 // new content is encrypted with it
+	ContentCount  int32                  `protobuf:"varint,4,opt,name=content_count,json=contentCount,proto3" json:"content_count,omitempty"` // messages, revisions and AI interactions encrypted with it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptionKey) Reset() {
+	*x = EncryptionKey{}
+	mi := &file_proto_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKey) ProtoMessage() {}
+
+func (x *EncryptionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKey.ProtoReflect.Descriptor instead.
+func (*EncryptionKey) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EncryptionKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EncryptionKey) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *EncryptionKey) GetContentCount() int32 {
+	if x != nil {
+		return x.ContentCount
+	}
+	return 0
+}
+
+type GetEncryptionStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Enabled        bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                     // false when no KEK is configured and content is stored as plaintext
+	KekId          string                 `protobuf:"bytes,2,opt,name=kek_id,json=kekId,proto3" json:"kek_id,omitempty"`                             // fingerprint of the configured KEK
+	Keys           []*EncryptionKey       `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`                                            // content keys, oldest first
+	PlaintextCount int32                  `protobuf:"varint,4,opt,name=plaintext_count,json=plaintextCount,proto3" json:"plaintext_count,omitempty"` // content not encrypted yet
+	UnindexedCount int32                  `protobuf:"varint,5,opt,name=unindexed_count,json=unindexedCount,proto3" json:"unindexed_count,omitempty"` // messages not indexed for search yet
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetEncryptionStatusResponse) Reset() {
+	*x = GetEncryptionStatusResponse{}
+	mi := &file_proto_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEncryptionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEncryptionStatusResponse) ProtoMessage() {}
+
+func (x *GetEncryptionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEncryptionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetEncryptionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetEncryptionStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetEncryptionStatusResponse) GetKekId() string {
+	if x != nil {
+		return x.KekId
+	}
+	return ""
+}
+
+func (x *GetEncryptionStatusResponse) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GetEncryptionStatusResponse) GetPlaintextCount() int32 {
+	if x != nil {
+		return x.PlaintextCount
+	}
+	return 0
+}
+
+func (x *GetEncryptionStatusResponse) GetUnindexedCount() int32 {
+	if x != nil {
+		return x.UnindexedCount
+	}
+	return 0
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/admin.proto\x12\x0eloveguru.admin\x1a\x12proto/common.proto\"\xbb\x02\n" +
+	"\tAdminFlag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreported_by\x18\x02 \x01(\tR\n" +
+	"reportedBy\x12(\n" +
+	"\x10reported_user_id\x18\x03 \x01(\tR\x0ereportedUserId\x12.\n" +
+	"\x13reported_advisor_id\x18\x04 \x01(\tR\x11reportedAdvisorId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\n" +
+	" \x01(\tR\tmessageId\"I\n" +
+	"\x19GetPendingAdvisorsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"R\n" +
+	"\x1aGetPendingAdvisorsResponse\x124\n" +
+	"\badvisors\x18\x01 \x03(\v2\x18.loveguru.common.AdvisorR\badvisors\"6\n" +
+	"\x15ApproveAdvisorRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\"2\n" +
+	"\x16ApproveAdvisorResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\x0fGetFlagsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"C\n" +
+	"\x10GetFlagsResponse\x12/\n" +
+	"\x05flags\x18\x01 \x03(\v2\x19.loveguru.admin.AdminFlagR\x05flags\"+\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"-\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x89\x01\n" +
+	"\x1dGetAdvisorApplicationsRequest\x12:\n" +
+	"\x06status\x18\x01 \x01(\x0e2\".loveguru.common.ApplicationStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"i\n" +
+	"\x1eGetAdvisorApplicationsResponse\x12G\n" +
+	"\fapplications\x18\x01 \x03(\v2#.loveguru.common.AdvisorApplicationR\fapplications\"\xd6\x01\n" +
+	"\x1fReviewAdvisorApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12:\n" +
+	"\x06status\x18\x02 \x01(\x0e2\".loveguru.common.ApplicationStatusR\x06status\x12%\n" +
+	"\x0ereviewer_notes\x18\x03 \x01(\tR\rreviewerNotes\x12)\n" +
+	"\x10rejection_reason\x18\x04 \x01(\tR\x0frejectionReason\"i\n" +
+	" ReviewAdvisorApplicationResponse\x12E\n" +
+	"\vapplication\x18\x01 \x01(\v2#.loveguru.common.AdvisorApplicationR\vapplication\"?\n" +
+	"\x1cGetCredentialDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\"z\n" +
+	"\x1dGetCredentialDocumentResponse\x12?\n" +
+	"\bdocument\x18\x01 \x01(\v2#.loveguru.common.CredentialDocumentR\bdocument\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"X\n" +
+	"\x18CreatePayoutBatchRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\tR\tperiodEnd\"O\n" +
+	"\x19CreatePayoutBatchResponse\x122\n" +
+	"\x05batch\x18\x01 \x01(\v2\x1c.loveguru.common.PayoutBatchR\x05batch\"d\n" +
+	"\x1aMarkPayoutBatchPaidRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12+\n" +
+	"\x11payment_reference\x18\x02 \x01(\tR\x10paymentReference\"Q\n" +
+	"\x1bMarkPayoutBatchPaidResponse\x122\n" +
+	"\x05batch\x18\x01 \x01(\v2\x1c.loveguru.common.PayoutBatchR\x05batch\"~\n" +
+	"\x17GetPayoutBatchesRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"R\n" +
+	"\x18GetPayoutBatchesResponse\x126\n" +
+	"\abatches\x18\x01 \x03(\v2\x1c.loveguru.common.PayoutBatchR\abatches\"r\n" +
+	"\x0eCommissionTier\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\tR\x04tier\x12-\n" +
+	"\x12commission_percent\x18\x02 \x01(\x01R\x11commissionPercent\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\"\x1b\n" +
+	"\x19GetCommissionTiersRequest\"R\n" +
+	"\x1aGetCommissionTiersResponse\x124\n" +
+	"\x05tiers\x18\x01 \x03(\v2\x1e.loveguru.admin.CommissionTierR\x05tiers\"]\n" +
+	"\x18SetCommissionTierRequest\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\tR\x04tier\x12-\n" +
+	"\x12commission_percent\x18\x02 \x01(\x01R\x11commissionPercent\"O\n" +
+	"\x19SetCommissionTierResponse\x122\n" +
+	"\x04tier\x18\x01 \x01(\v2\x1e.loveguru.admin.CommissionTierR\x04tier\"J\n" +
+	"\x15SetAdvisorTierRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\x12\x12\n" +
+	"\x04tier\x18\x02 \x01(\tR\x04tier\"2\n" +
+	"\x16SetAdvisorTierResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1d\n" +
+	"\x1bGetPlatformTemplatesRequest\"^\n" +
+	"\x1cGetPlatformTemplatesResponse\x12>\n" +
+	"\ttemplates\x18\x01 \x03(\v2 .loveguru.common.MessageTemplateR\ttemplates\"e\n" +
+	"\x1dCreatePlatformTemplateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"^\n" +
+	"\x1eCreatePlatformTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .loveguru.common.MessageTemplateR\btemplate\"\x92\x01\n" +
+	"\x1dUpdatePlatformTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\"^\n" +
+	"\x1eUpdatePlatformTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .loveguru.common.MessageTemplateR\btemplate\"/\n" +
+	"\x1dDeletePlatformTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x1eDeletePlatformTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"[\n" +
+	"\x16GetAdvisorStatsRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"V\n" +
+	"\x17GetAdvisorStatsResponse\x12;\n" +
+	"\x06report\x18\x01 \x01(\v2#.loveguru.common.AdvisorStatsReportR\x06report\"9\n" +
+	"\x18GetMessageHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"b\n" +
+	"\x0fMessageRevision\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"\x92\x01\n" +
+	"\x19GetMessageHistoryResponse\x126\n" +
+	"\amessage\x18\x01 \x01(\v2\x1c.loveguru.common.ChatMessageR\amessage\x12=\n" +
+	"\trevisions\x18\x02 \x03(\v2\x1f.loveguru.admin.MessageRevisionR\trevisions\"{\n" +
+	"\x0eModerationRule\x12\x1a\n" +
+	"\bdetector\x18\x01 \x01(\tR\bdetector\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06notice\x18\x03 \x01(\tR\x06notice\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"\x1b\n" +
+	"\x19GetModerationRulesRequest\"h\n" +
+	"\x1aGetModerationRulesResponse\x124\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1e.loveguru.admin.ModerationRuleR\x05rules\x12\x14\n" +
+	"\x05terms\x18\x02 \x03(\tR\x05terms\"f\n" +
+	"\x18SetModerationRuleRequest\x12\x1a\n" +
+	"\bdetector\x18\x01 \x01(\tR\bdetector\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06notice\x18\x03 \x01(\tR\x06notice\"O\n" +
+	"\x19SetModerationRuleResponse\x122\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1e.loveguru.admin.ModerationRuleR\x04rule\"1\n" +
+	"\x19AddModerationTermsRequest\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05terms\"2\n" +
+	"\x1aAddModerationTermsResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\"4\n" +
+	"\x1cRemoveModerationTermsRequest\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05terms\"9\n" +
+	"\x1dRemoveModerationTermsResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"\x93\x01\n" +
+	"\x1eExportSessionTranscriptRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\aflag_id\x18\x02 \x01(\tR\x06flagId\x129\n" +
+	"\x06format\x18\x03 \x01(\x0e2!.loveguru.common.TranscriptFormatR\x06format\"t\n" +
+	"\x1fExportSessionTranscriptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"_\n" +
+	"\x18CreditUserBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"b\n" +
+	"\x19CreditUserBalanceResponse\x12E\n" +
+	"\vtransaction\x18\x01 \x01(\v2#.loveguru.common.BalanceTransactionR\vtransaction\"\x1c\n" +
+	"\x1aRotateEncryptionKeyRequest\"N\n" +
+	"\x1bRotateEncryptionKeyResponse\x12/\n" +
+	"\x03key\x18\x01 \x01(\v2\x1d.loveguru.admin.EncryptionKeyR\x03key\"\x1c\n" +
+	"\x1aGetEncryptionStatusRequest\"{\n" +
+	"\rEncryptionKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\x12#\n" +
+	"\rcontent_count\x18\x04 \x01(\x05R\fcontentCount\"\xd3\x01\n" +
+	"\x1bGetEncryptionStatusResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x15\n" +
+	"\x06kek_id\x18\x02 \x01(\tR\x05kekId\x121\n" +
+	"\x04keys\x18\x03 \x03(\v2\x1d.loveguru.admin.EncryptionKeyR\x04keys\x12'\n" +
+	"\x0fplaintext_count\x18\x04 \x01(\x05R\x0eplaintextCount\x12'\n" +
+	"\x0funindexed_count\x18\x05 \x01(\x05R\x0eunindexedCount2\x90\x17\n" +
 	"\fAdminService\x12k\n" +
 	"\x12GetPendingAdvisors\x12).loveguru.admin.GetPendingAdvisorsRequest\x1a*.loveguru.admin.GetPendingAdvisorsResponse\x12_\n" +
 	"\x0eApproveAdvisor\x12%.loveguru.admin.ApproveAdvisorRequest\x1a&.loveguru.admin.ApproveAdvisorResponse\x12M\n" +
 	"\bGetFlags\x12\x1f.loveguru.admin.GetFlagsRequest\x1a .loveguru.admin.GetFlagsResponse\x12P\n" +
-	"\tBlockUser\x12 .loveguru.admin.BlockUserRequest\x1a!.loveguru.admin.BlockUserResponseB\x16Z\x14loveguru/proto/adminb\x06proto3"
+	"\tBlockUser\x12 .loveguru.admin.BlockUserRequest\x1a!.loveguru.admin.BlockUserResponse\x12w\n" +
+	"\x16GetAdvisorApplications\x12-.loveguru.admin.GetAdvisorApplicationsRequest\x1a..loveguru.admin.GetAdvisorApplicationsResponse\x12}\n" +
+	"\x18ReviewAdvisorApplication\x12/.loveguru.admin.ReviewAdvisorApplicationRequest\x1a0.loveguru.admin.ReviewAdvisorApplicationResponse\x12t\n" +
+	"\x15GetCredentialDocument\x12,.loveguru.admin.GetCredentialDocumentRequest\x1a-.loveguru.admin.GetCredentialDocumentResponse\x12h\n" +
+	"\x11CreatePayoutBatch\x12(.loveguru.admin.CreatePayoutBatchRequest\x1a).loveguru.admin.CreatePayoutBatchResponse\x12n\n" +
+	"\x13MarkPayoutBatchPaid\x12*.loveguru.admin.MarkPayoutBatchPaidRequest\x1a+.loveguru.admin.MarkPayoutBatchPaidResponse\x12e\n" +
+	"\x10GetPayoutBatches\x12'.loveguru.admin.GetPayoutBatchesRequest\x1a(.loveguru.admin.GetPayoutBatchesResponse\x12k\n" +
+	"\x12GetCommissionTiers\x12).loveguru.admin.GetCommissionTiersRequest\x1a*.loveguru.admin.GetCommissionTiersResponse\x12h\n" +
+	"\x11SetCommissionTier\x12(.loveguru.admin.SetCommissionTierRequest\x1a).loveguru.admin.SetCommissionTierResponse\x12_\n" +
+	"\x0eSetAdvisorTier\x12%.loveguru.admin.SetAdvisorTierRequest\x1a&.loveguru.admin.SetAdvisorTierResponse\x12q\n" +
+	"\x14GetPlatformTemplates\x12+.loveguru.admin.GetPlatformTemplatesRequest\x1a,.loveguru.admin.GetPlatformTemplatesResponse\x12w\n" +
+	"\x16CreatePlatformTemplate\x12-.loveguru.admin.CreatePlatformTemplateRequest\x1a..loveguru.admin.CreatePlatformTemplateResponse\x12w\n" +
+	"\x16UpdatePlatformTemplate\x12-.loveguru.admin.UpdatePlatformTemplateRequest\x1a..loveguru.admin.UpdatePlatformTemplateResponse\x12w\n" +
+	"\x16DeletePlatformTemplate\x12-.loveguru.admin.DeletePlatformTemplateRequest\x1a..loveguru.admin.DeletePlatformTemplateResponse\x12b\n" +
+	"\x0fGetAdvisorStats\x12&.loveguru.admin.GetAdvisorStatsRequest\x1a'.loveguru.admin.GetAdvisorStatsResponse\x12h\n" +
+	"\x11GetMessageHistory\x12(.loveguru.admin.GetMessageHistoryRequest\x1a).loveguru.admin.GetMessageHistoryResponse\x12k\n" +
+	"\x12GetModerationRules\x12).loveguru.admin.GetModerationRulesRequest\x1a*.loveguru.admin.GetModerationRulesResponse\x12h\n" +
+	"\x11SetModerationRule\x12(.loveguru.admin.SetModerationRuleRequest\x1a).loveguru.admin.SetModerationRuleResponse\x12k\n" +
+	"\x12AddModerationTerms\x12).loveguru.admin.AddModerationTermsRequest\x1a*.loveguru.admin.AddModerationTermsResponse\x12t\n" +
+	"\x15RemoveModerationTerms\x12,.loveguru.admin.RemoveModerationTermsRequest\x1a-.loveguru.admin.RemoveModerationTermsResponse\x12z\n" +
+	"\x17ExportSessionTranscript\x12..loveguru.admin.ExportSessionTranscriptRequest\x1a/.loveguru.admin.ExportSessionTranscriptResponse\x12h\n" +
+	"\x11CreditUserBalance\x12(.loveguru.admin.CreditUserBalanceRequest\x1a).loveguru.admin.CreditUserBalanceResponse\x12n\n" +
+	"\x13RotateEncryptionKey\x12*.loveguru.admin.RotateEncryptionKeyRequest\x1a+.loveguru.admin.RotateEncryptionKeyResponse\x12n\n" +
+	"\x13GetEncryptionStatus\x12*.loveguru.admin.GetEncryptionStatusRequest\x1a+.loveguru.admin.GetEncryptionStatusResponseB\x16Z\x14loveguru/proto/adminb\x06proto3"
 
 var (
 	file_proto_admin_proto_rawDescOnce sync.Once
@@ -544,35 +3261,162 @@ func file_proto_admin_proto_rawDescGZIP() []byte {
 	return file_proto_admin_proto_rawDescData
 }
 
-var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_proto_admin_proto_goTypes = []any{
-	(*AdminFlag)(nil),                  // 0: loveguru.admin.AdminFlag
-	(*GetPendingAdvisorsRequest)(nil),  // 1: loveguru.admin.GetPendingAdvisorsRequest
-	(*GetPendingAdvisorsResponse)(nil), // 2: loveguru.admin.GetPendingAdvisorsResponse
-	(*ApproveAdvisorRequest)(nil),      // 3: loveguru.admin.ApproveAdvisorRequest
-	(*ApproveAdvisorResponse)(nil),     // 4: loveguru.admin.ApproveAdvisorResponse
-	(*GetFlagsRequest)(nil),            // 5: loveguru.admin.GetFlagsRequest
-	(*GetFlagsResponse)(nil),           // 6: loveguru.admin.GetFlagsResponse
-	(*BlockUserRequest)(nil),           // 7: loveguru.admin.BlockUserRequest
-	(*BlockUserResponse)(nil),          // 8: loveguru.admin.BlockUserResponse
-	(*common.Advisor)(nil),             // 9: loveguru.common.Advisor
+	(*AdminFlag)(nil),                        // 0: loveguru.admin.AdminFlag
+	(*GetPendingAdvisorsRequest)(nil),        // 1: loveguru.admin.GetPendingAdvisorsRequest
+	(*GetPendingAdvisorsResponse)(nil),       // 2: loveguru.admin.GetPendingAdvisorsResponse
+	(*ApproveAdvisorRequest)(nil),            // 3: loveguru.admin.ApproveAdvisorRequest
+	(*ApproveAdvisorResponse)(nil),           // 4: loveguru.admin.ApproveAdvisorResponse
+	(*GetFlagsRequest)(nil),                  // 5: loveguru.admin.GetFlagsRequest
+	(*GetFlagsResponse)(nil),                 // 6: loveguru.admin.GetFlagsResponse
+	(*BlockUserRequest)(nil),                 // 7: loveguru.admin.BlockUserRequest
+	(*BlockUserResponse)(nil),                // 8: loveguru.admin.BlockUserResponse
+	(*GetAdvisorApplicationsRequest)(nil),    // 9: loveguru.admin.GetAdvisorApplicationsRequest
+	(*GetAdvisorApplicationsResponse)(nil),   // 10: loveguru.admin.GetAdvisorApplicationsResponse
+	(*ReviewAdvisorApplicationRequest)(nil),  // 11: loveguru.admin.ReviewAdvisorApplicationRequest
+	(*ReviewAdvisorApplicationResponse)(nil), // 12: loveguru.admin.ReviewAdvisorApplicationResponse
+	(*GetCredentialDocumentRequest)(nil),     // 13: loveguru.admin.GetCredentialDocumentRequest
+	(*GetCredentialDocumentResponse)(nil),    // 14: loveguru.admin.GetCredentialDocumentResponse
+	(*CreatePayoutBatchRequest)(nil),         // 15: loveguru.admin.CreatePayoutBatchRequest
+	(*CreatePayoutBatchResponse)(nil),        // 16: loveguru.admin.CreatePayoutBatchResponse
+	(*MarkPayoutBatchPaidRequest)(nil),       // 17: loveguru.admin.MarkPayoutBatchPaidRequest
+	(*MarkPayoutBatchPaidResponse)(nil),      // 18: loveguru.admin.MarkPayoutBatchPaidResponse
+	(*GetPayoutBatchesRequest)(nil),          // 19: loveguru.admin.GetPayoutBatchesRequest
+	(*GetPayoutBatchesResponse)(nil),         // 20: loveguru.admin.GetPayoutBatchesResponse
+	(*CommissionTier)(nil),                   // 21: loveguru.admin.CommissionTier
+	(*GetCommissionTiersRequest)(nil),        // 22: loveguru.admin.GetCommissionTiersRequest
+	(*GetCommissionTiersResponse)(nil),       // 23: loveguru.admin.GetCommissionTiersResponse
+	(*SetCommissionTierRequest)(nil),         // 24: loveguru.admin.SetCommissionTierRequest
+	(*SetCommissionTierResponse)(nil),        // 25: loveguru.admin.SetCommissionTierResponse
+	(*SetAdvisorTierRequest)(nil),            // 26: loveguru.admin.SetAdvisorTierRequest
+	(*SetAdvisorTierResponse)(nil),           // 27: loveguru.admin.SetAdvisorTierResponse
+	(*GetPlatformTemplatesRequest)(nil),      // 28: loveguru.admin.GetPlatformTemplatesRequest
+	(*GetPlatformTemplatesResponse)(nil),     // 29: loveguru.admin.GetPlatformTemplatesResponse
+	(*CreatePlatformTemplateRequest)(nil),    // 30: loveguru.admin.CreatePlatformTemplateRequest
+	(*CreatePlatformTemplateResponse)(nil),   // 31: loveguru.admin.CreatePlatformTemplateResponse
+	(*UpdatePlatformTemplateRequest)(nil),    // 32: loveguru.admin.UpdatePlatformTemplateRequest
+	(*UpdatePlatformTemplateResponse)(nil),   // 33: loveguru.admin.UpdatePlatformTemplateResponse
+	(*DeletePlatformTemplateRequest)(nil),    // 34: loveguru.admin.DeletePlatformTemplateRequest
+	(*DeletePlatformTemplateResponse)(nil),   // 35: loveguru.admin.DeletePlatformTemplateResponse
+	(*GetAdvisorStatsRequest)(nil),           // 36: loveguru.admin.GetAdvisorStatsRequest
+	(*GetAdvisorStatsResponse)(nil),          // 37: loveguru.admin.GetAdvisorStatsResponse
+	(*GetMessageHistoryRequest)(nil),         // 38: loveguru.admin.GetMessageHistoryRequest
+	(*MessageRevision)(nil),                  // 39: loveguru.admin.MessageRevision
+	(*GetMessageHistoryResponse)(nil),        // 40: loveguru.admin.GetMessageHistoryResponse
+	(*ModerationRule)(nil),                   // 41: loveguru.admin.ModerationRule
+	(*GetModerationRulesRequest)(nil),        // 42: loveguru.admin.GetModerationRulesRequest
+	(*GetModerationRulesResponse)(nil),       // 43: loveguru.admin.GetModerationRulesResponse
+	(*SetModerationRuleRequest)(nil),         // 44: loveguru.admin.SetModerationRuleRequest
+	(*SetModerationRuleResponse)(nil),        // 45: loveguru.admin.SetModerationRuleResponse
+	(*AddModerationTermsRequest)(nil),        // 46: loveguru.admin.AddModerationTermsRequest
+	(*AddModerationTermsResponse)(nil),       // 47: loveguru.admin.AddModerationTermsResponse
+	(*RemoveModerationTermsRequest)(nil),     // 48: loveguru.admin.RemoveModerationTermsRequest
+	(*RemoveModerationTermsResponse)(nil),    // 49: loveguru.admin.RemoveModerationTermsResponse
+	(*ExportSessionTranscriptRequest)(nil),   // 50: loveguru.admin.ExportSessionTranscriptRequest
+	(*ExportSessionTranscriptResponse)(nil),  // 51: loveguru.admin.ExportSessionTranscriptResponse
+	(*CreditUserBalanceRequest)(nil),         // 52: loveguru.admin.CreditUserBalanceRequest
+	(*CreditUserBalanceResponse)(nil),        // 53: loveguru.admin.CreditUserBalanceResponse
+	(*RotateEncryptionKeyRequest)(nil),       // 54: loveguru.admin.RotateEncryptionKeyRequest
+	(*RotateEncryptionKeyResponse)(nil),      // 55: loveguru.admin.RotateEncryptionKeyResponse
+	(*GetEncryptionStatusRequest)(nil),       // 56: loveguru.admin.GetEncryptionStatusRequest
+	(*EncryptionKey)(nil),                    // 57: loveguru.admin.EncryptionKey
+	(*GetEncryptionStatusResponse)(nil),      // 58: loveguru.admin.GetEncryptionStatusResponse
+	(*common.Advisor)(nil),                   // 59: loveguru.common.Advisor
+	(common.ApplicationStatus)(0),            // 60: loveguru.common.ApplicationStatus
+	(*common.AdvisorApplication)(nil),        // 61: loveguru.common.AdvisorApplication
+	(*common.CredentialDocument)(nil),        // 62: loveguru.common.CredentialDocument
+	(*common.PayoutBatch)(nil),               // 63: loveguru.common.PayoutBatch
+	(*common.MessageTemplate)(nil),           // 64: loveguru.common.MessageTemplate
+	(*common.AdvisorStatsReport)(nil),        // 65: loveguru.common.AdvisorStatsReport
+	(*common.ChatMessage)(nil),               // 66: loveguru.common.ChatMessage
+	(common.TranscriptFormat)(0),             // 67: loveguru.common.TranscriptFormat
+	(*common.BalanceTransaction)(nil),        // 68: loveguru.common.BalanceTransaction
 }
 var file_proto_admin_proto_depIdxs = []int32{
-	9, // 0: loveguru.admin.GetPendingAdvisorsResponse.advisors:type_name -> loveguru.common.Advisor
-	0, // 1: loveguru.admin.GetFlagsResponse.flags:type_name -> loveguru.admin.AdminFlag
-	1, // 2: loveguru.admin.AdminService.GetPendingAdvisors:input_type -> loveguru.admin.GetPendingAdvisorsRequest
-	3, // 3: loveguru.admin.AdminService.ApproveAdvisor:input_type -> loveguru.admin.ApproveAdvisorRequest
-	5, // 4: loveguru.admin.AdminService.GetFlags:input_type -> loveguru.admin.GetFlagsRequest
-	7, // 5: loveguru.admin.AdminService.BlockUser:input_type -> loveguru.admin.BlockUserRequest
-	2, // 6: loveguru.admin.AdminService.GetPendingAdvisors:output_type -> loveguru.admin.GetPendingAdvisorsResponse
-	4, // 7: loveguru.admin.AdminService.ApproveAdvisor:output_type -> loveguru.admin.ApproveAdvisorResponse
-	6, // 8: loveguru.admin.AdminService.GetFlags:output_type -> loveguru.admin.GetFlagsResponse
-	8, // 9: loveguru.admin.AdminService.BlockUser:output_type -> loveguru.admin.BlockUserResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	59, // 0: loveguru.admin.GetPendingAdvisorsResponse.advisors:type_name -> loveguru.common.Advisor
+	0,  // 1: loveguru.admin.GetFlagsResponse.flags:type_name -> loveguru.admin.AdminFlag
+	60, // 2: loveguru.admin.GetAdvisorApplicationsRequest.status:type_name -> loveguru.common.ApplicationStatus
+	61, // 3: loveguru.admin.GetAdvisorApplicationsResponse.applications:type_name -> loveguru.common.AdvisorApplication
+	60, // 4: loveguru.admin.ReviewAdvisorApplicationRequest.status:type_name -> loveguru.common.ApplicationStatus
+	61, // 5: loveguru.admin.ReviewAdvisorApplicationResponse.application:type_name -> loveguru.common.AdvisorApplication
+	62, // 6: loveguru.admin.GetCredentialDocumentResponse.document:type_name -> loveguru.common.CredentialDocument
+	63, // 7: loveguru.admin.CreatePayoutBatchResponse.batch:type_name -> loveguru.common.PayoutBatch
+	63, // 8: loveguru.admin.MarkPayoutBatchPaidResponse.batch:type_name -> loveguru.common.PayoutBatch
+	63, // 9: loveguru.admin.GetPayoutBatchesResponse.batches:type_name -> loveguru.common.PayoutBatch
+	21, // 10: loveguru.admin.GetCommissionTiersResponse.tiers:type_name -> loveguru.admin.CommissionTier
+	21, // 11: loveguru.admin.SetCommissionTierResponse.tier:type_name -> loveguru.admin.CommissionTier
+	64, // 12: loveguru.admin.GetPlatformTemplatesResponse.templates:type_name -> loveguru.common.MessageTemplate
+	64, // 13: loveguru.admin.CreatePlatformTemplateResponse.template:type_name -> loveguru.common.MessageTemplate
+	64, // 14: loveguru.admin.UpdatePlatformTemplateResponse.template:type_name -> loveguru.common.MessageTemplate
+	65, // 15: loveguru.admin.GetAdvisorStatsResponse.report:type_name -> loveguru.common.AdvisorStatsReport
+	66, // 16: loveguru.admin.GetMessageHistoryResponse.message:type_name -> loveguru.common.ChatMessage
+	39, // 17: loveguru.admin.GetMessageHistoryResponse.revisions:type_name -> loveguru.admin.MessageRevision
+	41, // 18: loveguru.admin.GetModerationRulesResponse.rules:type_name -> loveguru.admin.ModerationRule
+	41, // 19: loveguru.admin.SetModerationRuleResponse.rule:type_name -> loveguru.admin.ModerationRule
+	67, // 20: loveguru.admin.ExportSessionTranscriptRequest.format:type_name -> loveguru.common.TranscriptFormat
+	68, // 21: loveguru.admin.CreditUserBalanceResponse.transaction:type_name -> loveguru.common.BalanceTransaction
+	57, // 22: loveguru.admin.RotateEncryptionKeyResponse.key:type_name -> loveguru.admin.EncryptionKey
+	57, // 23: loveguru.admin.GetEncryptionStatusResponse.keys:type_name -> loveguru.admin.EncryptionKey
+	1,  // 24: loveguru.admin.AdminService.GetPendingAdvisors:input_type -> loveguru.admin.GetPendingAdvisorsRequest
+	3,  // 25: loveguru.admin.AdminService.ApproveAdvisor:input_type -> loveguru.admin.ApproveAdvisorRequest
+	5,  // 26: loveguru.admin.AdminService.GetFlags:input_type -> loveguru.admin.GetFlagsRequest
+	7,  // 27: loveguru.admin.AdminService.BlockUser:input_type -> loveguru.admin.BlockUserRequest
+	9,  // 28: loveguru.admin.AdminService.GetAdvisorApplications:input_type -> loveguru.admin.GetAdvisorApplicationsRequest
+	11, // 29: loveguru.admin.AdminService.ReviewAdvisorApplication:input_type -> loveguru.admin.ReviewAdvisorApplicationRequest
+	13, // 30: loveguru.admin.AdminService.GetCredentialDocument:input_type -> loveguru.admin.GetCredentialDocumentRequest
+	15, // 31: loveguru.admin.AdminService.CreatePayoutBatch:input_type -> loveguru.admin.CreatePayoutBatchRequest
+	17, // 32: loveguru.admin.AdminService.MarkPayoutBatchPaid:input_type -> loveguru.admin.MarkPayoutBatchPaidRequest
+	19, // 33: loveguru.admin.AdminService.GetPayoutBatches:input_type -> loveguru.admin.GetPayoutBatchesRequest
+	22, // 34: loveguru.admin.AdminService.GetCommissionTiers:input_type -> loveguru.admin.GetCommissionTiersRequest
+	24, // 35: loveguru.admin.AdminService.SetCommissionTier:input_type -> loveguru.admin.SetCommissionTierRequest
+	26, // 36: loveguru.admin.AdminService.SetAdvisorTier:input_type -> loveguru.admin.SetAdvisorTierRequest
+	28, // 37: loveguru.admin.AdminService.GetPlatformTemplates:input_type -> loveguru.admin.GetPlatformTemplatesRequest
+	30, // 38: loveguru.admin.AdminService.CreatePlatformTemplate:input_type -> loveguru.admin.CreatePlatformTemplateRequest
+	32, // 39: loveguru.admin.AdminService.UpdatePlatformTemplate:input_type -> loveguru.admin.UpdatePlatformTemplateRequest
+	34, // 40: loveguru.admin.AdminService.DeletePlatformTemplate:input_type -> loveguru.admin.DeletePlatformTemplateRequest
+	36, // 41: loveguru.admin.AdminService.GetAdvisorStats:input_type -> loveguru.admin.GetAdvisorStatsRequest
+	38, // 42: loveguru.admin.AdminService.GetMessageHistory:input_type -> loveguru.admin.GetMessageHistoryRequest
+	42, // 43: loveguru.admin.AdminService.GetModerationRules:input_type -> loveguru.admin.GetModerationRulesRequest
+	44, // 44: loveguru.admin.AdminService.SetModerationRule:input_type -> loveguru.admin.SetModerationRuleRequest
+	46, // 45: loveguru.admin.AdminService.AddModerationTerms:input_type -> loveguru.admin.AddModerationTermsRequest
+	48, // 46: loveguru.admin.AdminService.RemoveModerationTerms:input_type -> loveguru.admin.RemoveModerationTermsRequest
+	50, // 47: loveguru.admin.AdminService.ExportSessionTranscript:input_type -> loveguru.admin.ExportSessionTranscriptRequest
+	52, // 48: loveguru.admin.AdminService.CreditUserBalance:input_type -> loveguru.admin.CreditUserBalanceRequest
+	54, // 49: loveguru.admin.AdminService.RotateEncryptionKey:input_type -> loveguru.admin.RotateEncryptionKeyRequest
+	56, // 50: loveguru.admin.AdminService.GetEncryptionStatus:input_type -> loveguru.admin.GetEncryptionStatusRequest
+	2,  // 51: loveguru.admin.AdminService.GetPendingAdvisors:output_type -> loveguru.admin.GetPendingAdvisorsResponse
+	4,  // 52: loveguru.admin.AdminService.ApproveAdvisor:output_type -> loveguru.admin.ApproveAdvisorResponse
+	6,  // 53: loveguru.admin.AdminService.GetFlags:output_type -> loveguru.admin.GetFlagsResponse
+	8,  // 54: loveguru.admin.AdminService.BlockUser:output_type -> loveguru.admin.BlockUserResponse
+	10, // 55: loveguru.admin.AdminService.GetAdvisorApplications:output_type -> loveguru.admin.GetAdvisorApplicationsResponse
+	12, // 56: loveguru.admin.AdminService.ReviewAdvisorApplication:output_type -> loveguru.admin.ReviewAdvisorApplicationResponse
+	14, // 57: loveguru.admin.AdminService.GetCredentialDocument:output_type -> loveguru.admin.GetCredentialDocumentResponse
+	16, // 58: loveguru.admin.AdminService.CreatePayoutBatch:output_type -> loveguru.admin.CreatePayoutBatchResponse
+	18, // 59: loveguru.admin.AdminService.MarkPayoutBatchPaid:output_type -> loveguru.admin.MarkPayoutBatchPaidResponse
+	20, // 60: loveguru.admin.AdminService.GetPayoutBatches:output_type -> loveguru.admin.GetPayoutBatchesResponse
+	23, // 61: loveguru.admin.AdminService.GetCommissionTiers:output_type -> loveguru.admin.GetCommissionTiersResponse
+	25, // 62: loveguru.admin.AdminService.SetCommissionTier:output_type -> loveguru.admin.SetCommissionTierResponse
+	27, // 63: loveguru.admin.AdminService.SetAdvisorTier:output_type -> loveguru.admin.SetAdvisorTierResponse
+	29, // 64: loveguru.admin.AdminService.GetPlatformTemplates:output_type -> loveguru.admin.GetPlatformTemplatesResponse
+	31, // 65: loveguru.admin.AdminService.CreatePlatformTemplate:output_type -> loveguru.admin.CreatePlatformTemplateResponse
+	33, // 66: loveguru.admin.AdminService.UpdatePlatformTemplate:output_type -> loveguru.admin.UpdatePlatformTemplateResponse
+	35, // 67: loveguru.admin.AdminService.DeletePlatformTemplate:output_type -> loveguru.admin.DeletePlatformTemplateResponse
+	37, // 68: loveguru.admin.AdminService.GetAdvisorStats:output_type -> loveguru.admin.GetAdvisorStatsResponse
+	40, // 69: loveguru.admin.AdminService.GetMessageHistory:output_type -> loveguru.admin.GetMessageHistoryResponse
+	43, // 70: loveguru.admin.AdminService.GetModerationRules:output_type -> loveguru.admin.GetModerationRulesResponse
+	45, // 71: loveguru.admin.AdminService.SetModerationRule:output_type -> loveguru.admin.SetModerationRuleResponse
+	47, // 72: loveguru.admin.AdminService.AddModerationTerms:output_type -> loveguru.admin.AddModerationTermsResponse
+	49, // 73: loveguru.admin.AdminService.RemoveModerationTerms:output_type -> loveguru.admin.RemoveModerationTermsResponse
+	51, // 74: loveguru.admin.AdminService.ExportSessionTranscript:output_type -> loveguru.admin.ExportSessionTranscriptResponse
+	53, // 75: loveguru.admin.AdminService.CreditUserBalance:output_type -> loveguru.admin.CreditUserBalanceResponse
+	55, // 76: loveguru.admin.AdminService.RotateEncryptionKey:output_type -> loveguru.admin.RotateEncryptionKeyResponse
+	58, // 77: loveguru.admin.AdminService.GetEncryptionStatus:output_type -> loveguru.admin.GetEncryptionStatusResponse
+	51, // [51:78] is the sub-list for method output_type
+	24, // [24:51] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_proto_init() }
@@ -586,7 +3430,7 @@ func file_proto_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
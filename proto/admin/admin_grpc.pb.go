@@ -19,10 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetPendingAdvisors_FullMethodName = "/loveguru.admin.AdminService/GetPendingAdvisors"
-	AdminService_ApproveAdvisor_FullMethodName     = "/loveguru.admin.AdminService/ApproveAdvisor"
-	AdminService_GetFlags_FullMethodName           = "/loveguru.admin.AdminService/GetFlags"
-	AdminService_BlockUser_FullMethodName          = "/loveguru.admin.AdminService/BlockUser"
+	AdminService_GetPendingAdvisors_FullMethodName       = "/loveguru.admin.AdminService/GetPendingAdvisors"
+	AdminService_ApproveAdvisor_FullMethodName           = "/loveguru.admin.AdminService/ApproveAdvisor"
+	AdminService_GetFlags_FullMethodName                 = "/loveguru.admin.AdminService/GetFlags"
+	AdminService_BlockUser_FullMethodName                = "/loveguru.admin.AdminService/BlockUser"
+	AdminService_GetAdvisorApplications_FullMethodName   = "/loveguru.admin.AdminService/GetAdvisorApplications"
+	AdminService_ReviewAdvisorApplication_FullMethodName = "/loveguru.admin.AdminService/ReviewAdvisorApplication"
+	AdminService_GetCredentialDocument_FullMethodName    = "/loveguru.admin.AdminService/GetCredentialDocument"
+	AdminService_CreatePayoutBatch_FullMethodName        = "/loveguru.admin.AdminService/CreatePayoutBatch"
+	AdminService_MarkPayoutBatchPaid_FullMethodName      = "/loveguru.admin.AdminService/MarkPayoutBatchPaid"
+	AdminService_GetPayoutBatches_FullMethodName         = "/loveguru.admin.AdminService/GetPayoutBatches"
+	AdminService_GetCommissionTiers_FullMethodName       = "/loveguru.admin.AdminService/GetCommissionTiers"
+	AdminService_SetCommissionTier_FullMethodName        = "/loveguru.admin.AdminService/SetCommissionTier"
+	AdminService_SetAdvisorTier_FullMethodName           = "/loveguru.admin.AdminService/SetAdvisorTier"
+	AdminService_GetPlatformTemplates_FullMethodName     = "/loveguru.admin.AdminService/GetPlatformTemplates"
+	AdminService_CreatePlatformTemplate_FullMethodName   = "/loveguru.admin.AdminService/CreatePlatformTemplate"
+	AdminService_UpdatePlatformTemplate_FullMethodName   = "/loveguru.admin.AdminService/UpdatePlatformTemplate"
+	AdminService_DeletePlatformTemplate_FullMethodName   = "/loveguru.admin.AdminService/DeletePlatformTemplate"
+	AdminService_GetAdvisorStats_FullMethodName          = "/loveguru.admin.AdminService/GetAdvisorStats"
+	AdminService_GetMessageHistory_FullMethodName        = "/loveguru.admin.AdminService/GetMessageHistory"
+	AdminService_GetModerationRules_FullMethodName       = "/loveguru.admin.AdminService/GetModerationRules"
+	AdminService_SetModerationRule_FullMethodName        = "/loveguru.admin.AdminService/SetModerationRule"
+	AdminService_AddModerationTerms_FullMethodName       = "/loveguru.admin.AdminService/AddModerationTerms"
+	AdminService_RemoveModerationTerms_FullMethodName    = "/loveguru.admin.AdminService/RemoveModerationTerms"
+	AdminService_ExportSessionTranscript_FullMethodName  = "/loveguru.admin.AdminService/ExportSessionTranscript"
+	AdminService_CreditUserBalance_FullMethodName        = "/loveguru.admin.AdminService/CreditUserBalance"
+	AdminService_RotateEncryptionKey_FullMethodName      = "/loveguru.admin.AdminService/RotateEncryptionKey"
+	AdminService_GetEncryptionStatus_FullMethodName      = "/loveguru.admin.AdminService/GetEncryptionStatus"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -33,6 +56,29 @@ type AdminServiceClient interface {
 	ApproveAdvisor(ctx context.Context, in *ApproveAdvisorRequest, opts ...grpc.CallOption) (*ApproveAdvisorResponse, error)
 	GetFlags(ctx context.Context, in *GetFlagsRequest, opts ...grpc.CallOption) (*GetFlagsResponse, error)
 	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	GetAdvisorApplications(ctx context.Context, in *GetAdvisorApplicationsRequest, opts ...grpc.CallOption) (*GetAdvisorApplicationsResponse, error)
+	ReviewAdvisorApplication(ctx context.Context, in *ReviewAdvisorApplicationRequest, opts ...grpc.CallOption) (*ReviewAdvisorApplicationResponse, error)
+	GetCredentialDocument(ctx context.Context, in *GetCredentialDocumentRequest, opts ...grpc.CallOption) (*GetCredentialDocumentResponse, error)
+	CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...grpc.CallOption) (*CreatePayoutBatchResponse, error)
+	MarkPayoutBatchPaid(ctx context.Context, in *MarkPayoutBatchPaidRequest, opts ...grpc.CallOption) (*MarkPayoutBatchPaidResponse, error)
+	GetPayoutBatches(ctx context.Context, in *GetPayoutBatchesRequest, opts ...grpc.CallOption) (*GetPayoutBatchesResponse, error)
+	GetCommissionTiers(ctx context.Context, in *GetCommissionTiersRequest, opts ...grpc.CallOption) (*GetCommissionTiersResponse, error)
+	SetCommissionTier(ctx context.Context, in *SetCommissionTierRequest, opts ...grpc.CallOption) (*SetCommissionTierResponse, error)
+	SetAdvisorTier(ctx context.Context, in *SetAdvisorTierRequest, opts ...grpc.CallOption) (*SetAdvisorTierResponse, error)
+	GetPlatformTemplates(ctx context.Context, in *GetPlatformTemplatesRequest, opts ...grpc.CallOption) (*GetPlatformTemplatesResponse, error)
+	CreatePlatformTemplate(ctx context.Context, in *CreatePlatformTemplateRequest, opts ...grpc.CallOption) (*CreatePlatformTemplateResponse, error)
+	UpdatePlatformTemplate(ctx context.Context, in *UpdatePlatformTemplateRequest, opts ...grpc.CallOption) (*UpdatePlatformTemplateResponse, error)
+	DeletePlatformTemplate(ctx context.Context, in *DeletePlatformTemplateRequest, opts ...grpc.CallOption) (*DeletePlatformTemplateResponse, error)
+	GetAdvisorStats(ctx context.Context, in *GetAdvisorStatsRequest, opts ...grpc.CallOption) (*GetAdvisorStatsResponse, error)
+	GetMessageHistory(ctx context.Context, in *GetMessageHistoryRequest, opts ...grpc.CallOption) (*GetMessageHistoryResponse, error)
+	GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error)
+	SetModerationRule(ctx context.Context, in *SetModerationRuleRequest, opts ...grpc.CallOption) (*SetModerationRuleResponse, error)
+	AddModerationTerms(ctx context.Context, in *AddModerationTermsRequest, opts ...grpc.CallOption) (*AddModerationTermsResponse, error)
+	RemoveModerationTerms(ctx context.Context, in *RemoveModerationTermsRequest, opts ...grpc.CallOption) (*RemoveModerationTermsResponse, error)
+	ExportSessionTranscript(ctx context.Context, in *ExportSessionTranscriptRequest, opts ...grpc.CallOption) (*ExportSessionTranscriptResponse, error)
+	CreditUserBalance(ctx context.Context, in *CreditUserBalanceRequest, opts ...grpc.CallOption) (*CreditUserBalanceResponse, error)
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error)
+	GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error)
 }
 
 type adminServiceClient struct {
@@ -83,6 +129,236 @@ func (c *adminServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest
 	return out, nil
 }
 
+func (c *adminServiceClient) GetAdvisorApplications(ctx context.Context, in *GetAdvisorApplicationsRequest, opts ...grpc.CallOption) (*GetAdvisorApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdvisorApplicationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAdvisorApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReviewAdvisorApplication(ctx context.Context, in *ReviewAdvisorApplicationRequest, opts ...grpc.CallOption) (*ReviewAdvisorApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewAdvisorApplicationResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReviewAdvisorApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCredentialDocument(ctx context.Context, in *GetCredentialDocumentRequest, opts ...grpc.CallOption) (*GetCredentialDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCredentialDocumentResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetCredentialDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...grpc.CallOption) (*CreatePayoutBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePayoutBatchResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreatePayoutBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) MarkPayoutBatchPaid(ctx context.Context, in *MarkPayoutBatchPaidRequest, opts ...grpc.CallOption) (*MarkPayoutBatchPaidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkPayoutBatchPaidResponse)
+	err := c.cc.Invoke(ctx, AdminService_MarkPayoutBatchPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPayoutBatches(ctx context.Context, in *GetPayoutBatchesRequest, opts ...grpc.CallOption) (*GetPayoutBatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoutBatchesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPayoutBatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCommissionTiers(ctx context.Context, in *GetCommissionTiersRequest, opts ...grpc.CallOption) (*GetCommissionTiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommissionTiersResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetCommissionTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetCommissionTier(ctx context.Context, in *SetCommissionTierRequest, opts ...grpc.CallOption) (*SetCommissionTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCommissionTierResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetCommissionTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetAdvisorTier(ctx context.Context, in *SetAdvisorTierRequest, opts ...grpc.CallOption) (*SetAdvisorTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAdvisorTierResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetAdvisorTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPlatformTemplates(ctx context.Context, in *GetPlatformTemplatesRequest, opts ...grpc.CallOption) (*GetPlatformTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlatformTemplatesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPlatformTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreatePlatformTemplate(ctx context.Context, in *CreatePlatformTemplateRequest, opts ...grpc.CallOption) (*CreatePlatformTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePlatformTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreatePlatformTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdatePlatformTemplate(ctx context.Context, in *UpdatePlatformTemplateRequest, opts ...grpc.CallOption) (*UpdatePlatformTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlatformTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdatePlatformTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePlatformTemplate(ctx context.Context, in *DeletePlatformTemplateRequest, opts ...grpc.CallOption) (*DeletePlatformTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlatformTemplateResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeletePlatformTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAdvisorStats(ctx context.Context, in *GetAdvisorStatsRequest, opts ...grpc.CallOption) (*GetAdvisorStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdvisorStatsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAdvisorStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetMessageHistory(ctx context.Context, in *GetMessageHistoryRequest, opts ...grpc.CallOption) (*GetMessageHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetMessageHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetModerationRules(ctx context.Context, in *GetModerationRulesRequest, opts ...grpc.CallOption) (*GetModerationRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModerationRulesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetModerationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetModerationRule(ctx context.Context, in *SetModerationRuleRequest, opts ...grpc.CallOption) (*SetModerationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetModerationRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetModerationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddModerationTerms(ctx context.Context, in *AddModerationTermsRequest, opts ...grpc.CallOption) (*AddModerationTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddModerationTermsResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddModerationTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveModerationTerms(ctx context.Context, in *RemoveModerationTermsRequest, opts ...grpc.CallOption) (*RemoveModerationTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveModerationTermsResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveModerationTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportSessionTranscript(ctx context.Context, in *ExportSessionTranscriptRequest, opts ...grpc.CallOption) (*ExportSessionTranscriptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportSessionTranscriptResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExportSessionTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreditUserBalance(ctx context.Context, in *CreditUserBalanceRequest, opts ...grpc.CallOption) (*CreditUserBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditUserBalanceResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreditUserBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, AdminService_RotateEncryptionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetEncryptionStatus(ctx context.Context, in *GetEncryptionStatusRequest, opts ...grpc.CallOption) (*GetEncryptionStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEncryptionStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetEncryptionStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -91,6 +367,29 @@ type AdminServiceServer interface {
 	ApproveAdvisor(context.Context, *ApproveAdvisorRequest) (*ApproveAdvisorResponse, error)
 	GetFlags(context.Context, *GetFlagsRequest) (*GetFlagsResponse, error)
 	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	GetAdvisorApplications(context.Context, *GetAdvisorApplicationsRequest) (*GetAdvisorApplicationsResponse, error)
+	ReviewAdvisorApplication(context.Context, *ReviewAdvisorApplicationRequest) (*ReviewAdvisorApplicationResponse, error)
+	GetCredentialDocument(context.Context, *GetCredentialDocumentRequest) (*GetCredentialDocumentResponse, error)
+	CreatePayoutBatch(context.Context, *CreatePayoutBatchRequest) (*CreatePayoutBatchResponse, error)
+	MarkPayoutBatchPaid(context.Context, *MarkPayoutBatchPaidRequest) (*MarkPayoutBatchPaidResponse, error)
+	GetPayoutBatches(context.Context, *GetPayoutBatchesRequest) (*GetPayoutBatchesResponse, error)
+	GetCommissionTiers(context.Context, *GetCommissionTiersRequest) (*GetCommissionTiersResponse, error)
+	SetCommissionTier(context.Context, *SetCommissionTierRequest) (*SetCommissionTierResponse, error)
+	SetAdvisorTier(context.Context, *SetAdvisorTierRequest) (*SetAdvisorTierResponse, error)
+	GetPlatformTemplates(context.Context, *GetPlatformTemplatesRequest) (*GetPlatformTemplatesResponse, error)
+	CreatePlatformTemplate(context.Context, *CreatePlatformTemplateRequest) (*CreatePlatformTemplateResponse, error)
+	UpdatePlatformTemplate(context.Context, *UpdatePlatformTemplateRequest) (*UpdatePlatformTemplateResponse, error)
+	DeletePlatformTemplate(context.Context, *DeletePlatformTemplateRequest) (*DeletePlatformTemplateResponse, error)
+	GetAdvisorStats(context.Context, *GetAdvisorStatsRequest) (*GetAdvisorStatsResponse, error)
+	GetMessageHistory(context.Context, *GetMessageHistoryRequest) (*GetMessageHistoryResponse, error)
+	GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error)
+	SetModerationRule(context.Context, *SetModerationRuleRequest) (*SetModerationRuleResponse, error)
+	AddModerationTerms(context.Context, *AddModerationTermsRequest) (*AddModerationTermsResponse, error)
+	RemoveModerationTerms(context.Context, *RemoveModerationTermsRequest) (*RemoveModerationTermsResponse, error)
+	ExportSessionTranscript(context.Context, *ExportSessionTranscriptRequest) (*ExportSessionTranscriptResponse, error)
+	CreditUserBalance(context.Context, *CreditUserBalanceRequest) (*CreditUserBalanceResponse, error)
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error)
+	GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -113,6 +412,75 @@ func (UnimplementedAdminServiceServer) GetFlags(context.Context, *GetFlagsReques
 func (UnimplementedAdminServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BlockUser not implemented")
 }
+func (UnimplementedAdminServiceServer) GetAdvisorApplications(context.Context, *GetAdvisorApplicationsRequest) (*GetAdvisorApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAdvisorApplications not implemented")
+}
+func (UnimplementedAdminServiceServer) ReviewAdvisorApplication(context.Context, *ReviewAdvisorApplicationRequest) (*ReviewAdvisorApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewAdvisorApplication not implemented")
+}
+func (UnimplementedAdminServiceServer) GetCredentialDocument(context.Context, *GetCredentialDocumentRequest) (*GetCredentialDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCredentialDocument not implemented")
+}
+func (UnimplementedAdminServiceServer) CreatePayoutBatch(context.Context, *CreatePayoutBatchRequest) (*CreatePayoutBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePayoutBatch not implemented")
+}
+func (UnimplementedAdminServiceServer) MarkPayoutBatchPaid(context.Context, *MarkPayoutBatchPaidRequest) (*MarkPayoutBatchPaidResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkPayoutBatchPaid not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPayoutBatches(context.Context, *GetPayoutBatchesRequest) (*GetPayoutBatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayoutBatches not implemented")
+}
+func (UnimplementedAdminServiceServer) GetCommissionTiers(context.Context, *GetCommissionTiersRequest) (*GetCommissionTiersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCommissionTiers not implemented")
+}
+func (UnimplementedAdminServiceServer) SetCommissionTier(context.Context, *SetCommissionTierRequest) (*SetCommissionTierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCommissionTier not implemented")
+}
+func (UnimplementedAdminServiceServer) SetAdvisorTier(context.Context, *SetAdvisorTierRequest) (*SetAdvisorTierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAdvisorTier not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPlatformTemplates(context.Context, *GetPlatformTemplatesRequest) (*GetPlatformTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlatformTemplates not implemented")
+}
+func (UnimplementedAdminServiceServer) CreatePlatformTemplate(context.Context, *CreatePlatformTemplateRequest) (*CreatePlatformTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePlatformTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdatePlatformTemplate(context.Context, *UpdatePlatformTemplateRequest) (*UpdatePlatformTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePlatformTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePlatformTemplate(context.Context, *DeletePlatformTemplateRequest) (*DeletePlatformTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePlatformTemplate not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAdvisorStats(context.Context, *GetAdvisorStatsRequest) (*GetAdvisorStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAdvisorStats not implemented")
+}
+func (UnimplementedAdminServiceServer) GetMessageHistory(context.Context, *GetMessageHistoryRequest) (*GetMessageHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMessageHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) GetModerationRules(context.Context, *GetModerationRulesRequest) (*GetModerationRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetModerationRules not implemented")
+}
+func (UnimplementedAdminServiceServer) SetModerationRule(context.Context, *SetModerationRuleRequest) (*SetModerationRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetModerationRule not implemented")
+}
+func (UnimplementedAdminServiceServer) AddModerationTerms(context.Context, *AddModerationTermsRequest) (*AddModerationTermsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddModerationTerms not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveModerationTerms(context.Context, *RemoveModerationTermsRequest) (*RemoveModerationTermsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveModerationTerms not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportSessionTranscript(context.Context, *ExportSessionTranscriptRequest) (*ExportSessionTranscriptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportSessionTranscript not implemented")
+}
+func (UnimplementedAdminServiceServer) CreditUserBalance(context.Context, *CreditUserBalanceRequest) (*CreditUserBalanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreditUserBalance not implemented")
+}
+func (UnimplementedAdminServiceServer) RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateEncryptionKey not implemented")
+}
+func (UnimplementedAdminServiceServer) GetEncryptionStatus(context.Context, *GetEncryptionStatusRequest) (*GetEncryptionStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEncryptionStatus not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -206,28 +574,534 @@ func _AdminService_BlockUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AdminService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "loveguru.admin.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPendingAdvisors",
-			Handler:    _AdminService_GetPendingAdvisors_Handler,
-		},
-		{
-			MethodName: "ApproveAdvisor",
-			Handler:    _AdminService_ApproveAdvisor_Handler,
-		},
-		{
-			MethodName: "GetFlags",
-			Handler:    _AdminService_GetFlags_Handler,
-		},
-		{
-			MethodName: "BlockUser",
-			Handler:    _AdminService_BlockUser_Handler,
+func _AdminService_GetAdvisorApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdvisorApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAdvisorApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAdvisorApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAdvisorApplications(ctx, req.(*GetAdvisorApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReviewAdvisorApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAdvisorApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReviewAdvisorApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReviewAdvisorApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReviewAdvisorApplication(ctx, req.(*ReviewAdvisorApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCredentialDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCredentialDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetCredentialDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCredentialDocument(ctx, req.(*GetCredentialDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreatePayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreatePayoutBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreatePayoutBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreatePayoutBatch(ctx, req.(*CreatePayoutBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_MarkPayoutBatchPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkPayoutBatchPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MarkPayoutBatchPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_MarkPayoutBatchPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MarkPayoutBatchPaid(ctx, req.(*MarkPayoutBatchPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPayoutBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPayoutBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPayoutBatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPayoutBatches(ctx, req.(*GetPayoutBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCommissionTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommissionTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCommissionTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetCommissionTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCommissionTiers(ctx, req.(*GetCommissionTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetCommissionTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommissionTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetCommissionTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetCommissionTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetCommissionTier(ctx, req.(*SetCommissionTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetAdvisorTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdvisorTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetAdvisorTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetAdvisorTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetAdvisorTier(ctx, req.(*SetAdvisorTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPlatformTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlatformTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPlatformTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPlatformTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPlatformTemplates(ctx, req.(*GetPlatformTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreatePlatformTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlatformTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreatePlatformTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreatePlatformTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreatePlatformTemplate(ctx, req.(*CreatePlatformTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdatePlatformTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlatformTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdatePlatformTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdatePlatformTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdatePlatformTemplate(ctx, req.(*UpdatePlatformTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePlatformTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlatformTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePlatformTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePlatformTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePlatformTemplate(ctx, req.(*DeletePlatformTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAdvisorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdvisorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAdvisorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAdvisorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAdvisorStats(ctx, req.(*GetAdvisorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetMessageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetMessageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetMessageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetMessageHistory(ctx, req.(*GetMessageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetModerationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetModerationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetModerationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetModerationRules(ctx, req.(*GetModerationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetModerationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetModerationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetModerationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetModerationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetModerationRule(ctx, req.(*SetModerationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddModerationTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddModerationTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddModerationTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddModerationTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddModerationTerms(ctx, req.(*AddModerationTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemoveModerationTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveModerationTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemoveModerationTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RemoveModerationTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemoveModerationTerms(ctx, req.(*RemoveModerationTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportSessionTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSessionTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportSessionTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportSessionTranscript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportSessionTranscript(ctx, req.(*ExportSessionTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreditUserBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreditUserBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreditUserBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreditUserBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreditUserBalance(ctx, req.(*CreditUserBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RotateEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetEncryptionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncryptionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetEncryptionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetEncryptionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetEncryptionStatus(ctx, req.(*GetEncryptionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loveguru.admin.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPendingAdvisors",
+			Handler:    _AdminService_GetPendingAdvisors_Handler,
+		},
+		{
+			MethodName: "ApproveAdvisor",
+			Handler:    _AdminService_ApproveAdvisor_Handler,
+		},
+		{
+			MethodName: "GetFlags",
+			Handler:    _AdminService_GetFlags_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _AdminService_BlockUser_Handler,
+		},
+		{
+			MethodName: "GetAdvisorApplications",
+			Handler:    _AdminService_GetAdvisorApplications_Handler,
+		},
+		{
+			MethodName: "ReviewAdvisorApplication",
+			Handler:    _AdminService_ReviewAdvisorApplication_Handler,
+		},
+		{
+			MethodName: "GetCredentialDocument",
+			Handler:    _AdminService_GetCredentialDocument_Handler,
+		},
+		{
+			MethodName: "CreatePayoutBatch",
+			Handler:    _AdminService_CreatePayoutBatch_Handler,
+		},
+		{
+			MethodName: "MarkPayoutBatchPaid",
+			Handler:    _AdminService_MarkPayoutBatchPaid_Handler,
+		},
+		{
+			MethodName: "GetPayoutBatches",
+			Handler:    _AdminService_GetPayoutBatches_Handler,
+		},
+		{
+			MethodName: "GetCommissionTiers",
+			Handler:    _AdminService_GetCommissionTiers_Handler,
+		},
+		{
+			MethodName: "SetCommissionTier",
+			Handler:    _AdminService_SetCommissionTier_Handler,
+		},
+		{
+			MethodName: "SetAdvisorTier",
+			Handler:    _AdminService_SetAdvisorTier_Handler,
+		},
+		{
+			MethodName: "GetPlatformTemplates",
+			Handler:    _AdminService_GetPlatformTemplates_Handler,
+		},
+		{
+			MethodName: "CreatePlatformTemplate",
+			Handler:    _AdminService_CreatePlatformTemplate_Handler,
+		},
+		{
+			MethodName: "UpdatePlatformTemplate",
+			Handler:    _AdminService_UpdatePlatformTemplate_Handler,
+		},
+		{
+			MethodName: "DeletePlatformTemplate",
+			Handler:    _AdminService_DeletePlatformTemplate_Handler,
+		},
+		{
+			MethodName: "GetAdvisorStats",
+			Handler:    _AdminService_GetAdvisorStats_Handler,
+		},
+		{
+			MethodName: "GetMessageHistory",
+			Handler:    _AdminService_GetMessageHistory_Handler,
+		},
+		{
+			MethodName: "GetModerationRules",
+			Handler:    _AdminService_GetModerationRules_Handler,
+		},
+		{
+			MethodName: "SetModerationRule",
+			Handler:    _AdminService_SetModerationRule_Handler,
+		},
+		{
+			MethodName: "AddModerationTerms",
+			Handler:    _AdminService_AddModerationTerms_Handler,
+		},
+		{
+			MethodName: "RemoveModerationTerms",
+			Handler:    _AdminService_RemoveModerationTerms_Handler,
+		},
+		{
+			MethodName: "ExportSessionTranscript",
+			Handler:    _AdminService_ExportSessionTranscript_Handler,
+		},
+		{
+			MethodName: "CreditUserBalance",
+			Handler:    _AdminService_CreditUserBalance_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _AdminService_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "GetEncryptionStatus",
+			Handler:    _AdminService_GetEncryptionStatus_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -25,11 +25,18 @@ const (
 type ListAdvisorsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	RatingMin       float64                `protobuf:"fixed64,1,opt,name=rating_min,json=ratingMin,proto3" json:"rating_min,omitempty"`
-	Languages       []string               `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
-	Specializations []string               `protobuf:"bytes,3,rep,name=specializations,proto3" json:"specializations,omitempty"`
-	Sort            string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"` // top_rated, price, experience
-	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset          int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	ExperienceMin   int32                  `protobuf:"varint,2,opt,name=experience_min,json=experienceMin,proto3" json:"experience_min,omitempty"`
+	Languages       []string               `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
+	Specializations []string               `protobuf:"bytes,4,rep,name=specializations,proto3" json:"specializations,omitempty"`
+	Status          common.AdvisorStatus   `protobuf:"varint,5,opt,name=status,proto3,enum=loveguru.common.AdvisorStatus" json:"status,omitempty"`
+	Search          string                 `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	Sort            string                 `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"` // top_rated, price, experience
+	Limit           int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	PricingType     string                 `protobuf:"bytes,10,opt,name=pricing_type,json=pricingType,proto3" json:"pricing_type,omitempty"` // CHAT, CALL, AI_HANDOFF; defaults to CHAT
+	PriceMin        float64                `protobuf:"fixed64,11,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`        // per-minute rate bounds for pricing_type
+	PriceMax        float64                `protobuf:"fixed64,12,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`
+	Currency        string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -71,6 +78,13 @@ func (x *ListAdvisorsRequest) GetRatingMin() float64 {
 	return 0
 }
 
+func (x *ListAdvisorsRequest) GetExperienceMin() int32 {
+	if x != nil {
+		return x.ExperienceMin
+	}
+	return 0
+}
+
 func (x *ListAdvisorsRequest) GetLanguages() []string {
 	if x != nil {
 		return x.Languages
@@ -85,6 +99,20 @@ func (x *ListAdvisorsRequest) GetSpecializations() []string {
 	return nil
 }
 
+func (x *ListAdvisorsRequest) GetStatus() common.AdvisorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return common.AdvisorStatus(0)
+}
+
+func (x *ListAdvisorsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
 func (x *ListAdvisorsRequest) GetSort() string {
 	if x != nil {
 		return x.Sort
@@ -106,6 +134,34 @@ func (x *ListAdvisorsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListAdvisorsRequest) GetPricingType() string {
+	if x != nil {
+		return x.PricingType
+	}
+	return ""
+}
+
+func (x *ListAdvisorsRequest) GetPriceMin() float64 {
+	if x != nil {
+		return x.PriceMin
+	}
+	return 0
+}
+
+func (x *ListAdvisorsRequest) GetPriceMax() float64 {
+	if x != nil {
+		return x.PriceMax
+	}
+	return 0
+}
+
+func (x *ListAdvisorsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ListAdvisorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Advisors      []*AdvisorWithRating   `protobuf:"bytes,1,rep,name=advisors,proto3" json:"advisors,omitempty"`
@@ -151,10 +207,11 @@ func (x *ListAdvisorsResponse) GetAdvisors() []*AdvisorWithRating {
 }
 
 type AdvisorWithRating struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Advisor       *common.Advisor        `protobuf:"bytes,1,opt,name=advisor,proto3" json:"advisor,omitempty"`
-	User          *common.User           `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	AverageRating float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Advisor       *common.Advisor          `protobuf:"bytes,1,opt,name=advisor,proto3" json:"advisor,omitempty"`
+	User          *common.User             `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	AverageRating float64                  `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	Pricing       []*common.AdvisorPricing `protobuf:"bytes,4,rep,name=pricing,proto3" json:"pricing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +267,13 @@ func (x *AdvisorWithRating) GetAverageRating() float64 {
 	return 0
 }
 
+func (x *AdvisorWithRating) GetPricing() []*common.AdvisorPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
 type GetAdvisorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -546,54 +610,2220 @@ func (x *UpdateProfileResponse) GetAdvisor() *common.Advisor {
 	return nil
 }
 
-var File_proto_advisor_proto protoreflect.FileDescriptor
+type SaveApplicationDraftRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Bio             string                 `protobuf:"bytes,1,opt,name=bio,proto3" json:"bio,omitempty"`
+	ExperienceYears int32                  `protobuf:"varint,2,opt,name=experience_years,json=experienceYears,proto3" json:"experience_years,omitempty"`
+	Languages       []string               `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
+	Specializations []string               `protobuf:"bytes,4,rep,name=specializations,proto3" json:"specializations,omitempty"`
+	HourlyRate      float64                `protobuf:"fixed64,5,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-const file_proto_advisor_proto_rawDesc = "" +
-	"\n" +
-	"\x13proto/advisor.proto\x12\x10loveguru.advisor\x1a\x12proto/common.proto\"\xbe\x01\n" +
-	"\x13ListAdvisorsRequest\x12\x1d\n" +
-	"\n" +
-	"rating_min\x18\x01 \x01(\x01R\tratingMin\x12\x1c\n" +
-	"\tlanguages\x18\x02 \x03(\tR\tlanguages\x12(\n" +
-	"\x0fspecializations\x18\x03 \x03(\tR\x0fspecializations\x12\x12\n" +
-	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x14\n" +
-	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x06 \x01(\x05R\x06offset\"W\n" +
-	"\x14ListAdvisorsResponse\x12?\n" +
-	"\badvisors\x18\x01 \x03(\v2#.loveguru.advisor.AdvisorWithRatingR\badvisors\"\x99\x01\n" +
-	"\x11AdvisorWithRating\x122\n" +
-	"\aadvisor\x18\x01 \x01(\v2\x18.loveguru.common.AdvisorR\aadvisor\x12)\n" +
-	"\x04user\x18\x02 \x01(\v2\x15.loveguru.common.UserR\x04user\x12%\n" +
-	"\x0eaverage_rating\x18\x03 \x01(\x01R\raverageRating\"#\n" +
-	"\x11GetAdvisorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
-	"\x12GetAdvisorResponse\x12=\n" +
-	"\aadvisor\x18\x01 \x01(\v2#.loveguru.advisor.AdvisorWithRatingR\aadvisor\"\xbd\x01\n" +
-	"\x15ApplyAsAdvisorRequest\x12\x10\n" +
-	"\x03bio\x18\x01 \x01(\tR\x03bio\x12)\n" +
-	"\x10experience_years\x18\x02 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
-	"\tlanguages\x18\x03 \x03(\tR\tlanguages\x12(\n" +
-	"\x0fspecializations\x18\x04 \x03(\tR\x0fspecializations\x12\x1f\n" +
-	"\vhourly_rate\x18\x05 \x01(\x01R\n" +
-	"hourlyRate\"L\n" +
-	"\x16ApplyAsAdvisorResponse\x122\n" +
-	"\aadvisor\x18\x01 \x01(\v2\x18.loveguru.common.AdvisorR\aadvisor\"\xf4\x01\n" +
-	"\x14UpdateProfileRequest\x12\x10\n" +
-	"\x03bio\x18\x01 \x01(\tR\x03bio\x12)\n" +
-	"\x10experience_years\x18\x02 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
-	"\tlanguages\x18\x03 \x03(\tR\tlanguages\x12(\n" +
-	"\x0fspecializations\x18\x04 \x03(\tR\x0fspecializations\x12\x1f\n" +
-	"\vhourly_rate\x18\x05 \x01(\x01R\n" +
-	"hourlyRate\x126\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x1e.loveguru.common.AdvisorStatusR\x06status\"K\n" +
-	"\x15UpdateProfileResponse\x122\n" +
-	"\aadvisor\x18\x01 \x01(\v2\x18.loveguru.common.AdvisorR\aadvisor2\x8f\x03\n" +
-	"\x0eAdvisorService\x12]\n" +
-	"\fListAdvisors\x12%.loveguru.advisor.ListAdvisorsRequest\x1a&.loveguru.advisor.ListAdvisorsResponse\x12W\n" +
-	"\n" +
-	"GetAdvisor\x12#.loveguru.advisor.GetAdvisorRequest\x1a$.loveguru.advisor.GetAdvisorResponse\x12c\n" +
-	"\x0eApplyAsAdvisor\x12'.loveguru.advisor.ApplyAsAdvisorRequest\x1a(.loveguru.advisor.ApplyAsAdvisorResponse\x12`\n" +
-	"\rUpdateProfile\x12&.loveguru.advisor.UpdateProfileRequest\x1a'.loveguru.advisor.UpdateProfileResponseB\x18Z\x16loveguru/proto/advisorb\x06proto3"
+func (x *SaveApplicationDraftRequest) Reset() {
+	*x = SaveApplicationDraftRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveApplicationDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveApplicationDraftRequest) ProtoMessage() {}
+
+func (x *SaveApplicationDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveApplicationDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveApplicationDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SaveApplicationDraftRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *SaveApplicationDraftRequest) GetExperienceYears() int32 {
+	if x != nil {
+		return x.ExperienceYears
+	}
+	return 0
+}
+
+func (x *SaveApplicationDraftRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *SaveApplicationDraftRequest) GetSpecializations() []string {
+	if x != nil {
+		return x.Specializations
+	}
+	return nil
+}
+
+func (x *SaveApplicationDraftRequest) GetHourlyRate() float64 {
+	if x != nil {
+		return x.HourlyRate
+	}
+	return 0
+}
+
+type SaveApplicationDraftResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Application   *common.AdvisorApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveApplicationDraftResponse) Reset() {
+	*x = SaveApplicationDraftResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveApplicationDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveApplicationDraftResponse) ProtoMessage() {}
+
+func (x *SaveApplicationDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveApplicationDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveApplicationDraftResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SaveApplicationDraftResponse) GetApplication() *common.AdvisorApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type UploadCredentialDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCredentialDocumentRequest) Reset() {
+	*x = UploadCredentialDocumentRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCredentialDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCredentialDocumentRequest) ProtoMessage() {}
+
+func (x *UploadCredentialDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCredentialDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadCredentialDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UploadCredentialDocumentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadCredentialDocumentRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *UploadCredentialDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadCredentialDocumentResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Document      *common.CredentialDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCredentialDocumentResponse) Reset() {
+	*x = UploadCredentialDocumentResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCredentialDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCredentialDocumentResponse) ProtoMessage() {}
+
+func (x *UploadCredentialDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCredentialDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadCredentialDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UploadCredentialDocumentResponse) GetDocument() *common.CredentialDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type SubmitApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitApplicationRequest) Reset() {
+	*x = SubmitApplicationRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitApplicationRequest) ProtoMessage() {}
+
+func (x *SubmitApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{13}
+}
+
+type SubmitApplicationResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Application   *common.AdvisorApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitApplicationResponse) Reset() {
+	*x = SubmitApplicationResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitApplicationResponse) ProtoMessage() {}
+
+func (x *SubmitApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitApplicationResponse.ProtoReflect.Descriptor instead.
+func (*SubmitApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SubmitApplicationResponse) GetApplication() *common.AdvisorApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type GetMyApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyApplicationRequest) Reset() {
+	*x = GetMyApplicationRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyApplicationRequest) ProtoMessage() {}
+
+func (x *GetMyApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetMyApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{15}
+}
+
+type GetMyApplicationResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Application   *common.AdvisorApplication `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyApplicationResponse) Reset() {
+	*x = GetMyApplicationResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyApplicationResponse) ProtoMessage() {}
+
+func (x *GetMyApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyApplicationResponse.ProtoReflect.Descriptor instead.
+func (*GetMyApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetMyApplicationResponse) GetApplication() *common.AdvisorApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type GetEarningsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"` // day, week, month
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // RFC3339, defaults to 30 days ago
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // RFC3339, defaults to now
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsRequest) Reset() {
+	*x = GetEarningsRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsRequest) ProtoMessage() {}
+
+func (x *GetEarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsRequest.ProtoReflect.Descriptor instead.
+func (*GetEarningsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetEarningsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetEarningsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetEarningsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetEarningsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetEarningsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type EarningsPeriod struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart      string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	SessionCount     int32                  `protobuf:"varint,2,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	TotalSeconds     int64                  `protobuf:"varint,3,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	GrossAmount      float64                `protobuf:"fixed64,4,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	CommissionAmount float64                `protobuf:"fixed64,5,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
+	NetAmount        float64                `protobuf:"fixed64,6,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EarningsPeriod) Reset() {
+	*x = EarningsPeriod{}
+	mi := &file_proto_advisor_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EarningsPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarningsPeriod) ProtoMessage() {}
+
+func (x *EarningsPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarningsPeriod.ProtoReflect.Descriptor instead.
+func (*EarningsPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EarningsPeriod) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *EarningsPeriod) GetSessionCount() int32 {
+	if x != nil {
+		return x.SessionCount
+	}
+	return 0
+}
+
+func (x *EarningsPeriod) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+func (x *EarningsPeriod) GetGrossAmount() float64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *EarningsPeriod) GetCommissionAmount() float64 {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return 0
+}
+
+func (x *EarningsPeriod) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+type GetEarningsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Periods       []*EarningsPeriod         `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	Items         []*common.EarningLineItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	UnpaidAmount  float64                   `protobuf:"fixed64,3,opt,name=unpaid_amount,json=unpaidAmount,proto3" json:"unpaid_amount,omitempty"`
+	Payouts       []*common.PayoutBatch     `protobuf:"bytes,4,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEarningsResponse) Reset() {
+	*x = GetEarningsResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEarningsResponse) ProtoMessage() {}
+
+func (x *GetEarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEarningsResponse.ProtoReflect.Descriptor instead.
+func (*GetEarningsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetEarningsResponse) GetPeriods() []*EarningsPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *GetEarningsResponse) GetItems() []*common.EarningLineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetEarningsResponse) GetUnpaidAmount() float64 {
+	if x != nil {
+		return x.UnpaidAmount
+	}
+	return 0
+}
+
+func (x *GetEarningsResponse) GetPayouts() []*common.PayoutBatch {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+type SetPricingRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pricing       []*common.AdvisorPricing `protobuf:"bytes,1,rep,name=pricing,proto3" json:"pricing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPricingRequest) Reset() {
+	*x = SetPricingRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPricingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPricingRequest) ProtoMessage() {}
+
+func (x *SetPricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPricingRequest.ProtoReflect.Descriptor instead.
+func (*SetPricingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetPricingRequest) GetPricing() []*common.AdvisorPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type SetPricingResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pricing       []*common.AdvisorPricing `protobuf:"bytes,1,rep,name=pricing,proto3" json:"pricing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPricingResponse) Reset() {
+	*x = SetPricingResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPricingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPricingResponse) ProtoMessage() {}
+
+func (x *SetPricingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPricingResponse.ProtoReflect.Descriptor instead.
+func (*SetPricingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetPricingResponse) GetPricing() []*common.AdvisorPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Languages     []string               `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"` // preferred languages, e.g. from the device locale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRecommendationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Advisor       *AdvisorWithRating     `protobuf:"bytes,1,opt,name=advisor,proto3" json:"advisor,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons       []string               `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"` // e.g. "speaks Hindi", "rated highly for breakups"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_proto_advisor_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Recommendation) GetAdvisor() *AdvisorWithRating {
+	if x != nil {
+		return x.Advisor
+	}
+	return nil
+}
+
+func (x *Recommendation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Recommendation) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type GetRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*Recommendation      `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRecommendationsResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{25}
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Templates     []*common.MessageTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // own saved replies, then platform templates
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*common.MessageTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Template      *common.MessageTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *common.MessageTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Template      *common.MessageTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *common.MessageTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Notes are private to the advisor who wrote them
+type ClientNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientNote) Reset() {
+	*x = ClientNote{}
+	mi := &file_proto_advisor_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientNote) ProtoMessage() {}
+
+func (x *ClientNote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientNote.ProtoReflect.Descriptor instead.
+func (*ClientNote) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClientNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClientNote) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClientNote) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ClientNote) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ClientNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ClientNote) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListClientNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientNotesRequest) Reset() {
+	*x = ListClientNotesRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientNotesRequest) ProtoMessage() {}
+
+func (x *ListClientNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListClientNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListClientNotesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListClientNotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListClientNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*ClientNote          `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientNotesResponse) Reset() {
+	*x = ListClientNotesResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientNotesResponse) ProtoMessage() {}
+
+func (x *ListClientNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListClientNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListClientNotesResponse) GetNotes() []*ClientNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type CreateClientNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // optional
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientNoteRequest) Reset() {
+	*x = CreateClientNoteRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientNoteRequest) ProtoMessage() {}
+
+func (x *CreateClientNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateClientNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateClientNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateClientNoteRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CreateClientNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateClientNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *ClientNote            `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClientNoteResponse) Reset() {
+	*x = CreateClientNoteResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClientNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClientNoteResponse) ProtoMessage() {}
+
+func (x *CreateClientNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClientNoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateClientNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateClientNoteResponse) GetNote() *ClientNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type UpdateClientNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClientNoteRequest) Reset() {
+	*x = UpdateClientNoteRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClientNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientNoteRequest) ProtoMessage() {}
+
+func (x *UpdateClientNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClientNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateClientNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateClientNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateClientNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *ClientNote            `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClientNoteResponse) Reset() {
+	*x = UpdateClientNoteResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClientNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClientNoteResponse) ProtoMessage() {}
+
+func (x *UpdateClientNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClientNoteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClientNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateClientNoteResponse) GetNote() *ClientNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type DeleteClientNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClientNoteRequest) Reset() {
+	*x = DeleteClientNoteRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClientNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientNoteRequest) ProtoMessage() {}
+
+func (x *DeleteClientNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClientNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteClientNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteClientNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClientNoteResponse) Reset() {
+	*x = DeleteClientNoteResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClientNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClientNoteResponse) ProtoMessage() {}
+
+func (x *DeleteClientNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClientNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClientNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteClientNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetClientContextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientContextRequest) Reset() {
+	*x = GetClientContextRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientContextRequest) ProtoMessage() {}
+
+func (x *GetClientContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientContextRequest.ProtoReflect.Descriptor instead.
+func (*GetClientContextRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetClientContextRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// What the advisor sees when a client opens a session
+type GetClientContextResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Session          *common.Session        `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	User             *common.User           `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	PreviousSessions int32                  `protobuf:"varint,3,opt,name=previous_sessions,json=previousSessions,proto3" json:"previous_sessions,omitempty"`
+	Notes            []*ClientNote          `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetClientContextResponse) Reset() {
+	*x = GetClientContextResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientContextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientContextResponse) ProtoMessage() {}
+
+func (x *GetClientContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientContextResponse.ProtoReflect.Descriptor instead.
+func (*GetClientContextResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetClientContextResponse) GetSession() *common.Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *GetClientContextResponse) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetClientContextResponse) GetPreviousSessions() int32 {
+	if x != nil {
+		return x.PreviousSessions
+	}
+	return 0
+}
+
+func (x *GetClientContextResponse) GetNotes() []*ClientNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type GetMyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // RFC3339, defaults to 30 days ago
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339, defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyStatsRequest) Reset() {
+	*x = GetMyStatsRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyStatsRequest) ProtoMessage() {}
+
+func (x *GetMyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMyStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetMyStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetMyStatsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Report        *common.AdvisorStatsReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyStatsResponse) Reset() {
+	*x = GetMyStatsResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyStatsResponse) ProtoMessage() {}
+
+func (x *GetMyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetMyStatsResponse) GetReport() *common.AdvisorStatsReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type SetChatCapacityRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaxConcurrentChats int32                  `protobuf:"varint,1,opt,name=max_concurrent_chats,json=maxConcurrentChats,proto3" json:"max_concurrent_chats,omitempty"` // 1-5, calls are always taken one at a time
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetChatCapacityRequest) Reset() {
+	*x = SetChatCapacityRequest{}
+	mi := &file_proto_advisor_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatCapacityRequest) ProtoMessage() {}
+
+func (x *SetChatCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetChatCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetChatCapacityRequest) GetMaxConcurrentChats() int32 {
+	if x != nil {
+		return x.MaxConcurrentChats
+	}
+	return 0
+}
+
+type SetChatCapacityResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaxConcurrentChats int32                  `protobuf:"varint,1,opt,name=max_concurrent_chats,json=maxConcurrentChats,proto3" json:"max_concurrent_chats,omitempty"`
+	Status             common.AdvisorStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=loveguru.common.AdvisorStatus" json:"status,omitempty"` // BUSY while at capacity
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetChatCapacityResponse) Reset() {
+	*x = SetChatCapacityResponse{}
+	mi := &file_proto_advisor_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatCapacityResponse) ProtoMessage() {}
+
+func (x *SetChatCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_advisor_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatCapacityResponse.ProtoReflect.Descriptor instead.
+func (*SetChatCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_advisor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetChatCapacityResponse) GetMaxConcurrentChats() int32 {
+	if x != nil {
+		return x.MaxConcurrentChats
+	}
+	return 0
+}
+
+func (x *SetChatCapacityResponse) GetStatus() common.AdvisorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return common.AdvisorStatus(0)
+}
+
+var File_proto_advisor_proto protoreflect.FileDescriptor
+
+const file_proto_advisor_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/advisor.proto\x12\x10loveguru.advisor\x1a\x12proto/common.proto\"\xae\x03\n" +
+	"\x13ListAdvisorsRequest\x12\x1d\n" +
+	"\n" +
+	"rating_min\x18\x01 \x01(\x01R\tratingMin\x12%\n" +
+	"\x0eexperience_min\x18\x02 \x01(\x05R\rexperienceMin\x12\x1c\n" +
+	"\tlanguages\x18\x03 \x03(\tR\tlanguages\x12(\n" +
+	"\x0fspecializations\x18\x04 \x03(\tR\x0fspecializations\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.loveguru.common.AdvisorStatusR\x06status\x12\x16\n" +
+	"\x06search\x18\x06 \x01(\tR\x06search\x12\x12\n" +
+	"\x04sort\x18\a \x01(\tR\x04sort\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\t \x01(\x05R\x06offset\x12!\n" +
+	"\fpricing_type\x18\n" +
+	" \x01(\tR\vpricingType\x12\x1b\n" +
+	"\tprice_min\x18\v \x01(\x01R\bpriceMin\x12\x1b\n" +
+	"\tprice_max\x18\f \x01(\x01R\bpriceMax\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\"W\n" +
+	"\x14ListAdvisorsResponse\x12?\n" +
+	"\badvisors\x18\x01 \x03(\v2#.loveguru.advisor.AdvisorWithRatingR\badvisors\"\xd4\x01\n" +
+	"\x11AdvisorWithRating\x122\n" +
+	"\aadvisor\x18\x01 \x01(\v2\x18.loveguru.common.AdvisorR\aadvisor\x12)\n" +
+	"\x04user\x18\x02 \x01(\v2\x15.loveguru.common.UserR\x04user\x12%\n" +
+	"\x0eaverage_rating\x18\x03 \x01(\x01R\raverageRating\x129\n" +
+	"\apricing\x18\x04 \x03(\v2\x1f.loveguru.common.AdvisorPricingR\apricing\"#\n" +
+	"\x11GetAdvisorRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
+	"\x12GetAdvisorResponse\x12=\n" +
+	"\aadvisor\x18\x01 \x01(\v2#.loveguru.advisor.AdvisorWithRatingR\aadvisor\"\xbd\x01\n" +
+	"\x15ApplyAsAdvisorRequest\x12\x10\n" +
+	"\x03bio\x18\x01 \x01(\tR\x03bio\x12)\n" +
+	"\x10experience_years\x18\x02 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
+	"\tlanguages\x18\x03 \x03(\tR\tlanguages\x12(\n" +
+	"\x0fspecializations\x18\x04 \x03(\tR\x0fspecializations\x12\x1f\n" +
+	"\vhourly_rate\x18\x05 \x01(\x01R\n" +
+	"hourlyRate\"L\n" +
+	"\x16ApplyAsAdvisorResponse\x122\n" +
+	"\aadvisor\x18\x01 \x01(\v2\x18.loveguru.common.AdvisorR\aadvisor\"\xf4\x01\n" +
+	"\x14UpdateProfileRequest\x12\x10\n" +
+	"\x03bio\x18\x01 \x01(\tR\x03bio\x12)\n" +
+	"\x10experience_years\x18\x02 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
+	"\tlanguages\x18\x03 \x03(\tR\tlanguages\x12(\n" +
+	"\x0fspecializations\x18\x04 \x03(\tR\x0fspecializations\x12\x1f\n" +
+	"\vhourly_rate\x18\x05 \x01(\x01R\n" +
+	"hourlyRate\x126\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1e.loveguru.common.AdvisorStatusR\x06status\"K\n" +
+	"\x15UpdateProfileResponse\x122\n" +
+	"\aadvisor\x18\x01 \x01(\v2\x18.loveguru.common.AdvisorR\aadvisor\"\xc3\x01\n" +
+	"\x1bSaveApplicationDraftRequest\x12\x10\n" +
+	"\x03bio\x18\x01 \x01(\tR\x03bio\x12)\n" +
+	"\x10experience_years\x18\x02 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
+	"\tlanguages\x18\x03 \x03(\tR\tlanguages\x12(\n" +
+	"\x0fspecializations\x18\x04 \x03(\tR\x0fspecializations\x12\x1f\n" +
+	"\vhourly_rate\x18\x05 \x01(\x01R\n" +
+	"hourlyRate\"e\n" +
+	"\x1cSaveApplicationDraftResponse\x12E\n" +
+	"\vapplication\x18\x01 \x01(\v2#.loveguru.common.AdvisorApplicationR\vapplication\"u\n" +
+	"\x1fUploadCredentialDocumentRequest\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"c\n" +
+	" UploadCredentialDocumentResponse\x12?\n" +
+	"\bdocument\x18\x01 \x01(\v2#.loveguru.common.CredentialDocumentR\bdocument\"\x1a\n" +
+	"\x18SubmitApplicationRequest\"b\n" +
+	"\x19SubmitApplicationResponse\x12E\n" +
+	"\vapplication\x18\x01 \x01(\v2#.loveguru.common.AdvisorApplicationR\vapplication\"\x19\n" +
+	"\x17GetMyApplicationRequest\"a\n" +
+	"\x18GetMyApplicationResponse\x12E\n" +
+	"\vapplication\x18\x01 \x01(\v2#.loveguru.common.AdvisorApplicationR\vapplication\"~\n" +
+	"\x12GetEarningsRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\xec\x01\n" +
+	"\x0eEarningsPeriod\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12#\n" +
+	"\rsession_count\x18\x02 \x01(\x05R\fsessionCount\x12#\n" +
+	"\rtotal_seconds\x18\x03 \x01(\x03R\ftotalSeconds\x12!\n" +
+	"\fgross_amount\x18\x04 \x01(\x01R\vgrossAmount\x12+\n" +
+	"\x11commission_amount\x18\x05 \x01(\x01R\x10commissionAmount\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\x06 \x01(\x01R\tnetAmount\"\xe6\x01\n" +
+	"\x13GetEarningsResponse\x12:\n" +
+	"\aperiods\x18\x01 \x03(\v2 .loveguru.advisor.EarningsPeriodR\aperiods\x126\n" +
+	"\x05items\x18\x02 \x03(\v2 .loveguru.common.EarningLineItemR\x05items\x12#\n" +
+	"\runpaid_amount\x18\x03 \x01(\x01R\funpaidAmount\x126\n" +
+	"\apayouts\x18\x04 \x03(\v2\x1c.loveguru.common.PayoutBatchR\apayouts\"N\n" +
+	"\x11SetPricingRequest\x129\n" +
+	"\apricing\x18\x01 \x03(\v2\x1f.loveguru.common.AdvisorPricingR\apricing\"O\n" +
+	"\x12SetPricingResponse\x129\n" +
+	"\apricing\x18\x01 \x03(\v2\x1f.loveguru.common.AdvisorPricingR\apricing\"O\n" +
+	"\x19GetRecommendationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tlanguages\x18\x02 \x03(\tR\tlanguages\"\x7f\n" +
+	"\x0eRecommendation\x12=\n" +
+	"\aadvisor\x18\x01 \x01(\v2#.loveguru.advisor.AdvisorWithRatingR\aadvisor\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\x03 \x03(\tR\areasons\"h\n" +
+	"\x1aGetRecommendationsResponse\x12J\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2 .loveguru.advisor.RecommendationR\x0frecommendations\"\x16\n" +
+	"\x14ListTemplatesRequest\"W\n" +
+	"\x15ListTemplatesResponse\x12>\n" +
+	"\ttemplates\x18\x01 \x03(\v2 .loveguru.common.MessageTemplateR\ttemplates\"]\n" +
+	"\x15CreateTemplateRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"V\n" +
+	"\x16CreateTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .loveguru.common.MessageTemplateR\btemplate\"m\n" +
+	"\x15UpdateTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\"V\n" +
+	"\x16UpdateTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .loveguru.common.MessageTemplateR\btemplate\"'\n" +
+	"\x15DeleteTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xac\x01\n" +
+	"\n" +
+	"ClientNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"G\n" +
+	"\x16ListClientNotesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"M\n" +
+	"\x17ListClientNotesResponse\x122\n" +
+	"\x05notes\x18\x01 \x03(\v2\x1c.loveguru.advisor.ClientNoteR\x05notes\"k\n" +
+	"\x17CreateClientNoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"L\n" +
+	"\x18CreateClientNoteResponse\x120\n" +
+	"\x04note\x18\x01 \x01(\v2\x1c.loveguru.advisor.ClientNoteR\x04note\"C\n" +
+	"\x17UpdateClientNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"L\n" +
+	"\x18UpdateClientNoteResponse\x120\n" +
+	"\x04note\x18\x01 \x01(\v2\x1c.loveguru.advisor.ClientNoteR\x04note\")\n" +
+	"\x17DeleteClientNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x18DeleteClientNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
+	"\x17GetClientContextRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xda\x01\n" +
+	"\x18GetClientContextResponse\x122\n" +
+	"\asession\x18\x01 \x01(\v2\x18.loveguru.common.SessionR\asession\x12)\n" +
+	"\x04user\x18\x02 \x01(\v2\x15.loveguru.common.UserR\x04user\x12+\n" +
+	"\x11previous_sessions\x18\x03 \x01(\x05R\x10previousSessions\x122\n" +
+	"\x05notes\x18\x04 \x03(\v2\x1c.loveguru.advisor.ClientNoteR\x05notes\"7\n" +
+	"\x11GetMyStatsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"Q\n" +
+	"\x12GetMyStatsResponse\x12;\n" +
+	"\x06report\x18\x01 \x01(\v2#.loveguru.common.AdvisorStatsReportR\x06report\"J\n" +
+	"\x16SetChatCapacityRequest\x120\n" +
+	"\x14max_concurrent_chats\x18\x01 \x01(\x05R\x12maxConcurrentChats\"\x83\x01\n" +
+	"\x17SetChatCapacityResponse\x120\n" +
+	"\x14max_concurrent_chats\x18\x01 \x01(\x05R\x12maxConcurrentChats\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.loveguru.common.AdvisorStatusR\x06status2\xef\x11\n" +
+	"\x0eAdvisorService\x12]\n" +
+	"\fListAdvisors\x12%.loveguru.advisor.ListAdvisorsRequest\x1a&.loveguru.advisor.ListAdvisorsResponse\x12W\n" +
+	"\n" +
+	"GetAdvisor\x12#.loveguru.advisor.GetAdvisorRequest\x1a$.loveguru.advisor.GetAdvisorResponse\x12c\n" +
+	"\x0eApplyAsAdvisor\x12'.loveguru.advisor.ApplyAsAdvisorRequest\x1a(.loveguru.advisor.ApplyAsAdvisorResponse\x12`\n" +
+	"\rUpdateProfile\x12&.loveguru.advisor.UpdateProfileRequest\x1a'.loveguru.advisor.UpdateProfileResponse\x12u\n" +
+	"\x14SaveApplicationDraft\x12-.loveguru.advisor.SaveApplicationDraftRequest\x1a..loveguru.advisor.SaveApplicationDraftResponse\x12\x81\x01\n" +
+	"\x18UploadCredentialDocument\x121.loveguru.advisor.UploadCredentialDocumentRequest\x1a2.loveguru.advisor.UploadCredentialDocumentResponse\x12l\n" +
+	"\x11SubmitApplication\x12*.loveguru.advisor.SubmitApplicationRequest\x1a+.loveguru.advisor.SubmitApplicationResponse\x12i\n" +
+	"\x10GetMyApplication\x12).loveguru.advisor.GetMyApplicationRequest\x1a*.loveguru.advisor.GetMyApplicationResponse\x12Z\n" +
+	"\vGetEarnings\x12$.loveguru.advisor.GetEarningsRequest\x1a%.loveguru.advisor.GetEarningsResponse\x12W\n" +
+	"\n" +
+	"SetPricing\x12#.loveguru.advisor.SetPricingRequest\x1a$.loveguru.advisor.SetPricingResponse\x12o\n" +
+	"\x12GetRecommendations\x12+.loveguru.advisor.GetRecommendationsRequest\x1a,.loveguru.advisor.GetRecommendationsResponse\x12`\n" +
+	"\rListTemplates\x12&.loveguru.advisor.ListTemplatesRequest\x1a'.loveguru.advisor.ListTemplatesResponse\x12c\n" +
+	"\x0eCreateTemplate\x12'.loveguru.advisor.CreateTemplateRequest\x1a(.loveguru.advisor.CreateTemplateResponse\x12c\n" +
+	"\x0eUpdateTemplate\x12'.loveguru.advisor.UpdateTemplateRequest\x1a(.loveguru.advisor.UpdateTemplateResponse\x12c\n" +
+	"\x0eDeleteTemplate\x12'.loveguru.advisor.DeleteTemplateRequest\x1a(.loveguru.advisor.DeleteTemplateResponse\x12f\n" +
+	"\x0fListClientNotes\x12(.loveguru.advisor.ListClientNotesRequest\x1a).loveguru.advisor.ListClientNotesResponse\x12i\n" +
+	"\x10CreateClientNote\x12).loveguru.advisor.CreateClientNoteRequest\x1a*.loveguru.advisor.CreateClientNoteResponse\x12i\n" +
+	"\x10UpdateClientNote\x12).loveguru.advisor.UpdateClientNoteRequest\x1a*.loveguru.advisor.UpdateClientNoteResponse\x12i\n" +
+	"\x10DeleteClientNote\x12).loveguru.advisor.DeleteClientNoteRequest\x1a*.loveguru.advisor.DeleteClientNoteResponse\x12i\n" +
+	"\x10GetClientContext\x12).loveguru.advisor.GetClientContextRequest\x1a*.loveguru.advisor.GetClientContextResponse\x12W\n" +
+	"\n" +
+	"GetMyStats\x12#.loveguru.advisor.GetMyStatsRequest\x1a$.loveguru.advisor.GetMyStatsResponse\x12f\n" +
+	"\x0fSetChatCapacity\x12(.loveguru.advisor.SetChatCapacityRequest\x1a).loveguru.advisor.SetChatCapacityResponseB\x18Z\x16loveguru/proto/advisorb\x06proto3"
 
 var (
 	file_proto_advisor_proto_rawDescOnce sync.Once
@@ -607,42 +2837,149 @@ func file_proto_advisor_proto_rawDescGZIP() []byte {
 	return file_proto_advisor_proto_rawDescData
 }
 
-var file_proto_advisor_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_advisor_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_proto_advisor_proto_goTypes = []any{
-	(*ListAdvisorsRequest)(nil),    // 0: loveguru.advisor.ListAdvisorsRequest
-	(*ListAdvisorsResponse)(nil),   // 1: loveguru.advisor.ListAdvisorsResponse
-	(*AdvisorWithRating)(nil),      // 2: loveguru.advisor.AdvisorWithRating
-	(*GetAdvisorRequest)(nil),      // 3: loveguru.advisor.GetAdvisorRequest
-	(*GetAdvisorResponse)(nil),     // 4: loveguru.advisor.GetAdvisorResponse
-	(*ApplyAsAdvisorRequest)(nil),  // 5: loveguru.advisor.ApplyAsAdvisorRequest
-	(*ApplyAsAdvisorResponse)(nil), // 6: loveguru.advisor.ApplyAsAdvisorResponse
-	(*UpdateProfileRequest)(nil),   // 7: loveguru.advisor.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),  // 8: loveguru.advisor.UpdateProfileResponse
-	(*common.Advisor)(nil),         // 9: loveguru.common.Advisor
-	(*common.User)(nil),            // 10: loveguru.common.User
-	(common.AdvisorStatus)(0),      // 11: loveguru.common.AdvisorStatus
+	(*ListAdvisorsRequest)(nil),              // 0: loveguru.advisor.ListAdvisorsRequest
+	(*ListAdvisorsResponse)(nil),             // 1: loveguru.advisor.ListAdvisorsResponse
+	(*AdvisorWithRating)(nil),                // 2: loveguru.advisor.AdvisorWithRating
+	(*GetAdvisorRequest)(nil),                // 3: loveguru.advisor.GetAdvisorRequest
+	(*GetAdvisorResponse)(nil),               // 4: loveguru.advisor.GetAdvisorResponse
+	(*ApplyAsAdvisorRequest)(nil),            // 5: loveguru.advisor.ApplyAsAdvisorRequest
+	(*ApplyAsAdvisorResponse)(nil),           // 6: loveguru.advisor.ApplyAsAdvisorResponse
+	(*UpdateProfileRequest)(nil),             // 7: loveguru.advisor.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),            // 8: loveguru.advisor.UpdateProfileResponse
+	(*SaveApplicationDraftRequest)(nil),      // 9: loveguru.advisor.SaveApplicationDraftRequest
+	(*SaveApplicationDraftResponse)(nil),     // 10: loveguru.advisor.SaveApplicationDraftResponse
+	(*UploadCredentialDocumentRequest)(nil),  // 11: loveguru.advisor.UploadCredentialDocumentRequest
+	(*UploadCredentialDocumentResponse)(nil), // 12: loveguru.advisor.UploadCredentialDocumentResponse
+	(*SubmitApplicationRequest)(nil),         // 13: loveguru.advisor.SubmitApplicationRequest
+	(*SubmitApplicationResponse)(nil),        // 14: loveguru.advisor.SubmitApplicationResponse
+	(*GetMyApplicationRequest)(nil),          // 15: loveguru.advisor.GetMyApplicationRequest
+	(*GetMyApplicationResponse)(nil),         // 16: loveguru.advisor.GetMyApplicationResponse
+	(*GetEarningsRequest)(nil),               // 17: loveguru.advisor.GetEarningsRequest
+	(*EarningsPeriod)(nil),                   // 18: loveguru.advisor.EarningsPeriod
+	(*GetEarningsResponse)(nil),              // 19: loveguru.advisor.GetEarningsResponse
+	(*SetPricingRequest)(nil),                // 20: loveguru.advisor.SetPricingRequest
+	(*SetPricingResponse)(nil),               // 21: loveguru.advisor.SetPricingResponse
+	(*GetRecommendationsRequest)(nil),        // 22: loveguru.advisor.GetRecommendationsRequest
+	(*Recommendation)(nil),                   // 23: loveguru.advisor.Recommendation
+	(*GetRecommendationsResponse)(nil),       // 24: loveguru.advisor.GetRecommendationsResponse
+	(*ListTemplatesRequest)(nil),             // 25: loveguru.advisor.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),            // 26: loveguru.advisor.ListTemplatesResponse
+	(*CreateTemplateRequest)(nil),            // 27: loveguru.advisor.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),           // 28: loveguru.advisor.CreateTemplateResponse
+	(*UpdateTemplateRequest)(nil),            // 29: loveguru.advisor.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),           // 30: loveguru.advisor.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),            // 31: loveguru.advisor.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),           // 32: loveguru.advisor.DeleteTemplateResponse
+	(*ClientNote)(nil),                       // 33: loveguru.advisor.ClientNote
+	(*ListClientNotesRequest)(nil),           // 34: loveguru.advisor.ListClientNotesRequest
+	(*ListClientNotesResponse)(nil),          // 35: loveguru.advisor.ListClientNotesResponse
+	(*CreateClientNoteRequest)(nil),          // 36: loveguru.advisor.CreateClientNoteRequest
+	(*CreateClientNoteResponse)(nil),         // 37: loveguru.advisor.CreateClientNoteResponse
+	(*UpdateClientNoteRequest)(nil),          // 38: loveguru.advisor.UpdateClientNoteRequest
+	(*UpdateClientNoteResponse)(nil),         // 39: loveguru.advisor.UpdateClientNoteResponse
+	(*DeleteClientNoteRequest)(nil),          // 40: loveguru.advisor.DeleteClientNoteRequest
+	(*DeleteClientNoteResponse)(nil),         // 41: loveguru.advisor.DeleteClientNoteResponse
+	(*GetClientContextRequest)(nil),          // 42: loveguru.advisor.GetClientContextRequest
+	(*GetClientContextResponse)(nil),         // 43: loveguru.advisor.GetClientContextResponse
+	(*GetMyStatsRequest)(nil),                // 44: loveguru.advisor.GetMyStatsRequest
+	(*GetMyStatsResponse)(nil),               // 45: loveguru.advisor.GetMyStatsResponse
+	(*SetChatCapacityRequest)(nil),           // 46: loveguru.advisor.SetChatCapacityRequest
+	(*SetChatCapacityResponse)(nil),          // 47: loveguru.advisor.SetChatCapacityResponse
+	(common.AdvisorStatus)(0),                // 48: loveguru.common.AdvisorStatus
+	(*common.Advisor)(nil),                   // 49: loveguru.common.Advisor
+	(*common.User)(nil),                      // 50: loveguru.common.User
+	(*common.AdvisorPricing)(nil),            // 51: loveguru.common.AdvisorPricing
+	(*common.AdvisorApplication)(nil),        // 52: loveguru.common.AdvisorApplication
+	(*common.CredentialDocument)(nil),        // 53: loveguru.common.CredentialDocument
+	(*common.EarningLineItem)(nil),           // 54: loveguru.common.EarningLineItem
+	(*common.PayoutBatch)(nil),               // 55: loveguru.common.PayoutBatch
+	(*common.MessageTemplate)(nil),           // 56: loveguru.common.MessageTemplate
+	(*common.Session)(nil),                   // 57: loveguru.common.Session
+	(*common.AdvisorStatsReport)(nil),        // 58: loveguru.common.AdvisorStatsReport
 }
 var file_proto_advisor_proto_depIdxs = []int32{
-	2,  // 0: loveguru.advisor.ListAdvisorsResponse.advisors:type_name -> loveguru.advisor.AdvisorWithRating
-	9,  // 1: loveguru.advisor.AdvisorWithRating.advisor:type_name -> loveguru.common.Advisor
-	10, // 2: loveguru.advisor.AdvisorWithRating.user:type_name -> loveguru.common.User
-	2,  // 3: loveguru.advisor.GetAdvisorResponse.advisor:type_name -> loveguru.advisor.AdvisorWithRating
-	9,  // 4: loveguru.advisor.ApplyAsAdvisorResponse.advisor:type_name -> loveguru.common.Advisor
-	11, // 5: loveguru.advisor.UpdateProfileRequest.status:type_name -> loveguru.common.AdvisorStatus
-	9,  // 6: loveguru.advisor.UpdateProfileResponse.advisor:type_name -> loveguru.common.Advisor
-	0,  // 7: loveguru.advisor.AdvisorService.ListAdvisors:input_type -> loveguru.advisor.ListAdvisorsRequest
-	3,  // 8: loveguru.advisor.AdvisorService.GetAdvisor:input_type -> loveguru.advisor.GetAdvisorRequest
-	5,  // 9: loveguru.advisor.AdvisorService.ApplyAsAdvisor:input_type -> loveguru.advisor.ApplyAsAdvisorRequest
-	7,  // 10: loveguru.advisor.AdvisorService.UpdateProfile:input_type -> loveguru.advisor.UpdateProfileRequest
-	1,  // 11: loveguru.advisor.AdvisorService.ListAdvisors:output_type -> loveguru.advisor.ListAdvisorsResponse
-	4,  // 12: loveguru.advisor.AdvisorService.GetAdvisor:output_type -> loveguru.advisor.GetAdvisorResponse
-	6,  // 13: loveguru.advisor.AdvisorService.ApplyAsAdvisor:output_type -> loveguru.advisor.ApplyAsAdvisorResponse
-	8,  // 14: loveguru.advisor.AdvisorService.UpdateProfile:output_type -> loveguru.advisor.UpdateProfileResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	48, // 0: loveguru.advisor.ListAdvisorsRequest.status:type_name -> loveguru.common.AdvisorStatus
+	2,  // 1: loveguru.advisor.ListAdvisorsResponse.advisors:type_name -> loveguru.advisor.AdvisorWithRating
+	49, // 2: loveguru.advisor.AdvisorWithRating.advisor:type_name -> loveguru.common.Advisor
+	50, // 3: loveguru.advisor.AdvisorWithRating.user:type_name -> loveguru.common.User
+	51, // 4: loveguru.advisor.AdvisorWithRating.pricing:type_name -> loveguru.common.AdvisorPricing
+	2,  // 5: loveguru.advisor.GetAdvisorResponse.advisor:type_name -> loveguru.advisor.AdvisorWithRating
+	49, // 6: loveguru.advisor.ApplyAsAdvisorResponse.advisor:type_name -> loveguru.common.Advisor
+	48, // 7: loveguru.advisor.UpdateProfileRequest.status:type_name -> loveguru.common.AdvisorStatus
+	49, // 8: loveguru.advisor.UpdateProfileResponse.advisor:type_name -> loveguru.common.Advisor
+	52, // 9: loveguru.advisor.SaveApplicationDraftResponse.application:type_name -> loveguru.common.AdvisorApplication
+	53, // 10: loveguru.advisor.UploadCredentialDocumentResponse.document:type_name -> loveguru.common.CredentialDocument
+	52, // 11: loveguru.advisor.SubmitApplicationResponse.application:type_name -> loveguru.common.AdvisorApplication
+	52, // 12: loveguru.advisor.GetMyApplicationResponse.application:type_name -> loveguru.common.AdvisorApplication
+	18, // 13: loveguru.advisor.GetEarningsResponse.periods:type_name -> loveguru.advisor.EarningsPeriod
+	54, // 14: loveguru.advisor.GetEarningsResponse.items:type_name -> loveguru.common.EarningLineItem
+	55, // 15: loveguru.advisor.GetEarningsResponse.payouts:type_name -> loveguru.common.PayoutBatch
+	51, // 16: loveguru.advisor.SetPricingRequest.pricing:type_name -> loveguru.common.AdvisorPricing
+	51, // 17: loveguru.advisor.SetPricingResponse.pricing:type_name -> loveguru.common.AdvisorPricing
+	2,  // 18: loveguru.advisor.Recommendation.advisor:type_name -> loveguru.advisor.AdvisorWithRating
+	23, // 19: loveguru.advisor.GetRecommendationsResponse.recommendations:type_name -> loveguru.advisor.Recommendation
+	56, // 20: loveguru.advisor.ListTemplatesResponse.templates:type_name -> loveguru.common.MessageTemplate
+	56, // 21: loveguru.advisor.CreateTemplateResponse.template:type_name -> loveguru.common.MessageTemplate
+	56, // 22: loveguru.advisor.UpdateTemplateResponse.template:type_name -> loveguru.common.MessageTemplate
+	33, // 23: loveguru.advisor.ListClientNotesResponse.notes:type_name -> loveguru.advisor.ClientNote
+	33, // 24: loveguru.advisor.CreateClientNoteResponse.note:type_name -> loveguru.advisor.ClientNote
+	33, // 25: loveguru.advisor.UpdateClientNoteResponse.note:type_name -> loveguru.advisor.ClientNote
+	57, // 26: loveguru.advisor.GetClientContextResponse.session:type_name -> loveguru.common.Session
+	50, // 27: loveguru.advisor.GetClientContextResponse.user:type_name -> loveguru.common.User
+	33, // 28: loveguru.advisor.GetClientContextResponse.notes:type_name -> loveguru.advisor.ClientNote
+	58, // 29: loveguru.advisor.GetMyStatsResponse.report:type_name -> loveguru.common.AdvisorStatsReport
+	48, // 30: loveguru.advisor.SetChatCapacityResponse.status:type_name -> loveguru.common.AdvisorStatus
+	0,  // 31: loveguru.advisor.AdvisorService.ListAdvisors:input_type -> loveguru.advisor.ListAdvisorsRequest
+	3,  // 32: loveguru.advisor.AdvisorService.GetAdvisor:input_type -> loveguru.advisor.GetAdvisorRequest
+	5,  // 33: loveguru.advisor.AdvisorService.ApplyAsAdvisor:input_type -> loveguru.advisor.ApplyAsAdvisorRequest
+	7,  // 34: loveguru.advisor.AdvisorService.UpdateProfile:input_type -> loveguru.advisor.UpdateProfileRequest
+	9,  // 35: loveguru.advisor.AdvisorService.SaveApplicationDraft:input_type -> loveguru.advisor.SaveApplicationDraftRequest
+	11, // 36: loveguru.advisor.AdvisorService.UploadCredentialDocument:input_type -> loveguru.advisor.UploadCredentialDocumentRequest
+	13, // 37: loveguru.advisor.AdvisorService.SubmitApplication:input_type -> loveguru.advisor.SubmitApplicationRequest
+	15, // 38: loveguru.advisor.AdvisorService.GetMyApplication:input_type -> loveguru.advisor.GetMyApplicationRequest
+	17, // 39: loveguru.advisor.AdvisorService.GetEarnings:input_type -> loveguru.advisor.GetEarningsRequest
+	20, // 40: loveguru.advisor.AdvisorService.SetPricing:input_type -> loveguru.advisor.SetPricingRequest
+	22, // 41: loveguru.advisor.AdvisorService.GetRecommendations:input_type -> loveguru.advisor.GetRecommendationsRequest
+	25, // 42: loveguru.advisor.AdvisorService.ListTemplates:input_type -> loveguru.advisor.ListTemplatesRequest
+	27, // 43: loveguru.advisor.AdvisorService.CreateTemplate:input_type -> loveguru.advisor.CreateTemplateRequest
+	29, // 44: loveguru.advisor.AdvisorService.UpdateTemplate:input_type -> loveguru.advisor.UpdateTemplateRequest
+	31, // 45: loveguru.advisor.AdvisorService.DeleteTemplate:input_type -> loveguru.advisor.DeleteTemplateRequest
+	34, // 46: loveguru.advisor.AdvisorService.ListClientNotes:input_type -> loveguru.advisor.ListClientNotesRequest
+	36, // 47: loveguru.advisor.AdvisorService.CreateClientNote:input_type -> loveguru.advisor.CreateClientNoteRequest
+	38, // 48: loveguru.advisor.AdvisorService.UpdateClientNote:input_type -> loveguru.advisor.UpdateClientNoteRequest
+	40, // 49: loveguru.advisor.AdvisorService.DeleteClientNote:input_type -> loveguru.advisor.DeleteClientNoteRequest
+	42, // 50: loveguru.advisor.AdvisorService.GetClientContext:input_type -> loveguru.advisor.GetClientContextRequest
+	44, // 51: loveguru.advisor.AdvisorService.GetMyStats:input_type -> loveguru.advisor.GetMyStatsRequest
+	46, // 52: loveguru.advisor.AdvisorService.SetChatCapacity:input_type -> loveguru.advisor.SetChatCapacityRequest
+	1,  // 53: loveguru.advisor.AdvisorService.ListAdvisors:output_type -> loveguru.advisor.ListAdvisorsResponse
+	4,  // 54: loveguru.advisor.AdvisorService.GetAdvisor:output_type -> loveguru.advisor.GetAdvisorResponse
+	6,  // 55: loveguru.advisor.AdvisorService.ApplyAsAdvisor:output_type -> loveguru.advisor.ApplyAsAdvisorResponse
+	8,  // 56: loveguru.advisor.AdvisorService.UpdateProfile:output_type -> loveguru.advisor.UpdateProfileResponse
+	10, // 57: loveguru.advisor.AdvisorService.SaveApplicationDraft:output_type -> loveguru.advisor.SaveApplicationDraftResponse
+	12, // 58: loveguru.advisor.AdvisorService.UploadCredentialDocument:output_type -> loveguru.advisor.UploadCredentialDocumentResponse
+	14, // 59: loveguru.advisor.AdvisorService.SubmitApplication:output_type -> loveguru.advisor.SubmitApplicationResponse
+	16, // 60: loveguru.advisor.AdvisorService.GetMyApplication:output_type -> loveguru.advisor.GetMyApplicationResponse
+	19, // 61: loveguru.advisor.AdvisorService.GetEarnings:output_type -> loveguru.advisor.GetEarningsResponse
+	21, // 62: loveguru.advisor.AdvisorService.SetPricing:output_type -> loveguru.advisor.SetPricingResponse
+	24, // 63: loveguru.advisor.AdvisorService.GetRecommendations:output_type -> loveguru.advisor.GetRecommendationsResponse
+	26, // 64: loveguru.advisor.AdvisorService.ListTemplates:output_type -> loveguru.advisor.ListTemplatesResponse
+	28, // 65: loveguru.advisor.AdvisorService.CreateTemplate:output_type -> loveguru.advisor.CreateTemplateResponse
+	30, // 66: loveguru.advisor.AdvisorService.UpdateTemplate:output_type -> loveguru.advisor.UpdateTemplateResponse
+	32, // 67: loveguru.advisor.AdvisorService.DeleteTemplate:output_type -> loveguru.advisor.DeleteTemplateResponse
+	35, // 68: loveguru.advisor.AdvisorService.ListClientNotes:output_type -> loveguru.advisor.ListClientNotesResponse
+	37, // 69: loveguru.advisor.AdvisorService.CreateClientNote:output_type -> loveguru.advisor.CreateClientNoteResponse
+	39, // 70: loveguru.advisor.AdvisorService.UpdateClientNote:output_type -> loveguru.advisor.UpdateClientNoteResponse
+	41, // 71: loveguru.advisor.AdvisorService.DeleteClientNote:output_type -> loveguru.advisor.DeleteClientNoteResponse
+	43, // 72: loveguru.advisor.AdvisorService.GetClientContext:output_type -> loveguru.advisor.GetClientContextResponse
+	45, // 73: loveguru.advisor.AdvisorService.GetMyStats:output_type -> loveguru.advisor.GetMyStatsResponse
+	47, // 74: loveguru.advisor.AdvisorService.SetChatCapacity:output_type -> loveguru.advisor.SetChatCapacityResponse
+	53, // [53:75] is the sub-list for method output_type
+	31, // [31:53] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_proto_advisor_proto_init() }
@@ -656,7 +2993,7 @@ func file_proto_advisor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_advisor_proto_rawDesc), len(file_proto_advisor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
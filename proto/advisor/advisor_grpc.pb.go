@@ -19,10 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdvisorService_ListAdvisors_FullMethodName   = "/loveguru.advisor.AdvisorService/ListAdvisors"
-	AdvisorService_GetAdvisor_FullMethodName     = "/loveguru.advisor.AdvisorService/GetAdvisor"
-	AdvisorService_ApplyAsAdvisor_FullMethodName = "/loveguru.advisor.AdvisorService/ApplyAsAdvisor"
-	AdvisorService_UpdateProfile_FullMethodName  = "/loveguru.advisor.AdvisorService/UpdateProfile"
+	AdvisorService_ListAdvisors_FullMethodName             = "/loveguru.advisor.AdvisorService/ListAdvisors"
+	AdvisorService_GetAdvisor_FullMethodName               = "/loveguru.advisor.AdvisorService/GetAdvisor"
+	AdvisorService_ApplyAsAdvisor_FullMethodName           = "/loveguru.advisor.AdvisorService/ApplyAsAdvisor"
+	AdvisorService_UpdateProfile_FullMethodName            = "/loveguru.advisor.AdvisorService/UpdateProfile"
+	AdvisorService_SaveApplicationDraft_FullMethodName     = "/loveguru.advisor.AdvisorService/SaveApplicationDraft"
+	AdvisorService_UploadCredentialDocument_FullMethodName = "/loveguru.advisor.AdvisorService/UploadCredentialDocument"
+	AdvisorService_SubmitApplication_FullMethodName        = "/loveguru.advisor.AdvisorService/SubmitApplication"
+	AdvisorService_GetMyApplication_FullMethodName         = "/loveguru.advisor.AdvisorService/GetMyApplication"
+	AdvisorService_GetEarnings_FullMethodName              = "/loveguru.advisor.AdvisorService/GetEarnings"
+	AdvisorService_SetPricing_FullMethodName               = "/loveguru.advisor.AdvisorService/SetPricing"
+	AdvisorService_GetRecommendations_FullMethodName       = "/loveguru.advisor.AdvisorService/GetRecommendations"
+	AdvisorService_ListTemplates_FullMethodName            = "/loveguru.advisor.AdvisorService/ListTemplates"
+	AdvisorService_CreateTemplate_FullMethodName           = "/loveguru.advisor.AdvisorService/CreateTemplate"
+	AdvisorService_UpdateTemplate_FullMethodName           = "/loveguru.advisor.AdvisorService/UpdateTemplate"
+	AdvisorService_DeleteTemplate_FullMethodName           = "/loveguru.advisor.AdvisorService/DeleteTemplate"
+	AdvisorService_ListClientNotes_FullMethodName          = "/loveguru.advisor.AdvisorService/ListClientNotes"
+	AdvisorService_CreateClientNote_FullMethodName         = "/loveguru.advisor.AdvisorService/CreateClientNote"
+	AdvisorService_UpdateClientNote_FullMethodName         = "/loveguru.advisor.AdvisorService/UpdateClientNote"
+	AdvisorService_DeleteClientNote_FullMethodName         = "/loveguru.advisor.AdvisorService/DeleteClientNote"
+	AdvisorService_GetClientContext_FullMethodName         = "/loveguru.advisor.AdvisorService/GetClientContext"
+	AdvisorService_GetMyStats_FullMethodName               = "/loveguru.advisor.AdvisorService/GetMyStats"
+	AdvisorService_SetChatCapacity_FullMethodName          = "/loveguru.advisor.AdvisorService/SetChatCapacity"
 )
 
 // AdvisorServiceClient is the client API for AdvisorService service.
@@ -33,6 +51,24 @@ type AdvisorServiceClient interface {
 	GetAdvisor(ctx context.Context, in *GetAdvisorRequest, opts ...grpc.CallOption) (*GetAdvisorResponse, error)
 	ApplyAsAdvisor(ctx context.Context, in *ApplyAsAdvisorRequest, opts ...grpc.CallOption) (*ApplyAsAdvisorResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	SaveApplicationDraft(ctx context.Context, in *SaveApplicationDraftRequest, opts ...grpc.CallOption) (*SaveApplicationDraftResponse, error)
+	UploadCredentialDocument(ctx context.Context, in *UploadCredentialDocumentRequest, opts ...grpc.CallOption) (*UploadCredentialDocumentResponse, error)
+	SubmitApplication(ctx context.Context, in *SubmitApplicationRequest, opts ...grpc.CallOption) (*SubmitApplicationResponse, error)
+	GetMyApplication(ctx context.Context, in *GetMyApplicationRequest, opts ...grpc.CallOption) (*GetMyApplicationResponse, error)
+	GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error)
+	SetPricing(ctx context.Context, in *SetPricingRequest, opts ...grpc.CallOption) (*SetPricingResponse, error)
+	GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+	ListClientNotes(ctx context.Context, in *ListClientNotesRequest, opts ...grpc.CallOption) (*ListClientNotesResponse, error)
+	CreateClientNote(ctx context.Context, in *CreateClientNoteRequest, opts ...grpc.CallOption) (*CreateClientNoteResponse, error)
+	UpdateClientNote(ctx context.Context, in *UpdateClientNoteRequest, opts ...grpc.CallOption) (*UpdateClientNoteResponse, error)
+	DeleteClientNote(ctx context.Context, in *DeleteClientNoteRequest, opts ...grpc.CallOption) (*DeleteClientNoteResponse, error)
+	GetClientContext(ctx context.Context, in *GetClientContextRequest, opts ...grpc.CallOption) (*GetClientContextResponse, error)
+	GetMyStats(ctx context.Context, in *GetMyStatsRequest, opts ...grpc.CallOption) (*GetMyStatsResponse, error)
+	SetChatCapacity(ctx context.Context, in *SetChatCapacityRequest, opts ...grpc.CallOption) (*SetChatCapacityResponse, error)
 }
 
 type advisorServiceClient struct {
@@ -83,6 +119,186 @@ func (c *advisorServiceClient) UpdateProfile(ctx context.Context, in *UpdateProf
 	return out, nil
 }
 
+func (c *advisorServiceClient) SaveApplicationDraft(ctx context.Context, in *SaveApplicationDraftRequest, opts ...grpc.CallOption) (*SaveApplicationDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveApplicationDraftResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_SaveApplicationDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) UploadCredentialDocument(ctx context.Context, in *UploadCredentialDocumentRequest, opts ...grpc.CallOption) (*UploadCredentialDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadCredentialDocumentResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_UploadCredentialDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) SubmitApplication(ctx context.Context, in *SubmitApplicationRequest, opts ...grpc.CallOption) (*SubmitApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitApplicationResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_SubmitApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) GetMyApplication(ctx context.Context, in *GetMyApplicationRequest, opts ...grpc.CallOption) (*GetMyApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyApplicationResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_GetMyApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) GetEarnings(ctx context.Context, in *GetEarningsRequest, opts ...grpc.CallOption) (*GetEarningsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEarningsResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_GetEarnings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) SetPricing(ctx context.Context, in *SetPricingRequest, opts ...grpc.CallOption) (*SetPricingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPricingResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_SetPricing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecommendationsResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_GetRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTemplateResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTemplateResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) ListClientNotes(ctx context.Context, in *ListClientNotesRequest, opts ...grpc.CallOption) (*ListClientNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientNotesResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_ListClientNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) CreateClientNote(ctx context.Context, in *CreateClientNoteRequest, opts ...grpc.CallOption) (*CreateClientNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClientNoteResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_CreateClientNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) UpdateClientNote(ctx context.Context, in *UpdateClientNoteRequest, opts ...grpc.CallOption) (*UpdateClientNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateClientNoteResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_UpdateClientNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) DeleteClientNote(ctx context.Context, in *DeleteClientNoteRequest, opts ...grpc.CallOption) (*DeleteClientNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClientNoteResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_DeleteClientNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) GetClientContext(ctx context.Context, in *GetClientContextRequest, opts ...grpc.CallOption) (*GetClientContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClientContextResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_GetClientContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) GetMyStats(ctx context.Context, in *GetMyStatsRequest, opts ...grpc.CallOption) (*GetMyStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyStatsResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_GetMyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advisorServiceClient) SetChatCapacity(ctx context.Context, in *SetChatCapacityRequest, opts ...grpc.CallOption) (*SetChatCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChatCapacityResponse)
+	err := c.cc.Invoke(ctx, AdvisorService_SetChatCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdvisorServiceServer is the server API for AdvisorService service.
 // All implementations must embed UnimplementedAdvisorServiceServer
 // for forward compatibility.
@@ -91,6 +307,24 @@ type AdvisorServiceServer interface {
 	GetAdvisor(context.Context, *GetAdvisorRequest) (*GetAdvisorResponse, error)
 	ApplyAsAdvisor(context.Context, *ApplyAsAdvisorRequest) (*ApplyAsAdvisorResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	SaveApplicationDraft(context.Context, *SaveApplicationDraftRequest) (*SaveApplicationDraftResponse, error)
+	UploadCredentialDocument(context.Context, *UploadCredentialDocumentRequest) (*UploadCredentialDocumentResponse, error)
+	SubmitApplication(context.Context, *SubmitApplicationRequest) (*SubmitApplicationResponse, error)
+	GetMyApplication(context.Context, *GetMyApplicationRequest) (*GetMyApplicationResponse, error)
+	GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error)
+	SetPricing(context.Context, *SetPricingRequest) (*SetPricingResponse, error)
+	GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
+	ListClientNotes(context.Context, *ListClientNotesRequest) (*ListClientNotesResponse, error)
+	CreateClientNote(context.Context, *CreateClientNoteRequest) (*CreateClientNoteResponse, error)
+	UpdateClientNote(context.Context, *UpdateClientNoteRequest) (*UpdateClientNoteResponse, error)
+	DeleteClientNote(context.Context, *DeleteClientNoteRequest) (*DeleteClientNoteResponse, error)
+	GetClientContext(context.Context, *GetClientContextRequest) (*GetClientContextResponse, error)
+	GetMyStats(context.Context, *GetMyStatsRequest) (*GetMyStatsResponse, error)
+	SetChatCapacity(context.Context, *SetChatCapacityRequest) (*SetChatCapacityResponse, error)
 	mustEmbedUnimplementedAdvisorServiceServer()
 }
 
@@ -113,6 +347,60 @@ func (UnimplementedAdvisorServiceServer) ApplyAsAdvisor(context.Context, *ApplyA
 func (UnimplementedAdvisorServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProfile not implemented")
 }
+func (UnimplementedAdvisorServiceServer) SaveApplicationDraft(context.Context, *SaveApplicationDraftRequest) (*SaveApplicationDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveApplicationDraft not implemented")
+}
+func (UnimplementedAdvisorServiceServer) UploadCredentialDocument(context.Context, *UploadCredentialDocumentRequest) (*UploadCredentialDocumentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadCredentialDocument not implemented")
+}
+func (UnimplementedAdvisorServiceServer) SubmitApplication(context.Context, *SubmitApplicationRequest) (*SubmitApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitApplication not implemented")
+}
+func (UnimplementedAdvisorServiceServer) GetMyApplication(context.Context, *GetMyApplicationRequest) (*GetMyApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyApplication not implemented")
+}
+func (UnimplementedAdvisorServiceServer) GetEarnings(context.Context, *GetEarningsRequest) (*GetEarningsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEarnings not implemented")
+}
+func (UnimplementedAdvisorServiceServer) SetPricing(context.Context, *SetPricingRequest) (*SetPricingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPricing not implemented")
+}
+func (UnimplementedAdvisorServiceServer) GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedAdvisorServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedAdvisorServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedAdvisorServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedAdvisorServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedAdvisorServiceServer) ListClientNotes(context.Context, *ListClientNotesRequest) (*ListClientNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClientNotes not implemented")
+}
+func (UnimplementedAdvisorServiceServer) CreateClientNote(context.Context, *CreateClientNoteRequest) (*CreateClientNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateClientNote not implemented")
+}
+func (UnimplementedAdvisorServiceServer) UpdateClientNote(context.Context, *UpdateClientNoteRequest) (*UpdateClientNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateClientNote not implemented")
+}
+func (UnimplementedAdvisorServiceServer) DeleteClientNote(context.Context, *DeleteClientNoteRequest) (*DeleteClientNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteClientNote not implemented")
+}
+func (UnimplementedAdvisorServiceServer) GetClientContext(context.Context, *GetClientContextRequest) (*GetClientContextResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClientContext not implemented")
+}
+func (UnimplementedAdvisorServiceServer) GetMyStats(context.Context, *GetMyStatsRequest) (*GetMyStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyStats not implemented")
+}
+func (UnimplementedAdvisorServiceServer) SetChatCapacity(context.Context, *SetChatCapacityRequest) (*SetChatCapacityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChatCapacity not implemented")
+}
 func (UnimplementedAdvisorServiceServer) mustEmbedUnimplementedAdvisorServiceServer() {}
 func (UnimplementedAdvisorServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +494,330 @@ func _AdvisorService_UpdateProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdvisorService_SaveApplicationDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveApplicationDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).SaveApplicationDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_SaveApplicationDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).SaveApplicationDraft(ctx, req.(*SaveApplicationDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_UploadCredentialDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadCredentialDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).UploadCredentialDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_UploadCredentialDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).UploadCredentialDocument(ctx, req.(*UploadCredentialDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_SubmitApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).SubmitApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_SubmitApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).SubmitApplication(ctx, req.(*SubmitApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_GetMyApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).GetMyApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_GetMyApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).GetMyApplication(ctx, req.(*GetMyApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_GetEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).GetEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_GetEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).GetEarnings(ctx, req.(*GetEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_SetPricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPricingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).SetPricing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_SetPricing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).SetPricing(ctx, req.(*SetPricingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).GetRecommendations(ctx, req.(*GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_ListClientNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).ListClientNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_ListClientNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).ListClientNotes(ctx, req.(*ListClientNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_CreateClientNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).CreateClientNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_CreateClientNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).CreateClientNote(ctx, req.(*CreateClientNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_UpdateClientNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).UpdateClientNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_UpdateClientNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).UpdateClientNote(ctx, req.(*UpdateClientNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_DeleteClientNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).DeleteClientNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_DeleteClientNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).DeleteClientNote(ctx, req.(*DeleteClientNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_GetClientContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).GetClientContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_GetClientContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).GetClientContext(ctx, req.(*GetClientContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_GetMyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).GetMyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_GetMyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).GetMyStats(ctx, req.(*GetMyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvisorService_SetChatCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChatCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvisorServiceServer).SetChatCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvisorService_SetChatCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvisorServiceServer).SetChatCapacity(ctx, req.(*SetChatCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdvisorService_ServiceDesc is the grpc.ServiceDesc for AdvisorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +841,78 @@ var AdvisorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProfile",
 			Handler:    _AdvisorService_UpdateProfile_Handler,
 		},
+		{
+			MethodName: "SaveApplicationDraft",
+			Handler:    _AdvisorService_SaveApplicationDraft_Handler,
+		},
+		{
+			MethodName: "UploadCredentialDocument",
+			Handler:    _AdvisorService_UploadCredentialDocument_Handler,
+		},
+		{
+			MethodName: "SubmitApplication",
+			Handler:    _AdvisorService_SubmitApplication_Handler,
+		},
+		{
+			MethodName: "GetMyApplication",
+			Handler:    _AdvisorService_GetMyApplication_Handler,
+		},
+		{
+			MethodName: "GetEarnings",
+			Handler:    _AdvisorService_GetEarnings_Handler,
+		},
+		{
+			MethodName: "SetPricing",
+			Handler:    _AdvisorService_SetPricing_Handler,
+		},
+		{
+			MethodName: "GetRecommendations",
+			Handler:    _AdvisorService_GetRecommendations_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _AdvisorService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _AdvisorService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _AdvisorService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _AdvisorService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "ListClientNotes",
+			Handler:    _AdvisorService_ListClientNotes_Handler,
+		},
+		{
+			MethodName: "CreateClientNote",
+			Handler:    _AdvisorService_CreateClientNote_Handler,
+		},
+		{
+			MethodName: "UpdateClientNote",
+			Handler:    _AdvisorService_UpdateClientNote_Handler,
+		},
+		{
+			MethodName: "DeleteClientNote",
+			Handler:    _AdvisorService_DeleteClientNote_Handler,
+		},
+		{
+			MethodName: "GetClientContext",
+			Handler:    _AdvisorService_GetClientContext_Handler,
+		},
+		{
+			MethodName: "GetMyStats",
+			Handler:    _AdvisorService_GetMyStats_Handler,
+		},
+		{
+			MethodName: "SetChatCapacity",
+			Handler:    _AdvisorService_SetChatCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/advisor.proto",
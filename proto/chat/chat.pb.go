@@ -23,11 +23,12 @@ const (
 )
 
 type CreateSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdvisorId     string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`        // for user-advisor, or empty for AI
-	Type          common.SessionType     `protobuf:"varint,2,opt,name=type,proto3,enum=loveguru.common.SessionType" json:"type,omitempty"` // CHAT or AI_CHAT
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AdvisorId        string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`                        // for user-advisor, or empty for AI
+	Type             common.SessionType     `protobuf:"varint,2,opt,name=type,proto3,enum=loveguru.common.SessionType" json:"type,omitempty"`                 // CHAT or AI_CHAT
+	HandoffSessionId string                 `protobuf:"bytes,3,opt,name=handoff_session_id,json=handoffSessionId,proto3" json:"handoff_session_id,omitempty"` // AI_CHAT session being handed off to the advisor, priced as AI_HANDOFF
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateSessionRequest) Reset() {
@@ -74,6 +75,13 @@ func (x *CreateSessionRequest) GetType() common.SessionType {
 	return common.SessionType(0)
 }
 
+func (x *CreateSessionRequest) GetHandoffSessionId() string {
+	if x != nil {
+		return x.HandoffSessionId
+	}
+	return ""
+}
+
 type CreateSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Session       *common.Session        `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
@@ -119,12 +127,15 @@ func (x *CreateSessionResponse) GetSession() *common.Session {
 }
 
 type GetMessagesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SessionId       string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`                                           // deprecated, use the cursors; ignored when one is set
+	BeforeMessageId string                 `protobuf:"bytes,4,opt,name=before_message_id,json=beforeMessageId,proto3" json:"before_message_id,omitempty"` // the page of messages just before this one
+	AfterMessageId  string                 `protobuf:"bytes,5,opt,name=after_message_id,json=afterMessageId,proto3" json:"after_message_id,omitempty"`    // the page of messages just after this one
+	Latest          bool                   `protobuf:"varint,6,opt,name=latest,proto3" json:"latest,omitempty"`                                           // without a cursor, the most recent page instead of the oldest
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetMessagesRequest) Reset() {
@@ -178,9 +189,31 @@ func (x *GetMessagesRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetMessagesRequest) GetBeforeMessageId() string {
+	if x != nil {
+		return x.BeforeMessageId
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetAfterMessageId() string {
+	if x != nil {
+		return x.AfterMessageId
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetLatest() bool {
+	if x != nil {
+		return x.Latest
+	}
+	return false
+}
+
 type GetMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*common.ChatMessage  `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages      []*common.ChatMessage  `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`               // oldest first
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // more messages beyond the page, in the direction paged
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,10 +255,19 @@ func (x *GetMessagesResponse) GetMessages() []*common.ChatMessage {
 	return nil
 }
 
+func (x *GetMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type ChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"` // TEXT or ATTACHMENT
+	Attachment    *common.ChatAttachment `protobuf:"bytes,4,opt,name=attachment,proto3" json:"attachment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -274,10 +316,32 @@ func (x *ChatMessage) GetContent() string {
 	return ""
 }
 
+func (x *ChatMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAttachment() *common.ChatAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// ChatStream frames mirror the /ws/chat frames. The first frame opens the stream for
+// its session_id and may carry a frame of its own; later frames stay in that session.
 type ChatMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                               // caption when sending an attachment
+	AttachmentId  string                 `protobuf:"bytes,3,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"` // sends an attachment uploaded to POST /chat/attachments
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                     // MESSAGE, TEMPLATE, ATTACHMENT, EDIT_MESSAGE, DELETE_MESSAGE, TYPING_STARTED, TYPING_STOPPED or READ_RECEIPT; inferred from content and attachment_id when empty
+	ClientId      string                 `protobuf:"bytes,5,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`             // the client's ID for the message, echoed in ACK and NACK
+	MessageId     string                 `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`          // EDIT_MESSAGE, DELETE_MESSAGE and READ_RECEIPT
+	TemplateId    string                 `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`       // TEMPLATE
+	ResumeAfter   string                 `protobuf:"bytes,8,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`    // first frame only, the last message the client has
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -326,9 +390,67 @@ func (x *ChatMessageRequest) GetContent() string {
 	return ""
 }
 
+func (x *ChatMessageRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *ChatMessageRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChatMessageRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ChatMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessageRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *ChatMessageRequest) GetResumeAfter() string {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return ""
+}
+
 type ChatMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // MESSAGE and MESSAGE_EDITED
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`       // the event, as on /ws/chat
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"` // who sent, edited, deleted, typed, read, joined or left
+	MessageId     string                 `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EditedAt      string                 `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`              // set for deleted messages, which have no content
+	Duplicate     bool                   `protobuf:"varint,10,opt,name=duplicate,proto3" json:"duplicate,omitempty"`                             // ACK of a message that was already stored
+	Error         string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`                                      // NACK
+	ConnectionId  string                 `protobuf:"bytes,12,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`    // CONNECTED
+	Notice        string                 `protobuf:"bytes,13,opt,name=notice,proto3" json:"notice,omitempty"`                                    // MESSAGE_BLOCKED, why moderation rejected the frame; BALANCE_LOW
+	Balance       float64                `protobuf:"fixed64,14,opt,name=balance,proto3" json:"balance,omitempty"`                                // BALANCE_UPDATE and BALANCE_LOW
+	MinutesLeft   int32                  `protobuf:"varint,15,opt,name=minutes_left,json=minutesLeft,proto3" json:"minutes_left,omitempty"`      // BALANCE_UPDATE and BALANCE_LOW, minutes the balance still covers
+	Reason        string                 `protobuf:"bytes,16,opt,name=reason,proto3" json:"reason,omitempty"`                                    // SESSION_ENDED and RECONNECT
+	RetryAfterMs  int64                  `protobuf:"varint,17,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"` // RECONNECT, how long to wait before reconnecting
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -370,39 +492,1359 @@ func (x *ChatMessageResponse) GetMessage() *ChatMessage {
 	return nil
 }
 
-var File_proto_chat_proto protoreflect.FileDescriptor
+func (x *ChatMessageResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
 
-const file_proto_chat_proto_rawDesc = "" +
-	"\n" +
-	"\x10proto/chat.proto\x12\rloveguru.chat\x1a\x12proto/common.proto\"g\n" +
-	"\x14CreateSessionRequest\x12\x1d\n" +
-	"\n" +
-	"advisor_id\x18\x01 \x01(\tR\tadvisorId\x120\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x1c.loveguru.common.SessionTypeR\x04type\"K\n" +
-	"\x15CreateSessionResponse\x122\n" +
-	"\asession\x18\x01 \x01(\v2\x18.loveguru.common.SessionR\asession\"a\n" +
-	"\x12GetMessagesRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"O\n" +
-	"\x13GetMessagesResponse\x128\n" +
-	"\bmessages\x18\x01 \x03(\v2\x1c.loveguru.common.ChatMessageR\bmessages\"F\n" +
-	"\vChatMessage\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"M\n" +
-	"\x12ChatMessageRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"K\n" +
-	"\x13ChatMessageResponse\x124\n" +
-	"\amessage\x18\x01 \x01(\v2\x1a.loveguru.chat.ChatMessageR\amessage2\x98\x02\n" +
-	"\vChatService\x12Z\n" +
-	"\rCreateSession\x12#.loveguru.chat.CreateSessionRequest\x1a$.loveguru.chat.CreateSessionResponse\x12T\n" +
-	"\vGetMessages\x12!.loveguru.chat.GetMessagesRequest\x1a\".loveguru.chat.GetMessagesResponse\x12W\n" +
+func (x *ChatMessageResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *ChatMessageResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ChatMessageResponse) GetMinutesLeft() int32 {
+	if x != nil {
+		return x.MinutesLeft
+	}
+	return 0
+}
+
+func (x *ChatMessageResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ChatMessageResponse) GetRetryAfterMs() int64 {
+	if x != nil {
+		return x.RetryAfterMs
+	}
+	return 0
+}
+
+type EndSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSessionRequest) Reset() {
+	*x = EndSessionRequest{}
+	mi := &file_proto_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionRequest) ProtoMessage() {}
+
+func (x *EndSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionRequest.ProtoReflect.Descriptor instead.
+func (*EndSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EndSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type EndSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSessionResponse) Reset() {
+	*x = EndSessionResponse{}
+	mi := &file_proto_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionResponse) ProtoMessage() {}
+
+func (x *EndSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionResponse.ProtoReflect.Descriptor instead.
+func (*EndSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EndSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSessionRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionRequestsRequest) Reset() {
+	*x = ListSessionRequestsRequest{}
+	mi := &file_proto_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionRequestsRequest) ProtoMessage() {}
+
+func (x *ListSessionRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{9}
+}
+
+type ListSessionRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*common.Session      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionRequestsResponse) Reset() {
+	*x = ListSessionRequestsResponse{}
+	mi := &file_proto_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionRequestsResponse) ProtoMessage() {}
+
+func (x *ListSessionRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSessionRequestsResponse) GetSessions() []*common.Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type AcceptSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSessionRequest) Reset() {
+	*x = AcceptSessionRequest{}
+	mi := &file_proto_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSessionRequest) ProtoMessage() {}
+
+func (x *AcceptSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSessionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type AcceptSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *common.Session        `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptSessionResponse) Reset() {
+	*x = AcceptSessionResponse{}
+	mi := &file_proto_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSessionResponse) ProtoMessage() {}
+
+func (x *AcceptSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSessionResponse.ProtoReflect.Descriptor instead.
+func (*AcceptSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AcceptSessionResponse) GetSession() *common.Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type DeclineSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineSessionRequest) Reset() {
+	*x = DeclineSessionRequest{}
+	mi := &file_proto_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineSessionRequest) ProtoMessage() {}
+
+func (x *DeclineSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeclineSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeclineSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type DeclineSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineSessionResponse) Reset() {
+	*x = DeclineSessionResponse{}
+	mi := &file_proto_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineSessionResponse) ProtoMessage() {}
+
+func (x *DeclineSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeclineSessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeclineSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetUnreadCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // optional, limits the counts to one session
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountsRequest) Reset() {
+	*x = GetUnreadCountsRequest{}
+	mi := &file_proto_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountsRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUnreadCountsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type SessionUnreadCount struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UnreadCount       int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	LastReadMessageId string                 `protobuf:"bytes,3,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"` // empty if nothing was read yet
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SessionUnreadCount) Reset() {
+	*x = SessionUnreadCount{}
+	mi := &file_proto_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionUnreadCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionUnreadCount) ProtoMessage() {}
+
+func (x *SessionUnreadCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionUnreadCount.ProtoReflect.Descriptor instead.
+func (*SessionUnreadCount) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SessionUnreadCount) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionUnreadCount) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *SessionUnreadCount) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
+type GetUnreadCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionUnreadCount  `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // sessions with unread messages, most recent first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountsResponse) Reset() {
+	*x = GetUnreadCountsResponse{}
+	mi := &file_proto_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountsResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUnreadCountsResponse) GetSessions() []*SessionUnreadCount {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *GetUnreadCountsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetAttachmentURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentURLRequest) Reset() {
+	*x = GetAttachmentURLRequest{}
+	mi := &file_proto_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentURLRequest) ProtoMessage() {}
+
+func (x *GetAttachmentURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentURLRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAttachmentURLRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type GetAttachmentURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *common.ChatAttachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"` // with a fresh download link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentURLResponse) Reset() {
+	*x = GetAttachmentURLResponse{}
+	mi := &file_proto_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentURLResponse) ProtoMessage() {}
+
+func (x *GetAttachmentURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentURLResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAttachmentURLResponse) GetAttachment() *common.ChatAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type SyncMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastMessageId string                 `protobuf:"bytes,1,opt,name=last_message_id,json=lastMessageId,proto3" json:"last_message_id,omitempty"` // last message the client has from any session, empty to sync everything
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncMessagesRequest) Reset() {
+	*x = SyncMessagesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMessagesRequest) ProtoMessage() {}
+
+func (x *SyncMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SyncMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SyncMessagesRequest) GetLastMessageId() string {
+	if x != nil {
+		return x.LastMessageId
+	}
+	return ""
+}
+
+func (x *SyncMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SyncMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*common.ChatMessage  `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`               // oldest first, across all of the caller's sessions
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // call again with the last message's ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncMessagesResponse) Reset() {
+	*x = SyncMessagesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMessagesResponse) ProtoMessage() {}
+
+func (x *SyncMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SyncMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SyncMessagesResponse) GetMessages() []*common.ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SyncMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SearchMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                          // words to find; messages containing all of them match, whole words, any case
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // optional, only this session
+	AdvisorId     string                 `protobuf:"bytes,3,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"` // optional, only sessions with this advisor (user ID)
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`                            // optional, RFC3339
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`                                // optional, RFC3339
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type MessageHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // offsets in characters into the snippet, end exclusive
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageHighlight) Reset() {
+	*x = MessageHighlight{}
+	mi := &file_proto_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageHighlight) ProtoMessage() {}
+
+func (x *MessageHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageHighlight.ProtoReflect.Descriptor instead.
+func (*MessageHighlight) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MessageHighlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MessageHighlight) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type MessageSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *common.ChatMessage    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	AdvisorId     string                 `protobuf:"bytes,2,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"` // the advisor of the message's session
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`                      // the parts of the message around the matches
+	Highlights    []*MessageHighlight    `protobuf:"bytes,4,rep,name=highlights,proto3" json:"highlights,omitempty"`                // the matches within the snippet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageSearchResult) Reset() {
+	*x = MessageSearchResult{}
+	mi := &file_proto_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSearchResult) ProtoMessage() {}
+
+func (x *MessageSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSearchResult.ProtoReflect.Descriptor instead.
+func (*MessageSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MessageSearchResult) GetMessage() *common.ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MessageSearchResult) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *MessageSearchResult) GetHighlights() []*MessageHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MessageSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                                    // newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchMessagesResponse) GetResults() []*MessageSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportTranscriptRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	SessionId     string                  `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Format        common.TranscriptFormat `protobuf:"varint,2,opt,name=format,proto3,enum=loveguru.common.TranscriptFormat" json:"format,omitempty"`
+	Email         bool                    `protobuf:"varint,3,opt,name=email,proto3" json:"email,omitempty"` // also email the transcript, as plain text, to the caller's address
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptRequest) Reset() {
+	*x = ExportTranscriptRequest{}
+	mi := &file_proto_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptRequest) ProtoMessage() {}
+
+func (x *ExportTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportTranscriptRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ExportTranscriptRequest) GetFormat() common.TranscriptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return common.TranscriptFormat(0)
+}
+
+func (x *ExportTranscriptRequest) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+type ExportTranscriptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	EmailedTo     string                 `protobuf:"bytes,4,opt,name=emailed_to,json=emailedTo,proto3" json:"emailed_to,omitempty"` // set when the transcript was emailed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptResponse) Reset() {
+	*x = ExportTranscriptResponse{}
+	mi := &file_proto_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptResponse) ProtoMessage() {}
+
+func (x *ExportTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportTranscriptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportTranscriptResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExportTranscriptResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportTranscriptResponse) GetEmailedTo() string {
+	if x != nil {
+		return x.EmailedTo
+	}
+	return ""
+}
+
+var File_proto_chat_proto protoreflect.FileDescriptor
+
+const file_proto_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/chat.proto\x12\rloveguru.chat\x1a\x12proto/common.proto\"\x95\x01\n" +
+	"\x14CreateSessionRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.loveguru.common.SessionTypeR\x04type\x12,\n" +
+	"\x12handoff_session_id\x18\x03 \x01(\tR\x10handoffSessionId\"K\n" +
+	"\x15CreateSessionResponse\x122\n" +
+	"\asession\x18\x01 \x01(\v2\x18.loveguru.common.SessionR\asession\"\xcf\x01\n" +
+	"\x12GetMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12*\n" +
+	"\x11before_message_id\x18\x04 \x01(\tR\x0fbeforeMessageId\x12(\n" +
+	"\x10after_message_id\x18\x05 \x01(\tR\x0eafterMessageId\x12\x16\n" +
+	"\x06latest\x18\x06 \x01(\bR\x06latest\"j\n" +
+	"\x13GetMessagesResponse\x128\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1c.loveguru.common.ChatMessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\x9b\x01\n" +
+	"\vChatMessage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12?\n" +
+	"\n" +
+	"attachment\x18\x04 \x01(\v2\x1f.loveguru.common.ChatAttachmentR\n" +
+	"attachment\"\x86\x02\n" +
+	"\x12ChatMessageRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12#\n" +
+	"\rattachment_id\x18\x03 \x01(\tR\fattachmentId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1b\n" +
+	"\tclient_id\x18\x05 \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x06 \x01(\tR\tmessageId\x12\x1f\n" +
+	"\vtemplate_id\x18\a \x01(\tR\n" +
+	"templateId\x12!\n" +
+	"\fresume_after\x18\b \x01(\tR\vresumeAfter\"\x9d\x04\n" +
+	"\x13ChatMessageResponse\x124\n" +
+	"\amessage\x18\x01 \x01(\v2\x1a.loveguru.chat.ChatMessageR\amessage\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tsender_id\x18\x04 \x01(\tR\bsenderId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tclient_id\x18\x06 \x01(\tR\bclientId\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp\x12\x1b\n" +
+	"\tedited_at\x18\b \x01(\tR\beditedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\t \x01(\tR\tdeletedAt\x12\x1c\n" +
+	"\tduplicate\x18\n" +
+	" \x01(\bR\tduplicate\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x12#\n" +
+	"\rconnection_id\x18\f \x01(\tR\fconnectionId\x12\x16\n" +
+	"\x06notice\x18\r \x01(\tR\x06notice\x12\x18\n" +
+	"\abalance\x18\x0e \x01(\x01R\abalance\x12!\n" +
+	"\fminutes_left\x18\x0f \x01(\x05R\vminutesLeft\x12\x16\n" +
+	"\x06reason\x18\x10 \x01(\tR\x06reason\x12$\n" +
+	"\x0eretry_after_ms\x18\x11 \x01(\x03R\fretryAfterMs\"2\n" +
+	"\x11EndSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\".\n" +
+	"\x12EndSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\x1aListSessionRequestsRequest\"S\n" +
+	"\x1bListSessionRequestsResponse\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.loveguru.common.SessionR\bsessions\"5\n" +
+	"\x14AcceptSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"K\n" +
+	"\x15AcceptSessionResponse\x122\n" +
+	"\asession\x18\x01 \x01(\v2\x18.loveguru.common.SessionR\asession\"6\n" +
+	"\x15DeclineSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"2\n" +
+	"\x16DeclineSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x16GetUnreadCountsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x87\x01\n" +
+	"\x12SessionUnreadCount\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\x12/\n" +
+	"\x14last_read_message_id\x18\x03 \x01(\tR\x11lastReadMessageId\"n\n" +
+	"\x17GetUnreadCountsResponse\x12=\n" +
+	"\bsessions\x18\x01 \x03(\v2!.loveguru.chat.SessionUnreadCountR\bsessions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\">\n" +
+	"\x17GetAttachmentURLRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"[\n" +
+	"\x18GetAttachmentURLResponse\x12?\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x1f.loveguru.common.ChatAttachmentR\n" +
+	"attachment\"S\n" +
+	"\x13SyncMessagesRequest\x12&\n" +
+	"\x0flast_message_id\x18\x01 \x01(\tR\rlastMessageId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"k\n" +
+	"\x14SyncMessagesResponse\x128\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1c.loveguru.common.ChatMessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xc4\x01\n" +
+	"\x15SearchMessagesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x03 \x01(\tR\tadvisorId\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\":\n" +
+	"\x10MessageHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"\xc7\x01\n" +
+	"\x13MessageSearchResult\x126\n" +
+	"\amessage\x18\x01 \x01(\v2\x1c.loveguru.common.ChatMessageR\amessage\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x02 \x01(\tR\tadvisorId\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\x12?\n" +
+	"\n" +
+	"highlights\x18\x04 \x03(\v2\x1f.loveguru.chat.MessageHighlightR\n" +
+	"highlights\"~\n" +
+	"\x16SearchMessagesResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".loveguru.chat.MessageSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x01\n" +
+	"\x17ExportTranscriptRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x129\n" +
+	"\x06format\x18\x02 \x01(\x0e2!.loveguru.common.TranscriptFormatR\x06format\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\bR\x05email\"\x8c\x01\n" +
+	"\x18ExportTranscriptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"emailed_to\x18\x04 \x01(\tR\temailedTo2\xf8\b\n" +
+	"\vChatService\x12Z\n" +
+	"\rCreateSession\x12#.loveguru.chat.CreateSessionRequest\x1a$.loveguru.chat.CreateSessionResponse\x12T\n" +
+	"\vGetMessages\x12!.loveguru.chat.GetMessagesRequest\x1a\".loveguru.chat.GetMessagesResponse\x12Q\n" +
+	"\n" +
+	"EndSession\x12 .loveguru.chat.EndSessionRequest\x1a!.loveguru.chat.EndSessionResponse\x12W\n" +
 	"\n" +
-	"ChatStream\x12!.loveguru.chat.ChatMessageRequest\x1a\".loveguru.chat.ChatMessageResponse(\x010\x01B\x15Z\x13loveguru/proto/chatb\x06proto3"
+	"ChatStream\x12!.loveguru.chat.ChatMessageRequest\x1a\".loveguru.chat.ChatMessageResponse(\x010\x01\x12l\n" +
+	"\x13ListSessionRequests\x12).loveguru.chat.ListSessionRequestsRequest\x1a*.loveguru.chat.ListSessionRequestsResponse\x12Z\n" +
+	"\rAcceptSession\x12#.loveguru.chat.AcceptSessionRequest\x1a$.loveguru.chat.AcceptSessionResponse\x12]\n" +
+	"\x0eDeclineSession\x12$.loveguru.chat.DeclineSessionRequest\x1a%.loveguru.chat.DeclineSessionResponse\x12`\n" +
+	"\x0fGetUnreadCounts\x12%.loveguru.chat.GetUnreadCountsRequest\x1a&.loveguru.chat.GetUnreadCountsResponse\x12c\n" +
+	"\x10GetAttachmentURL\x12&.loveguru.chat.GetAttachmentURLRequest\x1a'.loveguru.chat.GetAttachmentURLResponse\x12W\n" +
+	"\fSyncMessages\x12\".loveguru.chat.SyncMessagesRequest\x1a#.loveguru.chat.SyncMessagesResponse\x12]\n" +
+	"\x0eSearchMessages\x12$.loveguru.chat.SearchMessagesRequest\x1a%.loveguru.chat.SearchMessagesResponse\x12c\n" +
+	"\x10ExportTranscript\x12&.loveguru.chat.ExportTranscriptRequest\x1a'.loveguru.chat.ExportTranscriptResponseB\x15Z\x13loveguru/proto/chatb\x06proto3"
 
 var (
 	file_proto_chat_proto_rawDescOnce sync.Once
@@ -416,35 +1858,86 @@ func file_proto_chat_proto_rawDescGZIP() []byte {
 	return file_proto_chat_proto_rawDescData
 }
 
-var file_proto_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_proto_chat_proto_goTypes = []any{
-	(*CreateSessionRequest)(nil),  // 0: loveguru.chat.CreateSessionRequest
-	(*CreateSessionResponse)(nil), // 1: loveguru.chat.CreateSessionResponse
-	(*GetMessagesRequest)(nil),    // 2: loveguru.chat.GetMessagesRequest
-	(*GetMessagesResponse)(nil),   // 3: loveguru.chat.GetMessagesResponse
-	(*ChatMessage)(nil),           // 4: loveguru.chat.ChatMessage
-	(*ChatMessageRequest)(nil),    // 5: loveguru.chat.ChatMessageRequest
-	(*ChatMessageResponse)(nil),   // 6: loveguru.chat.ChatMessageResponse
-	(common.SessionType)(0),       // 7: loveguru.common.SessionType
-	(*common.Session)(nil),        // 8: loveguru.common.Session
-	(*common.ChatMessage)(nil),    // 9: loveguru.common.ChatMessage
+	(*CreateSessionRequest)(nil),        // 0: loveguru.chat.CreateSessionRequest
+	(*CreateSessionResponse)(nil),       // 1: loveguru.chat.CreateSessionResponse
+	(*GetMessagesRequest)(nil),          // 2: loveguru.chat.GetMessagesRequest
+	(*GetMessagesResponse)(nil),         // 3: loveguru.chat.GetMessagesResponse
+	(*ChatMessage)(nil),                 // 4: loveguru.chat.ChatMessage
+	(*ChatMessageRequest)(nil),          // 5: loveguru.chat.ChatMessageRequest
+	(*ChatMessageResponse)(nil),         // 6: loveguru.chat.ChatMessageResponse
+	(*EndSessionRequest)(nil),           // 7: loveguru.chat.EndSessionRequest
+	(*EndSessionResponse)(nil),          // 8: loveguru.chat.EndSessionResponse
+	(*ListSessionRequestsRequest)(nil),  // 9: loveguru.chat.ListSessionRequestsRequest
+	(*ListSessionRequestsResponse)(nil), // 10: loveguru.chat.ListSessionRequestsResponse
+	(*AcceptSessionRequest)(nil),        // 11: loveguru.chat.AcceptSessionRequest
+	(*AcceptSessionResponse)(nil),       // 12: loveguru.chat.AcceptSessionResponse
+	(*DeclineSessionRequest)(nil),       // 13: loveguru.chat.DeclineSessionRequest
+	(*DeclineSessionResponse)(nil),      // 14: loveguru.chat.DeclineSessionResponse
+	(*GetUnreadCountsRequest)(nil),      // 15: loveguru.chat.GetUnreadCountsRequest
+	(*SessionUnreadCount)(nil),          // 16: loveguru.chat.SessionUnreadCount
+	(*GetUnreadCountsResponse)(nil),     // 17: loveguru.chat.GetUnreadCountsResponse
+	(*GetAttachmentURLRequest)(nil),     // 18: loveguru.chat.GetAttachmentURLRequest
+	(*GetAttachmentURLResponse)(nil),    // 19: loveguru.chat.GetAttachmentURLResponse
+	(*SyncMessagesRequest)(nil),         // 20: loveguru.chat.SyncMessagesRequest
+	(*SyncMessagesResponse)(nil),        // 21: loveguru.chat.SyncMessagesResponse
+	(*SearchMessagesRequest)(nil),       // 22: loveguru.chat.SearchMessagesRequest
+	(*MessageHighlight)(nil),            // 23: loveguru.chat.MessageHighlight
+	(*MessageSearchResult)(nil),         // 24: loveguru.chat.MessageSearchResult
+	(*SearchMessagesResponse)(nil),      // 25: loveguru.chat.SearchMessagesResponse
+	(*ExportTranscriptRequest)(nil),     // 26: loveguru.chat.ExportTranscriptRequest
+	(*ExportTranscriptResponse)(nil),    // 27: loveguru.chat.ExportTranscriptResponse
+	(common.SessionType)(0),             // 28: loveguru.common.SessionType
+	(*common.Session)(nil),              // 29: loveguru.common.Session
+	(*common.ChatMessage)(nil),          // 30: loveguru.common.ChatMessage
+	(*common.ChatAttachment)(nil),       // 31: loveguru.common.ChatAttachment
+	(common.TranscriptFormat)(0),        // 32: loveguru.common.TranscriptFormat
 }
 var file_proto_chat_proto_depIdxs = []int32{
-	7, // 0: loveguru.chat.CreateSessionRequest.type:type_name -> loveguru.common.SessionType
-	8, // 1: loveguru.chat.CreateSessionResponse.session:type_name -> loveguru.common.Session
-	9, // 2: loveguru.chat.GetMessagesResponse.messages:type_name -> loveguru.common.ChatMessage
-	4, // 3: loveguru.chat.ChatMessageResponse.message:type_name -> loveguru.chat.ChatMessage
-	0, // 4: loveguru.chat.ChatService.CreateSession:input_type -> loveguru.chat.CreateSessionRequest
-	2, // 5: loveguru.chat.ChatService.GetMessages:input_type -> loveguru.chat.GetMessagesRequest
-	5, // 6: loveguru.chat.ChatService.ChatStream:input_type -> loveguru.chat.ChatMessageRequest
-	1, // 7: loveguru.chat.ChatService.CreateSession:output_type -> loveguru.chat.CreateSessionResponse
-	3, // 8: loveguru.chat.ChatService.GetMessages:output_type -> loveguru.chat.GetMessagesResponse
-	6, // 9: loveguru.chat.ChatService.ChatStream:output_type -> loveguru.chat.ChatMessageResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	28, // 0: loveguru.chat.CreateSessionRequest.type:type_name -> loveguru.common.SessionType
+	29, // 1: loveguru.chat.CreateSessionResponse.session:type_name -> loveguru.common.Session
+	30, // 2: loveguru.chat.GetMessagesResponse.messages:type_name -> loveguru.common.ChatMessage
+	31, // 3: loveguru.chat.ChatMessage.attachment:type_name -> loveguru.common.ChatAttachment
+	4,  // 4: loveguru.chat.ChatMessageResponse.message:type_name -> loveguru.chat.ChatMessage
+	29, // 5: loveguru.chat.ListSessionRequestsResponse.sessions:type_name -> loveguru.common.Session
+	29, // 6: loveguru.chat.AcceptSessionResponse.session:type_name -> loveguru.common.Session
+	16, // 7: loveguru.chat.GetUnreadCountsResponse.sessions:type_name -> loveguru.chat.SessionUnreadCount
+	31, // 8: loveguru.chat.GetAttachmentURLResponse.attachment:type_name -> loveguru.common.ChatAttachment
+	30, // 9: loveguru.chat.SyncMessagesResponse.messages:type_name -> loveguru.common.ChatMessage
+	30, // 10: loveguru.chat.MessageSearchResult.message:type_name -> loveguru.common.ChatMessage
+	23, // 11: loveguru.chat.MessageSearchResult.highlights:type_name -> loveguru.chat.MessageHighlight
+	24, // 12: loveguru.chat.SearchMessagesResponse.results:type_name -> loveguru.chat.MessageSearchResult
+	32, // 13: loveguru.chat.ExportTranscriptRequest.format:type_name -> loveguru.common.TranscriptFormat
+	0,  // 14: loveguru.chat.ChatService.CreateSession:input_type -> loveguru.chat.CreateSessionRequest
+	2,  // 15: loveguru.chat.ChatService.GetMessages:input_type -> loveguru.chat.GetMessagesRequest
+	7,  // 16: loveguru.chat.ChatService.EndSession:input_type -> loveguru.chat.EndSessionRequest
+	5,  // 17: loveguru.chat.ChatService.ChatStream:input_type -> loveguru.chat.ChatMessageRequest
+	9,  // 18: loveguru.chat.ChatService.ListSessionRequests:input_type -> loveguru.chat.ListSessionRequestsRequest
+	11, // 19: loveguru.chat.ChatService.AcceptSession:input_type -> loveguru.chat.AcceptSessionRequest
+	13, // 20: loveguru.chat.ChatService.DeclineSession:input_type -> loveguru.chat.DeclineSessionRequest
+	15, // 21: loveguru.chat.ChatService.GetUnreadCounts:input_type -> loveguru.chat.GetUnreadCountsRequest
+	18, // 22: loveguru.chat.ChatService.GetAttachmentURL:input_type -> loveguru.chat.GetAttachmentURLRequest
+	20, // 23: loveguru.chat.ChatService.SyncMessages:input_type -> loveguru.chat.SyncMessagesRequest
+	22, // 24: loveguru.chat.ChatService.SearchMessages:input_type -> loveguru.chat.SearchMessagesRequest
+	26, // 25: loveguru.chat.ChatService.ExportTranscript:input_type -> loveguru.chat.ExportTranscriptRequest
+	1,  // 26: loveguru.chat.ChatService.CreateSession:output_type -> loveguru.chat.CreateSessionResponse
+	3,  // 27: loveguru.chat.ChatService.GetMessages:output_type -> loveguru.chat.GetMessagesResponse
+	8,  // 28: loveguru.chat.ChatService.EndSession:output_type -> loveguru.chat.EndSessionResponse
+	6,  // 29: loveguru.chat.ChatService.ChatStream:output_type -> loveguru.chat.ChatMessageResponse
+	10, // 30: loveguru.chat.ChatService.ListSessionRequests:output_type -> loveguru.chat.ListSessionRequestsResponse
+	12, // 31: loveguru.chat.ChatService.AcceptSession:output_type -> loveguru.chat.AcceptSessionResponse
+	14, // 32: loveguru.chat.ChatService.DeclineSession:output_type -> loveguru.chat.DeclineSessionResponse
+	17, // 33: loveguru.chat.ChatService.GetUnreadCounts:output_type -> loveguru.chat.GetUnreadCountsResponse
+	19, // 34: loveguru.chat.ChatService.GetAttachmentURL:output_type -> loveguru.chat.GetAttachmentURLResponse
+	21, // 35: loveguru.chat.ChatService.SyncMessages:output_type -> loveguru.chat.SyncMessagesResponse
+	25, // 36: loveguru.chat.ChatService.SearchMessages:output_type -> loveguru.chat.SearchMessagesResponse
+	27, // 37: loveguru.chat.ChatService.ExportTranscript:output_type -> loveguru.chat.ExportTranscriptResponse
+	26, // [26:38] is the sub-list for method output_type
+	14, // [14:26] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_proto_chat_proto_init() }
@@ -458,7 +1951,7 @@ func file_proto_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_chat_proto_rawDesc), len(file_proto_chat_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
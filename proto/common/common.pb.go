@@ -174,6 +174,9 @@ const (
 	SessionStatus_ONGOING   SessionStatus = 0
 	SessionStatus_ENDED     SessionStatus = 1
 	SessionStatus_CANCELLED SessionStatus = 2
+	SessionStatus_REQUESTED SessionStatus = 3 // waiting for the advisor to accept
+	SessionStatus_DECLINED  SessionStatus = 4
+	SessionStatus_EXPIRED   SessionStatus = 5 // the advisor did not answer in time
 )
 
 // Enum value maps for SessionStatus.
@@ -182,11 +185,17 @@ var (
 		0: "ONGOING",
 		1: "ENDED",
 		2: "CANCELLED",
+		3: "REQUESTED",
+		4: "DECLINED",
+		5: "EXPIRED",
 	}
 	SessionStatus_value = map[string]int32{
 		"ONGOING":   0,
 		"ENDED":     1,
 		"CANCELLED": 2,
+		"REQUESTED": 3,
+		"DECLINED":  4,
+		"EXPIRED":   5,
 	}
 )
 
@@ -217,6 +226,55 @@ func (SessionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_common_proto_rawDescGZIP(), []int{3}
 }
 
+type TranscriptFormat int32
+
+const (
+	TranscriptFormat_JSON TranscriptFormat = 0
+	TranscriptFormat_TEXT TranscriptFormat = 1
+	TranscriptFormat_HTML TranscriptFormat = 2 // a standalone page for printing
+)
+
+// Enum value maps for TranscriptFormat.
+var (
+	TranscriptFormat_name = map[int32]string{
+		0: "JSON",
+		1: "TEXT",
+		2: "HTML",
+	}
+	TranscriptFormat_value = map[string]int32{
+		"JSON": 0,
+		"TEXT": 1,
+		"HTML": 2,
+	}
+)
+
+func (x TranscriptFormat) Enum() *TranscriptFormat {
+	p := new(TranscriptFormat)
+	*p = x
+	return p
+}
+
+func (x TranscriptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TranscriptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_common_proto_enumTypes[4].Descriptor()
+}
+
+func (TranscriptFormat) Type() protoreflect.EnumType {
+	return &file_proto_common_proto_enumTypes[4]
+}
+
+func (x TranscriptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TranscriptFormat.Descriptor instead.
+func (TranscriptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{4}
+}
+
 type AdvisorStatus int32
 
 const (
@@ -253,11 +311,11 @@ func (x AdvisorStatus) String() string {
 }
 
 func (AdvisorStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_common_proto_enumTypes[4].Descriptor()
+	return file_proto_common_proto_enumTypes[5].Descriptor()
 }
 
 func (AdvisorStatus) Type() protoreflect.EnumType {
-	return &file_proto_common_proto_enumTypes[4]
+	return &file_proto_common_proto_enumTypes[5]
 }
 
 func (x AdvisorStatus) Number() protoreflect.EnumNumber {
@@ -266,7 +324,65 @@ func (x AdvisorStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AdvisorStatus.Descriptor instead.
 func (AdvisorStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{4}
+	return file_proto_common_proto_rawDescGZIP(), []int{5}
+}
+
+type ApplicationStatus int32
+
+const (
+	ApplicationStatus_DRAFT         ApplicationStatus = 0
+	ApplicationStatus_SUBMITTED     ApplicationStatus = 1
+	ApplicationStatus_UNDER_REVIEW  ApplicationStatus = 2
+	ApplicationStatus_NEEDS_CHANGES ApplicationStatus = 3
+	ApplicationStatus_APPROVED      ApplicationStatus = 4
+	ApplicationStatus_REJECTED      ApplicationStatus = 5
+)
+
+// Enum value maps for ApplicationStatus.
+var (
+	ApplicationStatus_name = map[int32]string{
+		0: "DRAFT",
+		1: "SUBMITTED",
+		2: "UNDER_REVIEW",
+		3: "NEEDS_CHANGES",
+		4: "APPROVED",
+		5: "REJECTED",
+	}
+	ApplicationStatus_value = map[string]int32{
+		"DRAFT":         0,
+		"SUBMITTED":     1,
+		"UNDER_REVIEW":  2,
+		"NEEDS_CHANGES": 3,
+		"APPROVED":      4,
+		"REJECTED":      5,
+	}
+)
+
+func (x ApplicationStatus) Enum() *ApplicationStatus {
+	p := new(ApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x ApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_common_proto_enumTypes[6].Descriptor()
+}
+
+func (ApplicationStatus) Type() protoreflect.EnumType {
+	return &file_proto_common_proto_enumTypes[6]
+}
+
+func (x ApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationStatus.Descriptor instead.
+func (ApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{6}
 }
 
 type User struct {
@@ -526,6 +642,9 @@ type Session struct {
 	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
 	EndedAt       string                 `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
 	Status        SessionStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=loveguru.common.SessionStatus" json:"status,omitempty"`
+	Pricing       *AdvisorPricing        `protobuf:"bytes,8,opt,name=pricing,proto3" json:"pricing,omitempty"`                                   // price snapshot taken when the session was created
+	BilledMinutes int32                  `protobuf:"varint,9,opt,name=billed_minutes,json=billedMinutes,proto3" json:"billed_minutes,omitempty"` // paid chats, minutes charged so far
+	BilledAmount  float64                `protobuf:"fixed64,10,opt,name=billed_amount,json=billedAmount,proto3" json:"billed_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -609,17 +728,44 @@ func (x *Session) GetStatus() SessionStatus {
 	return SessionStatus_ONGOING
 }
 
+func (x *Session) GetPricing() *AdvisorPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *Session) GetBilledMinutes() int32 {
+	if x != nil {
+		return x.BilledMinutes
+	}
+	return 0
+}
+
+func (x *Session) GetBilledAmount() float64 {
+	if x != nil {
+		return x.BilledAmount
+	}
+	return 0
+}
+
 type ChatMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SenderType    string                 `protobuf:"bytes,3,opt,name=sender_type,json=senderType,proto3" json:"sender_type,omitempty"` // USER, ADVISOR, AI
-	SenderId      string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsRead        bool                   `protobuf:"varint,7,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionId       string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SenderType      string                 `protobuf:"bytes,3,opt,name=sender_type,json=senderType,proto3" json:"sender_type,omitempty"` // USER, ADVISOR, AI
+	SenderId        string                 `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Content         string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsRead          bool                   `protobuf:"varint,7,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	EditedAt        string                 `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`     // empty unless the sender edited the message
+	IsDeleted       bool                   `protobuf:"varint,9,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"` // deleted for everyone, content is empty
+	DeletedAt       string                 `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Kind            string                 `protobuf:"bytes,11,opt,name=kind,proto3" json:"kind,omitempty"` // TEXT or ATTACHMENT; content is the caption of an attachment
+	Attachment      *ChatAttachment        `protobuf:"bytes,12,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	ClientMessageId string                 `protobuf:"bytes,13,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"` // the ID the sender's client gave the message, if any
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ChatMessage) Reset() {
@@ -701,6 +847,140 @@ func (x *ChatMessage) GetIsRead() bool {
 	return false
 }
 
+func (x *ChatMessage) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *ChatMessage) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAttachment() *ChatAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+type ChatAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // IMAGE or VOICE
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	DurationMs    int32                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // voice notes, as reported by the sender
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                                  // signed download link, works without authentication until url_expires_at
+	UrlExpiresAt  string                 `protobuf:"bytes,7,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatAttachment) Reset() {
+	*x = ChatAttachment{}
+	mi := &file_proto_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatAttachment) ProtoMessage() {}
+
+func (x *ChatAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatAttachment.ProtoReflect.Descriptor instead.
+func (*ChatAttachment) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChatAttachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatAttachment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChatAttachment) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ChatAttachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ChatAttachment) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ChatAttachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ChatAttachment) GetUrlExpiresAt() string {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return ""
+}
+
 type Rating struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -716,7 +996,7 @@ type Rating struct {
 
 func (x *Rating) Reset() {
 	*x = Rating{}
-	mi := &file_proto_common_proto_msgTypes[4]
+	mi := &file_proto_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -728,7 +1008,7 @@ func (x *Rating) String() string {
 func (*Rating) ProtoMessage() {}
 
 func (x *Rating) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[4]
+	mi := &file_proto_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +1021,7 @@ func (x *Rating) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rating.ProtoReflect.Descriptor instead.
 func (*Rating) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{4}
+	return file_proto_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Rating) GetId() string {
@@ -793,29 +1073,33 @@ func (x *Rating) GetCreatedAt() string {
 	return ""
 }
 
-type Tokens struct {
+type CredentialDocument struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	SizeBytes     int32                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tokens) Reset() {
-	*x = Tokens{}
-	mi := &file_proto_common_proto_msgTypes[5]
+func (x *CredentialDocument) Reset() {
+	*x = CredentialDocument{}
+	mi := &file_proto_common_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tokens) String() string {
+func (x *CredentialDocument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tokens) ProtoMessage() {}
+func (*CredentialDocument) ProtoMessage() {}
 
-func (x *Tokens) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_common_proto_msgTypes[5]
+func (x *CredentialDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,83 +1110,1064 @@ func (x *Tokens) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tokens.ProtoReflect.Descriptor instead.
-func (*Tokens) Descriptor() ([]byte, []int) {
-	return file_proto_common_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CredentialDocument.ProtoReflect.Descriptor instead.
+func (*CredentialDocument) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Tokens) GetAccessToken() string {
+func (x *CredentialDocument) GetId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Tokens) GetRefreshToken() string {
+func (x *CredentialDocument) GetApplicationId() string {
 	if x != nil {
-		return x.RefreshToken
+		return x.ApplicationId
 	}
 	return ""
 }
 
-var File_proto_common_proto protoreflect.FileDescriptor
+func (x *CredentialDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
 
-const file_proto_common_proto_rawDesc = "" +
-	"\n" +
-	"\x12proto/common.proto\x12\x0floveguru.common\"\xd3\x02\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12#\n" +
-	"\rpassword_hash\x18\x04 \x01(\tR\fpasswordHash\x12!\n" +
-	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12)\n" +
-	"\x04role\x18\x06 \x01(\x0e2\x15.loveguru.common.RoleR\x04role\x12/\n" +
-	"\x06gender\x18\a \x01(\x0e2\x17.loveguru.common.GenderR\x06gender\x12\x10\n" +
-	"\x03dob\x18\b \x01(\tR\x03dob\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\x12\x1b\n" +
-	"\tis_active\x18\v \x01(\bR\bisActive\"\xef\x02\n" +
-	"\aAdvisor\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
-	"\x03bio\x18\x03 \x01(\tR\x03bio\x12)\n" +
-	"\x10experience_years\x18\x04 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
-	"\tlanguages\x18\x05 \x03(\tR\tlanguages\x12(\n" +
-	"\x0fspecializations\x18\x06 \x03(\tR\x0fspecializations\x12\x1f\n" +
-	"\vis_verified\x18\a \x01(\bR\n" +
-	"isVerified\x12\x1f\n" +
-	"\vhourly_rate\x18\b \x01(\x01R\n" +
-	"hourlyRate\x126\n" +
-	"\x06status\x18\t \x01(\x0e2\x1e.loveguru.common.AdvisorStatusR\x06status\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\n" +
-	" \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xf5\x01\n" +
-	"\aSession\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
-	"\n" +
-	"advisor_id\x18\x03 \x01(\tR\tadvisorId\x120\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x1c.loveguru.common.SessionTypeR\x04type\x12\x1d\n" +
-	"\n" +
-	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\x19\n" +
-	"\bended_at\x18\x06 \x01(\tR\aendedAt\x126\n" +
-	"\x06status\x18\a \x01(\x0e2\x1e.loveguru.common.SessionStatusR\x06status\"\xcc\x01\n" +
-	"\vChatMessage\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1f\n" +
-	"\vsender_type\x18\x03 \x01(\tR\n" +
+func (x *CredentialDocument) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *CredentialDocument) GetSizeBytes() int32 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CredentialDocument) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdvisorApplication struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdvisorId       string                 `protobuf:"bytes,2,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status          ApplicationStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=loveguru.common.ApplicationStatus" json:"status,omitempty"`
+	ReviewerNotes   string                 `protobuf:"bytes,5,opt,name=reviewer_notes,json=reviewerNotes,proto3" json:"reviewer_notes,omitempty"`       // only populated for admins
+	RejectionReason string                 `protobuf:"bytes,6,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"` // shown to the applicant for NEEDS_CHANGES and REJECTED
+	SubmittedAt     string                 `protobuf:"bytes,7,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ReviewedAt      string                 `protobuf:"bytes,8,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Documents       []*CredentialDocument  `protobuf:"bytes,11,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdvisorApplication) Reset() {
+	*x = AdvisorApplication{}
+	mi := &file_proto_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvisorApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvisorApplication) ProtoMessage() {}
+
+func (x *AdvisorApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvisorApplication.ProtoReflect.Descriptor instead.
+func (*AdvisorApplication) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdvisorApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_DRAFT
+}
+
+func (x *AdvisorApplication) GetReviewerNotes() string {
+	if x != nil {
+		return x.ReviewerNotes
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *AdvisorApplication) GetDocuments() []*CredentialDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type EarningLineItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionId         string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionType       SessionType            `protobuf:"varint,3,opt,name=session_type,json=sessionType,proto3,enum=loveguru.common.SessionType" json:"session_type,omitempty"`
+	DurationSeconds   int32                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	HourlyRate        float64                `protobuf:"fixed64,5,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	GrossAmount       float64                `protobuf:"fixed64,6,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	CommissionPercent float64                `protobuf:"fixed64,7,opt,name=commission_percent,json=commissionPercent,proto3" json:"commission_percent,omitempty"`
+	CommissionAmount  float64                `protobuf:"fixed64,8,opt,name=commission_amount,json=commissionAmount,proto3" json:"commission_amount,omitempty"`
+	NetAmount         float64                `protobuf:"fixed64,9,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	PayoutBatchId     string                 `protobuf:"bytes,10,opt,name=payout_batch_id,json=payoutBatchId,proto3" json:"payout_batch_id,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EarningLineItem) Reset() {
+	*x = EarningLineItem{}
+	mi := &file_proto_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EarningLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EarningLineItem) ProtoMessage() {}
+
+func (x *EarningLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EarningLineItem.ProtoReflect.Descriptor instead.
+func (*EarningLineItem) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EarningLineItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EarningLineItem) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *EarningLineItem) GetSessionType() SessionType {
+	if x != nil {
+		return x.SessionType
+	}
+	return SessionType_CHAT
+}
+
+func (x *EarningLineItem) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *EarningLineItem) GetHourlyRate() float64 {
+	if x != nil {
+		return x.HourlyRate
+	}
+	return 0
+}
+
+func (x *EarningLineItem) GetGrossAmount() float64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *EarningLineItem) GetCommissionPercent() float64 {
+	if x != nil {
+		return x.CommissionPercent
+	}
+	return 0
+}
+
+func (x *EarningLineItem) GetCommissionAmount() float64 {
+	if x != nil {
+		return x.CommissionAmount
+	}
+	return 0
+}
+
+func (x *EarningLineItem) GetNetAmount() float64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *EarningLineItem) GetPayoutBatchId() string {
+	if x != nil {
+		return x.PayoutBatchId
+	}
+	return ""
+}
+
+func (x *EarningLineItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type PayoutBatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdvisorId        string                 `protobuf:"bytes,2,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // PENDING, PAID
+	PeriodStart      string                 `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd        string                 `protobuf:"bytes,5,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	EarningsCount    int32                  `protobuf:"varint,6,opt,name=earnings_count,json=earningsCount,proto3" json:"earnings_count,omitempty"`
+	TotalAmount      float64                `protobuf:"fixed64,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	PaidAt           string                 `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	PaymentReference string                 `protobuf:"bytes,9,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PayoutBatch) Reset() {
+	*x = PayoutBatch{}
+	mi := &file_proto_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutBatch) ProtoMessage() {}
+
+func (x *PayoutBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutBatch.ProtoReflect.Descriptor instead.
+func (*PayoutBatch) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PayoutBatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetEarningsCount() int32 {
+	if x != nil {
+		return x.EarningsCount
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *PayoutBatch) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+func (x *PayoutBatch) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BalanceTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`       // CHARGE, CREDIT
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // negative for charges
+	BalanceAfter  float64                `protobuf:"fixed64,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	SessionId     string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // CHARGE, the chat that was charged
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`                            // CREDIT
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceTransaction) Reset() {
+	*x = BalanceTransaction{}
+	mi := &file_proto_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceTransaction) ProtoMessage() {}
+
+func (x *BalanceTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceTransaction.ProtoReflect.Descriptor instead.
+func (*BalanceTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BalanceTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BalanceTransaction) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BalanceTransaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BalanceTransaction) GetBalanceAfter() float64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *BalanceTransaction) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BalanceTransaction) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *BalanceTransaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdvisorPricing struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	SessionType                 string                 `protobuf:"bytes,1,opt,name=session_type,json=sessionType,proto3" json:"session_type,omitempty"` // CHAT, CALL, AI_HANDOFF
+	PerMinuteRate               float64                `protobuf:"fixed64,2,opt,name=per_minute_rate,json=perMinuteRate,proto3" json:"per_minute_rate,omitempty"`
+	MinBillableMinutes          int32                  `protobuf:"varint,3,opt,name=min_billable_minutes,json=minBillableMinutes,proto3" json:"min_billable_minutes,omitempty"`
+	FirstSessionDiscountPercent float64                `protobuf:"fixed64,4,opt,name=first_session_discount_percent,json=firstSessionDiscountPercent,proto3" json:"first_session_discount_percent,omitempty"`
+	Currency                    string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AdvisorPricing) Reset() {
+	*x = AdvisorPricing{}
+	mi := &file_proto_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvisorPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvisorPricing) ProtoMessage() {}
+
+func (x *AdvisorPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvisorPricing.ProtoReflect.Descriptor instead.
+func (*AdvisorPricing) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AdvisorPricing) GetSessionType() string {
+	if x != nil {
+		return x.SessionType
+	}
+	return ""
+}
+
+func (x *AdvisorPricing) GetPerMinuteRate() float64 {
+	if x != nil {
+		return x.PerMinuteRate
+	}
+	return 0
+}
+
+func (x *AdvisorPricing) GetMinBillableMinutes() int32 {
+	if x != nil {
+		return x.MinBillableMinutes
+	}
+	return 0
+}
+
+func (x *AdvisorPricing) GetFirstSessionDiscountPercent() float64 {
+	if x != nil {
+		return x.FirstSessionDiscountPercent
+	}
+	return 0
+}
+
+func (x *AdvisorPricing) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type MessageTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdvisorId     string                 `protobuf:"bytes,2,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"` // empty for platform templates
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"` // may contain {{user_name}} and {{advisor_name}}
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	IsPlatform    bool                   `protobuf:"varint,6,opt,name=is_platform,json=isPlatform,proto3" json:"is_platform,omitempty"`
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageTemplate) Reset() {
+	*x = MessageTemplate{}
+	mi := &file_proto_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageTemplate) ProtoMessage() {}
+
+func (x *MessageTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageTemplate.ProtoReflect.Descriptor instead.
+func (*MessageTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MessageTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetIsPlatform() bool {
+	if x != nil {
+		return x.IsPlatform
+	}
+	return false
+}
+
+func (x *MessageTemplate) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *MessageTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AdvisorStats struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	From                       string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                         string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	SessionCount               int32                  `protobuf:"varint,3,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	AcceptanceRate             float64                `protobuf:"fixed64,4,opt,name=acceptance_rate,json=acceptanceRate,proto3" json:"acceptance_rate,omitempty"`                                         // share of sessions the advisor replied to or answered
+	MedianFirstResponseSeconds float64                `protobuf:"fixed64,5,opt,name=median_first_response_seconds,json=medianFirstResponseSeconds,proto3" json:"median_first_response_seconds,omitempty"` // chat only
+	AverageSessionSeconds      float64                `protobuf:"fixed64,6,opt,name=average_session_seconds,json=averageSessionSeconds,proto3" json:"average_session_seconds,omitempty"`
+	RepeatClientRate           float64                `protobuf:"fixed64,7,opt,name=repeat_client_rate,json=repeatClientRate,proto3" json:"repeat_client_rate,omitempty"` // share of sessions from returning clients
+	MissedCalls                int32                  `protobuf:"varint,8,opt,name=missed_calls,json=missedCalls,proto3" json:"missed_calls,omitempty"`
+	AverageRating              float64                `protobuf:"fixed64,9,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingCount                int32                  `protobuf:"varint,10,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *AdvisorStats) Reset() {
+	*x = AdvisorStats{}
+	mi := &file_proto_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvisorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvisorStats) ProtoMessage() {}
+
+func (x *AdvisorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvisorStats.ProtoReflect.Descriptor instead.
+func (*AdvisorStats) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AdvisorStats) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AdvisorStats) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AdvisorStats) GetSessionCount() int32 {
+	if x != nil {
+		return x.SessionCount
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetAcceptanceRate() float64 {
+	if x != nil {
+		return x.AcceptanceRate
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetMedianFirstResponseSeconds() float64 {
+	if x != nil {
+		return x.MedianFirstResponseSeconds
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetAverageSessionSeconds() float64 {
+	if x != nil {
+		return x.AverageSessionSeconds
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetRepeatClientRate() float64 {
+	if x != nil {
+		return x.RepeatClientRate
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetMissedCalls() int32 {
+	if x != nil {
+		return x.MissedCalls
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *AdvisorStats) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type RatingTrendPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart     string                 `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,2,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,3,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingTrendPoint) Reset() {
+	*x = RatingTrendPoint{}
+	mi := &file_proto_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingTrendPoint) ProtoMessage() {}
+
+func (x *RatingTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingTrendPoint.ProtoReflect.Descriptor instead.
+func (*RatingTrendPoint) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RatingTrendPoint) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *RatingTrendPoint) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *RatingTrendPoint) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type AdvisorStatsReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       *AdvisorStats          `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	Previous      *AdvisorStats          `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`                          // the period of the same length just before current
+	RatingTrend   []*RatingTrendPoint    `protobuf:"bytes,3,rep,name=rating_trend,json=ratingTrend,proto3" json:"rating_trend,omitempty"` // weekly, over current
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvisorStatsReport) Reset() {
+	*x = AdvisorStatsReport{}
+	mi := &file_proto_common_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvisorStatsReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvisorStatsReport) ProtoMessage() {}
+
+func (x *AdvisorStatsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvisorStatsReport.ProtoReflect.Descriptor instead.
+func (*AdvisorStatsReport) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AdvisorStatsReport) GetCurrent() *AdvisorStats {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *AdvisorStatsReport) GetPrevious() *AdvisorStats {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *AdvisorStatsReport) GetRatingTrend() []*RatingTrendPoint {
+	if x != nil {
+		return x.RatingTrend
+	}
+	return nil
+}
+
+type Tokens struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tokens) Reset() {
+	*x = Tokens{}
+	mi := &file_proto_common_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tokens) ProtoMessage() {}
+
+func (x *Tokens) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_common_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tokens.ProtoReflect.Descriptor instead.
+func (*Tokens) Descriptor() ([]byte, []int) {
+	return file_proto_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Tokens) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *Tokens) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+var File_proto_common_proto protoreflect.FileDescriptor
+
+const file_proto_common_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/common.proto\x12\x0floveguru.common\"\xd3\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12#\n" +
+	"\rpassword_hash\x18\x04 \x01(\tR\fpasswordHash\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12)\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x15.loveguru.common.RoleR\x04role\x12/\n" +
+	"\x06gender\x18\a \x01(\x0e2\x17.loveguru.common.GenderR\x06gender\x12\x10\n" +
+	"\x03dob\x18\b \x01(\tR\x03dob\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\"\xef\x02\n" +
+	"\aAdvisor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12)\n" +
+	"\x10experience_years\x18\x04 \x01(\x05R\x0fexperienceYears\x12\x1c\n" +
+	"\tlanguages\x18\x05 \x03(\tR\tlanguages\x12(\n" +
+	"\x0fspecializations\x18\x06 \x03(\tR\x0fspecializations\x12\x1f\n" +
+	"\vis_verified\x18\a \x01(\bR\n" +
+	"isVerified\x12\x1f\n" +
+	"\vhourly_rate\x18\b \x01(\x01R\n" +
+	"hourlyRate\x126\n" +
+	"\x06status\x18\t \x01(\x0e2\x1e.loveguru.common.AdvisorStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xfc\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x03 \x01(\tR\tadvisorId\x120\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1c.loveguru.common.SessionTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x06 \x01(\tR\aendedAt\x126\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.loveguru.common.SessionStatusR\x06status\x129\n" +
+	"\apricing\x18\b \x01(\v2\x1f.loveguru.common.AdvisorPricingR\apricing\x12%\n" +
+	"\x0ebilled_minutes\x18\t \x01(\x05R\rbilledMinutes\x12#\n" +
+	"\rbilled_amount\x18\n" +
+	" \x01(\x01R\fbilledAmount\"\xa8\x03\n" +
+	"\vChatMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vsender_type\x18\x03 \x01(\tR\n" +
 	"senderType\x12\x1b\n" +
 	"\tsender_id\x18\x04 \x01(\tR\bsenderId\x12\x18\n" +
 	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x17\n" +
-	"\ais_read\x18\a \x01(\bR\x06isRead\"\xc7\x01\n" +
+	"\ais_read\x18\a \x01(\bR\x06isRead\x12\x1b\n" +
+	"\tedited_at\x18\b \x01(\tR\beditedAt\x12\x1d\n" +
+	"\n" +
+	"is_deleted\x18\t \x01(\bR\tisDeleted\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\tR\tdeletedAt\x12\x12\n" +
+	"\x04kind\x18\v \x01(\tR\x04kind\x12?\n" +
+	"\n" +
+	"attachment\x18\f \x01(\v2\x1f.loveguru.common.ChatAttachmentR\n" +
+	"attachment\x12*\n" +
+	"\x11client_message_id\x18\r \x01(\tR\x0fclientMessageId\"\xc9\x01\n" +
+	"\x0eChatAttachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x05R\n" +
+	"durationMs\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12$\n" +
+	"\x0eurl_expires_at\x18\a \x01(\tR\furlExpiresAt\"\xc7\x01\n" +
 	"\x06Rating\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -914,7 +2179,116 @@ const file_proto_common_proto_rawDesc = "" +
 	"\vreview_text\x18\x06 \x01(\tR\n" +
 	"reviewText\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\a \x01(\tR\tcreatedAt\"P\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xc3\x01\n" +
+	"\x12CredentialDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x05R\tsizeBytes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\xaf\x03\n" +
+	"\x12AdvisorApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x02 \x01(\tR\tadvisorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\".loveguru.common.ApplicationStatusR\x06status\x12%\n" +
+	"\x0ereviewer_notes\x18\x05 \x01(\tR\rreviewerNotes\x12)\n" +
+	"\x10rejection_reason\x18\x06 \x01(\tR\x0frejectionReason\x12!\n" +
+	"\fsubmitted_at\x18\a \x01(\tR\vsubmittedAt\x12\x1f\n" +
+	"\vreviewed_at\x18\b \x01(\tR\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12A\n" +
+	"\tdocuments\x18\v \x03(\v2#.loveguru.common.CredentialDocumentR\tdocuments\"\xb2\x03\n" +
+	"\x0fEarningLineItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12?\n" +
+	"\fsession_type\x18\x03 \x01(\x0e2\x1c.loveguru.common.SessionTypeR\vsessionType\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x05R\x0fdurationSeconds\x12\x1f\n" +
+	"\vhourly_rate\x18\x05 \x01(\x01R\n" +
+	"hourlyRate\x12!\n" +
+	"\fgross_amount\x18\x06 \x01(\x01R\vgrossAmount\x12-\n" +
+	"\x12commission_percent\x18\a \x01(\x01R\x11commissionPercent\x12+\n" +
+	"\x11commission_amount\x18\b \x01(\x01R\x10commissionAmount\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\t \x01(\x01R\tnetAmount\x12&\n" +
+	"\x0fpayout_batch_id\x18\n" +
+	" \x01(\tR\rpayoutBatchId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\xc5\x02\n" +
+	"\vPayoutBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x02 \x01(\tR\tadvisorId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\fperiod_start\x18\x04 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x05 \x01(\tR\tperiodEnd\x12%\n" +
+	"\x0eearnings_count\x18\x06 \x01(\x05R\rearningsCount\x12!\n" +
+	"\ftotal_amount\x18\a \x01(\x01R\vtotalAmount\x12\x17\n" +
+	"\apaid_at\x18\b \x01(\tR\x06paidAt\x12+\n" +
+	"\x11payment_reference\x18\t \x01(\tR\x10paymentReference\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"\xc7\x01\n" +
+	"\x12BalanceTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x04 \x01(\x01R\fbalanceAfter\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\xee\x01\n" +
+	"\x0eAdvisorPricing\x12!\n" +
+	"\fsession_type\x18\x01 \x01(\tR\vsessionType\x12&\n" +
+	"\x0fper_minute_rate\x18\x02 \x01(\x01R\rperMinuteRate\x120\n" +
+	"\x14min_billable_minutes\x18\x03 \x01(\x05R\x12minBillableMinutes\x12C\n" +
+	"\x1efirst_session_discount_percent\x18\x04 \x01(\x01R\x1bfirstSessionDiscountPercent\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\x82\x02\n" +
+	"\x0fMessageTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x02 \x01(\tR\tadvisorId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vis_platform\x18\x06 \x01(\bR\n" +
+	"isPlatform\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\x96\x03\n" +
+	"\fAdvisorStats\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12#\n" +
+	"\rsession_count\x18\x03 \x01(\x05R\fsessionCount\x12'\n" +
+	"\x0facceptance_rate\x18\x04 \x01(\x01R\x0eacceptanceRate\x12A\n" +
+	"\x1dmedian_first_response_seconds\x18\x05 \x01(\x01R\x1amedianFirstResponseSeconds\x126\n" +
+	"\x17average_session_seconds\x18\x06 \x01(\x01R\x15averageSessionSeconds\x12,\n" +
+	"\x12repeat_client_rate\x18\a \x01(\x01R\x10repeatClientRate\x12!\n" +
+	"\fmissed_calls\x18\b \x01(\x05R\vmissedCalls\x12%\n" +
+	"\x0eaverage_rating\x18\t \x01(\x01R\raverageRating\x12!\n" +
+	"\frating_count\x18\n" +
+	" \x01(\x05R\vratingCount\"{\n" +
+	"\x10RatingTrendPoint\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\tR\tweekStart\x12%\n" +
+	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12!\n" +
+	"\frating_count\x18\x03 \x01(\x05R\vratingCount\"\xce\x01\n" +
+	"\x12AdvisorStatsReport\x127\n" +
+	"\acurrent\x18\x01 \x01(\v2\x1d.loveguru.common.AdvisorStatsR\acurrent\x129\n" +
+	"\bprevious\x18\x02 \x01(\v2\x1d.loveguru.common.AdvisorStatsR\bprevious\x12D\n" +
+	"\frating_trend\x18\x03 \x03(\v2!.loveguru.common.RatingTrendPointR\vratingTrend\"P\n" +
 	"\x06Tokens\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken*(\n" +
@@ -930,17 +2304,31 @@ const file_proto_common_proto_rawDesc = "" +
 	"\vSessionType\x12\b\n" +
 	"\x04CHAT\x10\x00\x12\b\n" +
 	"\x04CALL\x10\x01\x12\v\n" +
-	"\aAI_CHAT\x10\x02*6\n" +
+	"\aAI_CHAT\x10\x02*`\n" +
 	"\rSessionStatus\x12\v\n" +
 	"\aONGOING\x10\x00\x12\t\n" +
 	"\x05ENDED\x10\x01\x12\r\n" +
-	"\tCANCELLED\x10\x02*?\n" +
+	"\tCANCELLED\x10\x02\x12\r\n" +
+	"\tREQUESTED\x10\x03\x12\f\n" +
+	"\bDECLINED\x10\x04\x12\v\n" +
+	"\aEXPIRED\x10\x05*0\n" +
+	"\x10TranscriptFormat\x12\b\n" +
+	"\x04JSON\x10\x00\x12\b\n" +
+	"\x04TEXT\x10\x01\x12\b\n" +
+	"\x04HTML\x10\x02*?\n" +
 	"\rAdvisorStatus\x12\n" +
 	"\n" +
 	"\x06ONLINE\x10\x00\x12\v\n" +
 	"\aOFFLINE\x10\x01\x12\b\n" +
 	"\x04BUSY\x10\x02\x12\v\n" +
-	"\aPENDING\x10\x03B\x17Z\x15loveguru/proto/commonb\x06proto3"
+	"\aPENDING\x10\x03*n\n" +
+	"\x11ApplicationStatus\x12\t\n" +
+	"\x05DRAFT\x10\x00\x12\r\n" +
+	"\tSUBMITTED\x10\x01\x12\x10\n" +
+	"\fUNDER_REVIEW\x10\x02\x12\x11\n" +
+	"\rNEEDS_CHANGES\x10\x03\x12\f\n" +
+	"\bAPPROVED\x10\x04\x12\f\n" +
+	"\bREJECTED\x10\x05B\x17Z\x15loveguru/proto/commonb\x06proto3"
 
 var (
 	file_proto_common_proto_rawDescOnce sync.Once
@@ -954,32 +2342,53 @@ func file_proto_common_proto_rawDescGZIP() []byte {
 	return file_proto_common_proto_rawDescData
 }
 
-var file_proto_common_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_common_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_common_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_common_proto_goTypes = []any{
-	(Role)(0),           // 0: loveguru.common.Role
-	(Gender)(0),         // 1: loveguru.common.Gender
-	(SessionType)(0),    // 2: loveguru.common.SessionType
-	(SessionStatus)(0),  // 3: loveguru.common.SessionStatus
-	(AdvisorStatus)(0),  // 4: loveguru.common.AdvisorStatus
-	(*User)(nil),        // 5: loveguru.common.User
-	(*Advisor)(nil),     // 6: loveguru.common.Advisor
-	(*Session)(nil),     // 7: loveguru.common.Session
-	(*ChatMessage)(nil), // 8: loveguru.common.ChatMessage
-	(*Rating)(nil),      // 9: loveguru.common.Rating
-	(*Tokens)(nil),      // 10: loveguru.common.Tokens
+	(Role)(0),                  // 0: loveguru.common.Role
+	(Gender)(0),                // 1: loveguru.common.Gender
+	(SessionType)(0),           // 2: loveguru.common.SessionType
+	(SessionStatus)(0),         // 3: loveguru.common.SessionStatus
+	(TranscriptFormat)(0),      // 4: loveguru.common.TranscriptFormat
+	(AdvisorStatus)(0),         // 5: loveguru.common.AdvisorStatus
+	(ApplicationStatus)(0),     // 6: loveguru.common.ApplicationStatus
+	(*User)(nil),               // 7: loveguru.common.User
+	(*Advisor)(nil),            // 8: loveguru.common.Advisor
+	(*Session)(nil),            // 9: loveguru.common.Session
+	(*ChatMessage)(nil),        // 10: loveguru.common.ChatMessage
+	(*ChatAttachment)(nil),     // 11: loveguru.common.ChatAttachment
+	(*Rating)(nil),             // 12: loveguru.common.Rating
+	(*CredentialDocument)(nil), // 13: loveguru.common.CredentialDocument
+	(*AdvisorApplication)(nil), // 14: loveguru.common.AdvisorApplication
+	(*EarningLineItem)(nil),    // 15: loveguru.common.EarningLineItem
+	(*PayoutBatch)(nil),        // 16: loveguru.common.PayoutBatch
+	(*BalanceTransaction)(nil), // 17: loveguru.common.BalanceTransaction
+	(*AdvisorPricing)(nil),     // 18: loveguru.common.AdvisorPricing
+	(*MessageTemplate)(nil),    // 19: loveguru.common.MessageTemplate
+	(*AdvisorStats)(nil),       // 20: loveguru.common.AdvisorStats
+	(*RatingTrendPoint)(nil),   // 21: loveguru.common.RatingTrendPoint
+	(*AdvisorStatsReport)(nil), // 22: loveguru.common.AdvisorStatsReport
+	(*Tokens)(nil),             // 23: loveguru.common.Tokens
 }
 var file_proto_common_proto_depIdxs = []int32{
-	0, // 0: loveguru.common.User.role:type_name -> loveguru.common.Role
-	1, // 1: loveguru.common.User.gender:type_name -> loveguru.common.Gender
-	4, // 2: loveguru.common.Advisor.status:type_name -> loveguru.common.AdvisorStatus
-	2, // 3: loveguru.common.Session.type:type_name -> loveguru.common.SessionType
-	3, // 4: loveguru.common.Session.status:type_name -> loveguru.common.SessionStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: loveguru.common.User.role:type_name -> loveguru.common.Role
+	1,  // 1: loveguru.common.User.gender:type_name -> loveguru.common.Gender
+	5,  // 2: loveguru.common.Advisor.status:type_name -> loveguru.common.AdvisorStatus
+	2,  // 3: loveguru.common.Session.type:type_name -> loveguru.common.SessionType
+	3,  // 4: loveguru.common.Session.status:type_name -> loveguru.common.SessionStatus
+	18, // 5: loveguru.common.Session.pricing:type_name -> loveguru.common.AdvisorPricing
+	11, // 6: loveguru.common.ChatMessage.attachment:type_name -> loveguru.common.ChatAttachment
+	6,  // 7: loveguru.common.AdvisorApplication.status:type_name -> loveguru.common.ApplicationStatus
+	13, // 8: loveguru.common.AdvisorApplication.documents:type_name -> loveguru.common.CredentialDocument
+	2,  // 9: loveguru.common.EarningLineItem.session_type:type_name -> loveguru.common.SessionType
+	20, // 10: loveguru.common.AdvisorStatsReport.current:type_name -> loveguru.common.AdvisorStats
+	20, // 11: loveguru.common.AdvisorStatsReport.previous:type_name -> loveguru.common.AdvisorStats
+	21, // 12: loveguru.common.AdvisorStatsReport.rating_trend:type_name -> loveguru.common.RatingTrendPoint
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_common_proto_init() }
@@ -992,8 +2401,8 @@ func file_proto_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_common_proto_rawDesc), len(file_proto_common_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   6,
+			NumEnums:      7,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,692 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.28.3
+// source: proto/queue.proto
+
+package queue
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	common "loveguru/proto/common"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QueueEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdvisorId      string                 `protobuf:"bytes,2,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // WAITING, OFFERED, ACCEPTED, LEFT, EXPIRED
+	SessionId      string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // set once the user reaches the front
+	OfferedAt      string                 `protobuf:"bytes,6,opt,name=offered_at,json=offeredAt,proto3" json:"offered_at,omitempty"`
+	OfferExpiresAt string                 `protobuf:"bytes,7,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	mi := &file_proto_queue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEntry) ProtoMessage() {}
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueueEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetOfferedAt() string {
+	if x != nil {
+		return x.OfferedAt
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetOfferExpiresAt() string {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type QueueStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Entry                *QueueEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Position             int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 1 is the front of the queue
+	EstimatedWaitSeconds int32                  `protobuf:"varint,3,opt,name=estimated_wait_seconds,json=estimatedWaitSeconds,proto3" json:"estimated_wait_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QueueStatus) Reset() {
+	*x = QueueStatus{}
+	mi := &file_proto_queue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatus) ProtoMessage() {}
+
+func (x *QueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatus.ProtoReflect.Descriptor instead.
+func (*QueueStatus) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueueStatus) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *QueueStatus) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetEstimatedWaitSeconds() int32 {
+	if x != nil {
+		return x.EstimatedWaitSeconds
+	}
+	return 0
+}
+
+type AdvisorQueueStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueLength   int32                  `protobuf:"varint,1,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvisorQueueStatus) Reset() {
+	*x = AdvisorQueueStatus{}
+	mi := &file_proto_queue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvisorQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvisorQueueStatus) ProtoMessage() {}
+
+func (x *AdvisorQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvisorQueueStatus.ProtoReflect.Descriptor instead.
+func (*AdvisorQueueStatus) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdvisorQueueStatus) GetQueueLength() int32 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+type JoinQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdvisorId     string                 `protobuf:"bytes,1,opt,name=advisor_id,json=advisorId,proto3" json:"advisor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueRequest) Reset() {
+	*x = JoinQueueRequest{}
+	mi := &file_proto_queue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueRequest) ProtoMessage() {}
+
+func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JoinQueueRequest) GetAdvisorId() string {
+	if x != nil {
+		return x.AdvisorId
+	}
+	return ""
+}
+
+type JoinQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *QueueStatus           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueResponse) Reset() {
+	*x = JoinQueueResponse{}
+	mi := &file_proto_queue_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueResponse) ProtoMessage() {}
+
+func (x *JoinQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueResponse.ProtoReflect.Descriptor instead.
+func (*JoinQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JoinQueueResponse) GetStatus() *QueueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type LeaveQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveQueueRequest) Reset() {
+	*x = LeaveQueueRequest{}
+	mi := &file_proto_queue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueueRequest) ProtoMessage() {}
+
+func (x *LeaveQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueueRequest.ProtoReflect.Descriptor instead.
+func (*LeaveQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LeaveQueueRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type LeaveQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveQueueResponse) Reset() {
+	*x = LeaveQueueResponse{}
+	mi := &file_proto_queue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueueResponse) ProtoMessage() {}
+
+func (x *LeaveQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueueResponse.ProtoReflect.Descriptor instead.
+func (*LeaveQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LeaveQueueResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AcceptQueueOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptQueueOfferRequest) Reset() {
+	*x = AcceptQueueOfferRequest{}
+	mi := &file_proto_queue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptQueueOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptQueueOfferRequest) ProtoMessage() {}
+
+func (x *AcceptQueueOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptQueueOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptQueueOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptQueueOfferRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type AcceptQueueOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *common.Session        `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptQueueOfferResponse) Reset() {
+	*x = AcceptQueueOfferResponse{}
+	mi := &file_proto_queue_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptQueueOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptQueueOfferResponse) ProtoMessage() {}
+
+func (x *AcceptQueueOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptQueueOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptQueueOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptQueueOfferResponse) GetSession() *common.Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type WatchQueuePositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQueuePositionRequest) Reset() {
+	*x = WatchQueuePositionRequest{}
+	mi := &file_proto_queue_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQueuePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQueuePositionRequest) ProtoMessage() {}
+
+func (x *WatchQueuePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchQueuePositionRequest.ProtoReflect.Descriptor instead.
+func (*WatchQueuePositionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchQueuePositionRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+type WatchAdvisorQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAdvisorQueueRequest) Reset() {
+	*x = WatchAdvisorQueueRequest{}
+	mi := &file_proto_queue_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAdvisorQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAdvisorQueueRequest) ProtoMessage() {}
+
+func (x *WatchAdvisorQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_queue_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAdvisorQueueRequest.ProtoReflect.Descriptor instead.
+func (*WatchAdvisorQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_queue_proto_rawDescGZIP(), []int{10}
+}
+
+var File_proto_queue_proto protoreflect.FileDescriptor
+
+const file_proto_queue_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/queue.proto\x12\x0eloveguru.queue\x1a\x12proto/common.proto\"\xf3\x01\n" +
+	"\n" +
+	"QueueEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x02 \x01(\tR\tadvisorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"offered_at\x18\x06 \x01(\tR\tofferedAt\x12(\n" +
+	"\x10offer_expires_at\x18\a \x01(\tR\x0eofferExpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x91\x01\n" +
+	"\vQueueStatus\x120\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1a.loveguru.queue.QueueEntryR\x05entry\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x124\n" +
+	"\x16estimated_wait_seconds\x18\x03 \x01(\x05R\x14estimatedWaitSeconds\"7\n" +
+	"\x12AdvisorQueueStatus\x12!\n" +
+	"\fqueue_length\x18\x01 \x01(\x05R\vqueueLength\"1\n" +
+	"\x10JoinQueueRequest\x12\x1d\n" +
+	"\n" +
+	"advisor_id\x18\x01 \x01(\tR\tadvisorId\"H\n" +
+	"\x11JoinQueueResponse\x123\n" +
+	"\x06status\x18\x01 \x01(\v2\x1b.loveguru.queue.QueueStatusR\x06status\".\n" +
+	"\x11LeaveQueueRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\".\n" +
+	"\x12LeaveQueueResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x17AcceptQueueOfferRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\"N\n" +
+	"\x18AcceptQueueOfferResponse\x122\n" +
+	"\asession\x18\x01 \x01(\v2\x18.loveguru.common.SessionR\asession\"6\n" +
+	"\x19WatchQueuePositionRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\"\x1a\n" +
+	"\x18WatchAdvisorQueueRequest2\xe1\x03\n" +
+	"\fQueueService\x12P\n" +
+	"\tJoinQueue\x12 .loveguru.queue.JoinQueueRequest\x1a!.loveguru.queue.JoinQueueResponse\x12S\n" +
+	"\n" +
+	"LeaveQueue\x12!.loveguru.queue.LeaveQueueRequest\x1a\".loveguru.queue.LeaveQueueResponse\x12e\n" +
+	"\x10AcceptQueueOffer\x12'.loveguru.queue.AcceptQueueOfferRequest\x1a(.loveguru.queue.AcceptQueueOfferResponse\x12^\n" +
+	"\x12WatchQueuePosition\x12).loveguru.queue.WatchQueuePositionRequest\x1a\x1b.loveguru.queue.QueueStatus0\x01\x12c\n" +
+	"\x11WatchAdvisorQueue\x12(.loveguru.queue.WatchAdvisorQueueRequest\x1a\".loveguru.queue.AdvisorQueueStatus0\x01B\x16Z\x14loveguru/proto/queueb\x06proto3"
+
+var (
+	file_proto_queue_proto_rawDescOnce sync.Once
+	file_proto_queue_proto_rawDescData []byte
+)
+
+func file_proto_queue_proto_rawDescGZIP() []byte {
+	file_proto_queue_proto_rawDescOnce.Do(func() {
+		file_proto_queue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_queue_proto_rawDesc), len(file_proto_queue_proto_rawDesc)))
+	})
+	return file_proto_queue_proto_rawDescData
+}
+
+var file_proto_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_queue_proto_goTypes = []any{
+	(*QueueEntry)(nil),                // 0: loveguru.queue.QueueEntry
+	(*QueueStatus)(nil),               // 1: loveguru.queue.QueueStatus
+	(*AdvisorQueueStatus)(nil),        // 2: loveguru.queue.AdvisorQueueStatus
+	(*JoinQueueRequest)(nil),          // 3: loveguru.queue.JoinQueueRequest
+	(*JoinQueueResponse)(nil),         // 4: loveguru.queue.JoinQueueResponse
+	(*LeaveQueueRequest)(nil),         // 5: loveguru.queue.LeaveQueueRequest
+	(*LeaveQueueResponse)(nil),        // 6: loveguru.queue.LeaveQueueResponse
+	(*AcceptQueueOfferRequest)(nil),   // 7: loveguru.queue.AcceptQueueOfferRequest
+	(*AcceptQueueOfferResponse)(nil),  // 8: loveguru.queue.AcceptQueueOfferResponse
+	(*WatchQueuePositionRequest)(nil), // 9: loveguru.queue.WatchQueuePositionRequest
+	(*WatchAdvisorQueueRequest)(nil),  // 10: loveguru.queue.WatchAdvisorQueueRequest
+	(*common.Session)(nil),            // 11: loveguru.common.Session
+}
+var file_proto_queue_proto_depIdxs = []int32{
+	0,  // 0: loveguru.queue.QueueStatus.entry:type_name -> loveguru.queue.QueueEntry
+	1,  // 1: loveguru.queue.JoinQueueResponse.status:type_name -> loveguru.queue.QueueStatus
+	11, // 2: loveguru.queue.AcceptQueueOfferResponse.session:type_name -> loveguru.common.Session
+	3,  // 3: loveguru.queue.QueueService.JoinQueue:input_type -> loveguru.queue.JoinQueueRequest
+	5,  // 4: loveguru.queue.QueueService.LeaveQueue:input_type -> loveguru.queue.LeaveQueueRequest
+	7,  // 5: loveguru.queue.QueueService.AcceptQueueOffer:input_type -> loveguru.queue.AcceptQueueOfferRequest
+	9,  // 6: loveguru.queue.QueueService.WatchQueuePosition:input_type -> loveguru.queue.WatchQueuePositionRequest
+	10, // 7: loveguru.queue.QueueService.WatchAdvisorQueue:input_type -> loveguru.queue.WatchAdvisorQueueRequest
+	4,  // 8: loveguru.queue.QueueService.JoinQueue:output_type -> loveguru.queue.JoinQueueResponse
+	6,  // 9: loveguru.queue.QueueService.LeaveQueue:output_type -> loveguru.queue.LeaveQueueResponse
+	8,  // 10: loveguru.queue.QueueService.AcceptQueueOffer:output_type -> loveguru.queue.AcceptQueueOfferResponse
+	1,  // 11: loveguru.queue.QueueService.WatchQueuePosition:output_type -> loveguru.queue.QueueStatus
+	2,  // 12: loveguru.queue.QueueService.WatchAdvisorQueue:output_type -> loveguru.queue.AdvisorQueueStatus
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_queue_proto_init() }
+func file_proto_queue_proto_init() {
+	if File_proto_queue_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_queue_proto_rawDesc), len(file_proto_queue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_queue_proto_goTypes,
+		DependencyIndexes: file_proto_queue_proto_depIdxs,
+		MessageInfos:      file_proto_queue_proto_msgTypes,
+	}.Build()
+	File_proto_queue_proto = out.File
+	file_proto_queue_proto_goTypes = nil
+	file_proto_queue_proto_depIdxs = nil
+}
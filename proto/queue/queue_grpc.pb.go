@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.28.3
+// source: proto/queue.proto
+
+package queue
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QueueService_JoinQueue_FullMethodName          = "/loveguru.queue.QueueService/JoinQueue"
+	QueueService_LeaveQueue_FullMethodName         = "/loveguru.queue.QueueService/LeaveQueue"
+	QueueService_AcceptQueueOffer_FullMethodName   = "/loveguru.queue.QueueService/AcceptQueueOffer"
+	QueueService_WatchQueuePosition_FullMethodName = "/loveguru.queue.QueueService/WatchQueuePosition"
+	QueueService_WatchAdvisorQueue_FullMethodName  = "/loveguru.queue.QueueService/WatchAdvisorQueue"
+)
+
+// QueueServiceClient is the client API for QueueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueueServiceClient interface {
+	JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (*JoinQueueResponse, error)
+	LeaveQueue(ctx context.Context, in *LeaveQueueRequest, opts ...grpc.CallOption) (*LeaveQueueResponse, error)
+	AcceptQueueOffer(ctx context.Context, in *AcceptQueueOfferRequest, opts ...grpc.CallOption) (*AcceptQueueOfferResponse, error)
+	WatchQueuePosition(ctx context.Context, in *WatchQueuePositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueueStatus], error)
+	WatchAdvisorQueue(ctx context.Context, in *WatchAdvisorQueueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AdvisorQueueStatus], error)
+}
+
+type queueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueueServiceClient(cc grpc.ClientConnInterface) QueueServiceClient {
+	return &queueServiceClient{cc}
+}
+
+func (c *queueServiceClient) JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (*JoinQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinQueueResponse)
+	err := c.cc.Invoke(ctx, QueueService_JoinQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) LeaveQueue(ctx context.Context, in *LeaveQueueRequest, opts ...grpc.CallOption) (*LeaveQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveQueueResponse)
+	err := c.cc.Invoke(ctx, QueueService_LeaveQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) AcceptQueueOffer(ctx context.Context, in *AcceptQueueOfferRequest, opts ...grpc.CallOption) (*AcceptQueueOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptQueueOfferResponse)
+	err := c.cc.Invoke(ctx, QueueService_AcceptQueueOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) WatchQueuePosition(ctx context.Context, in *WatchQueuePositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueueStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QueueService_ServiceDesc.Streams[0], QueueService_WatchQueuePosition_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchQueuePositionRequest, QueueStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QueueService_WatchQueuePositionClient = grpc.ServerStreamingClient[QueueStatus]
+
+func (c *queueServiceClient) WatchAdvisorQueue(ctx context.Context, in *WatchAdvisorQueueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AdvisorQueueStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QueueService_ServiceDesc.Streams[1], QueueService_WatchAdvisorQueue_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAdvisorQueueRequest, AdvisorQueueStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QueueService_WatchAdvisorQueueClient = grpc.ServerStreamingClient[AdvisorQueueStatus]
+
+// QueueServiceServer is the server API for QueueService service.
+// All implementations must embed UnimplementedQueueServiceServer
+// for forward compatibility.
+type QueueServiceServer interface {
+	JoinQueue(context.Context, *JoinQueueRequest) (*JoinQueueResponse, error)
+	LeaveQueue(context.Context, *LeaveQueueRequest) (*LeaveQueueResponse, error)
+	AcceptQueueOffer(context.Context, *AcceptQueueOfferRequest) (*AcceptQueueOfferResponse, error)
+	WatchQueuePosition(*WatchQueuePositionRequest, grpc.ServerStreamingServer[QueueStatus]) error
+	WatchAdvisorQueue(*WatchAdvisorQueueRequest, grpc.ServerStreamingServer[AdvisorQueueStatus]) error
+	mustEmbedUnimplementedQueueServiceServer()
+}
+
+// UnimplementedQueueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueueServiceServer struct{}
+
+func (UnimplementedQueueServiceServer) JoinQueue(context.Context, *JoinQueueRequest) (*JoinQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) LeaveQueue(context.Context, *LeaveQueueRequest) (*LeaveQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) AcceptQueueOffer(context.Context, *AcceptQueueOfferRequest) (*AcceptQueueOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptQueueOffer not implemented")
+}
+func (UnimplementedQueueServiceServer) WatchQueuePosition(*WatchQueuePositionRequest, grpc.ServerStreamingServer[QueueStatus]) error {
+	return status.Error(codes.Unimplemented, "method WatchQueuePosition not implemented")
+}
+func (UnimplementedQueueServiceServer) WatchAdvisorQueue(*WatchAdvisorQueueRequest, grpc.ServerStreamingServer[AdvisorQueueStatus]) error {
+	return status.Error(codes.Unimplemented, "method WatchAdvisorQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) mustEmbedUnimplementedQueueServiceServer() {}
+func (UnimplementedQueueServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeQueueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueueServiceServer will
+// result in compilation errors.
+type UnsafeQueueServiceServer interface {
+	mustEmbedUnimplementedQueueServiceServer()
+}
+
+func RegisterQueueServiceServer(s grpc.ServiceRegistrar, srv QueueServiceServer) {
+	// If the following call panics, it indicates UnimplementedQueueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QueueService_ServiceDesc, srv)
+}
+
+func _QueueService_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_JoinQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).JoinQueue(ctx, req.(*JoinQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_LeaveQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).LeaveQueue(ctx, req.(*LeaveQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_AcceptQueueOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptQueueOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).AcceptQueueOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueueService_AcceptQueueOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).AcceptQueueOffer(ctx, req.(*AcceptQueueOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_WatchQueuePosition_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueuePositionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServiceServer).WatchQueuePosition(m, &grpc.GenericServerStream[WatchQueuePositionRequest, QueueStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QueueService_WatchQueuePositionServer = grpc.ServerStreamingServer[QueueStatus]
+
+func _QueueService_WatchAdvisorQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAdvisorQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServiceServer).WatchAdvisorQueue(m, &grpc.GenericServerStream[WatchAdvisorQueueRequest, AdvisorQueueStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QueueService_WatchAdvisorQueueServer = grpc.ServerStreamingServer[AdvisorQueueStatus]
+
+// QueueService_ServiceDesc is the grpc.ServiceDesc for QueueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QueueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loveguru.queue.QueueService",
+	HandlerType: (*QueueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinQueue",
+			Handler:    _QueueService_JoinQueue_Handler,
+		},
+		{
+			MethodName: "LeaveQueue",
+			Handler:    _QueueService_LeaveQueue_Handler,
+		},
+		{
+			MethodName: "AcceptQueueOffer",
+			Handler:    _QueueService_AcceptQueueOffer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueuePosition",
+			Handler:       _QueueService_WatchQueuePosition_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchAdvisorQueue",
+			Handler:       _QueueService_WatchAdvisorQueue_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/queue.proto",
+}
@@ -303,6 +303,543 @@ func (x *GetSessionsResponse) GetSessions() []*common.Session {
 	return nil
 }
 
+type CreateAnonymousProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Gender        common.Gender          `protobuf:"varint,2,opt,name=gender,proto3,enum=loveguru.common.Gender" json:"gender,omitempty"`
+	Dob           string                 `protobuf:"bytes,3,opt,name=dob,proto3" json:"dob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnonymousProfileRequest) Reset() {
+	*x = CreateAnonymousProfileRequest{}
+	mi := &file_proto_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnonymousProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnonymousProfileRequest) ProtoMessage() {}
+
+func (x *CreateAnonymousProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnonymousProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnonymousProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateAnonymousProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateAnonymousProfileRequest) GetGender() common.Gender {
+	if x != nil {
+		return x.Gender
+	}
+	return common.Gender(0)
+}
+
+func (x *CreateAnonymousProfileRequest) GetDob() string {
+	if x != nil {
+		return x.Dob
+	}
+	return ""
+}
+
+type CreateAnonymousProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *common.User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Tokens        *common.Tokens         `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnonymousProfileResponse) Reset() {
+	*x = CreateAnonymousProfileResponse{}
+	mi := &file_proto_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnonymousProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnonymousProfileResponse) ProtoMessage() {}
+
+func (x *CreateAnonymousProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnonymousProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateAnonymousProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateAnonymousProfileResponse) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CreateAnonymousProfileResponse) GetTokens() *common.Tokens {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type ConvertAnonymousToFullRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAnonymousToFullRequest) Reset() {
+	*x = ConvertAnonymousToFullRequest{}
+	mi := &file_proto_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAnonymousToFullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAnonymousToFullRequest) ProtoMessage() {}
+
+func (x *ConvertAnonymousToFullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAnonymousToFullRequest.ProtoReflect.Descriptor instead.
+func (*ConvertAnonymousToFullRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConvertAnonymousToFullRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ConvertAnonymousToFullRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ConvertAnonymousToFullRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ConvertAnonymousToFullResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *common.User           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Tokens        *common.Tokens         `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAnonymousToFullResponse) Reset() {
+	*x = ConvertAnonymousToFullResponse{}
+	mi := &file_proto_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAnonymousToFullResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAnonymousToFullResponse) ProtoMessage() {}
+
+func (x *ConvertAnonymousToFullResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAnonymousToFullResponse.ProtoReflect.Descriptor instead.
+func (*ConvertAnonymousToFullResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConvertAnonymousToFullResponse) GetUser() *common.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ConvertAnonymousToFullResponse) GetTokens() *common.Tokens {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type ForgotPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordRequest) Reset() {
+	*x = ForgotPasswordRequest{}
+	mi := &file_proto_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordRequest) ProtoMessage() {}
+
+func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ForgotPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ForgotPasswordRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type ForgotPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Otp           string                 `protobuf:"bytes,2,opt,name=otp,proto3" json:"otp,omitempty"` // In production, don't return OTP in response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordResponse) Reset() {
+	*x = ForgotPasswordResponse{}
+	mi := &file_proto_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordResponse) ProtoMessage() {}
+
+func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ForgotPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ForgotPasswordResponse) GetOtp() string {
+	if x != nil {
+		return x.Otp
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Otp           string                 `protobuf:"bytes,3,opt,name=otp,proto3" json:"otp,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_proto_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResetPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetOtp() string {
+	if x != nil {
+		return x.Otp
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_proto_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResetPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// authenticated user
+	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // recent transactions, 20 by default, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_proto_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBalanceRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Balance       float64                      `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Transactions  []*common.BalanceTransaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_proto_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBalanceResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetTransactions() []*common.BalanceTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
 var File_proto_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_proto_rawDesc = "" +
@@ -321,12 +858,50 @@ const file_proto_user_proto_rawDesc = "" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\"K\n" +
 	"\x13GetSessionsResponse\x124\n" +
-	"\bsessions\x18\x01 \x03(\v2\x18.loveguru.common.SessionR\bsessions2\x92\x02\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.loveguru.common.SessionR\bsessions\"\x85\x01\n" +
+	"\x1dCreateAnonymousProfileRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12/\n" +
+	"\x06gender\x18\x02 \x01(\x0e2\x17.loveguru.common.GenderR\x06gender\x12\x10\n" +
+	"\x03dob\x18\x03 \x01(\tR\x03dob\"|\n" +
+	"\x1eCreateAnonymousProfileResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.loveguru.common.UserR\x04user\x12/\n" +
+	"\x06tokens\x18\x02 \x01(\v2\x17.loveguru.common.TokensR\x06tokens\"g\n" +
+	"\x1dConvertAnonymousToFullRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"|\n" +
+	"\x1eConvertAnonymousToFullResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.loveguru.common.UserR\x04user\x12/\n" +
+	"\x06tokens\x18\x02 \x01(\v2\x17.loveguru.common.TokensR\x06tokens\"C\n" +
+	"\x15ForgotPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\"D\n" +
+	"\x16ForgotPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x10\n" +
+	"\x03otp\x18\x02 \x01(\tR\x03otp\"w\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x10\n" +
+	"\x03otp\x18\x03 \x01(\tR\x03otp\x12!\n" +
+	"\fnew_password\x18\x04 \x01(\tR\vnewPassword\"1\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\")\n" +
+	"\x11GetBalanceRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"w\n" +
+	"\x12GetBalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\x12G\n" +
+	"\ftransactions\x18\x02 \x03(\v2#.loveguru.common.BalanceTransactionR\ftransactions2\x8e\x06\n" +
 	"\vUserService\x12Q\n" +
 	"\n" +
 	"GetProfile\x12 .loveguru.user.GetProfileRequest\x1a!.loveguru.user.GetProfileResponse\x12Z\n" +
 	"\rUpdateProfile\x12#.loveguru.user.UpdateProfileRequest\x1a$.loveguru.user.UpdateProfileResponse\x12T\n" +
-	"\vGetSessions\x12!.loveguru.user.GetSessionsRequest\x1a\".loveguru.user.GetSessionsResponseB\x15Z\x13loveguru/proto/userb\x06proto3"
+	"\vGetSessions\x12!.loveguru.user.GetSessionsRequest\x1a\".loveguru.user.GetSessionsResponse\x12u\n" +
+	"\x16CreateAnonymousProfile\x12,.loveguru.user.CreateAnonymousProfileRequest\x1a-.loveguru.user.CreateAnonymousProfileResponse\x12u\n" +
+	"\x16ConvertAnonymousToFull\x12,.loveguru.user.ConvertAnonymousToFullRequest\x1a-.loveguru.user.ConvertAnonymousToFullResponse\x12]\n" +
+	"\x0eForgotPassword\x12$.loveguru.user.ForgotPasswordRequest\x1a%.loveguru.user.ForgotPasswordResponse\x12Z\n" +
+	"\rResetPassword\x12#.loveguru.user.ResetPasswordRequest\x1a$.loveguru.user.ResetPasswordResponse\x12Q\n" +
+	"\n" +
+	"GetBalance\x12 .loveguru.user.GetBalanceRequest\x1a!.loveguru.user.GetBalanceResponseB\x15Z\x13loveguru/proto/userb\x06proto3"
 
 var (
 	file_proto_user_proto_rawDescOnce sync.Once
@@ -340,34 +915,62 @@ func file_proto_user_proto_rawDescGZIP() []byte {
 	return file_proto_user_proto_rawDescData
 }
 
-var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_proto_user_proto_goTypes = []any{
-	(*GetProfileRequest)(nil),     // 0: loveguru.user.GetProfileRequest
-	(*GetProfileResponse)(nil),    // 1: loveguru.user.GetProfileResponse
-	(*UpdateProfileRequest)(nil),  // 2: loveguru.user.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil), // 3: loveguru.user.UpdateProfileResponse
-	(*GetSessionsRequest)(nil),    // 4: loveguru.user.GetSessionsRequest
-	(*GetSessionsResponse)(nil),   // 5: loveguru.user.GetSessionsResponse
-	(*common.User)(nil),           // 6: loveguru.common.User
-	(common.Gender)(0),            // 7: loveguru.common.Gender
-	(*common.Session)(nil),        // 8: loveguru.common.Session
+	(*GetProfileRequest)(nil),              // 0: loveguru.user.GetProfileRequest
+	(*GetProfileResponse)(nil),             // 1: loveguru.user.GetProfileResponse
+	(*UpdateProfileRequest)(nil),           // 2: loveguru.user.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),          // 3: loveguru.user.UpdateProfileResponse
+	(*GetSessionsRequest)(nil),             // 4: loveguru.user.GetSessionsRequest
+	(*GetSessionsResponse)(nil),            // 5: loveguru.user.GetSessionsResponse
+	(*CreateAnonymousProfileRequest)(nil),  // 6: loveguru.user.CreateAnonymousProfileRequest
+	(*CreateAnonymousProfileResponse)(nil), // 7: loveguru.user.CreateAnonymousProfileResponse
+	(*ConvertAnonymousToFullRequest)(nil),  // 8: loveguru.user.ConvertAnonymousToFullRequest
+	(*ConvertAnonymousToFullResponse)(nil), // 9: loveguru.user.ConvertAnonymousToFullResponse
+	(*ForgotPasswordRequest)(nil),          // 10: loveguru.user.ForgotPasswordRequest
+	(*ForgotPasswordResponse)(nil),         // 11: loveguru.user.ForgotPasswordResponse
+	(*ResetPasswordRequest)(nil),           // 12: loveguru.user.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),          // 13: loveguru.user.ResetPasswordResponse
+	(*GetBalanceRequest)(nil),              // 14: loveguru.user.GetBalanceRequest
+	(*GetBalanceResponse)(nil),             // 15: loveguru.user.GetBalanceResponse
+	(*common.User)(nil),                    // 16: loveguru.common.User
+	(common.Gender)(0),                     // 17: loveguru.common.Gender
+	(*common.Session)(nil),                 // 18: loveguru.common.Session
+	(*common.Tokens)(nil),                  // 19: loveguru.common.Tokens
+	(*common.BalanceTransaction)(nil),      // 20: loveguru.common.BalanceTransaction
 }
 var file_proto_user_proto_depIdxs = []int32{
-	6, // 0: loveguru.user.GetProfileResponse.user:type_name -> loveguru.common.User
-	7, // 1: loveguru.user.UpdateProfileRequest.gender:type_name -> loveguru.common.Gender
-	6, // 2: loveguru.user.UpdateProfileResponse.user:type_name -> loveguru.common.User
-	8, // 3: loveguru.user.GetSessionsResponse.sessions:type_name -> loveguru.common.Session
-	0, // 4: loveguru.user.UserService.GetProfile:input_type -> loveguru.user.GetProfileRequest
-	2, // 5: loveguru.user.UserService.UpdateProfile:input_type -> loveguru.user.UpdateProfileRequest
-	4, // 6: loveguru.user.UserService.GetSessions:input_type -> loveguru.user.GetSessionsRequest
-	1, // 7: loveguru.user.UserService.GetProfile:output_type -> loveguru.user.GetProfileResponse
-	3, // 8: loveguru.user.UserService.UpdateProfile:output_type -> loveguru.user.UpdateProfileResponse
-	5, // 9: loveguru.user.UserService.GetSessions:output_type -> loveguru.user.GetSessionsResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	16, // 0: loveguru.user.GetProfileResponse.user:type_name -> loveguru.common.User
+	17, // 1: loveguru.user.UpdateProfileRequest.gender:type_name -> loveguru.common.Gender
+	16, // 2: loveguru.user.UpdateProfileResponse.user:type_name -> loveguru.common.User
+	18, // 3: loveguru.user.GetSessionsResponse.sessions:type_name -> loveguru.common.Session
+	17, // 4: loveguru.user.CreateAnonymousProfileRequest.gender:type_name -> loveguru.common.Gender
+	16, // 5: loveguru.user.CreateAnonymousProfileResponse.user:type_name -> loveguru.common.User
+	19, // 6: loveguru.user.CreateAnonymousProfileResponse.tokens:type_name -> loveguru.common.Tokens
+	16, // 7: loveguru.user.ConvertAnonymousToFullResponse.user:type_name -> loveguru.common.User
+	19, // 8: loveguru.user.ConvertAnonymousToFullResponse.tokens:type_name -> loveguru.common.Tokens
+	20, // 9: loveguru.user.GetBalanceResponse.transactions:type_name -> loveguru.common.BalanceTransaction
+	0,  // 10: loveguru.user.UserService.GetProfile:input_type -> loveguru.user.GetProfileRequest
+	2,  // 11: loveguru.user.UserService.UpdateProfile:input_type -> loveguru.user.UpdateProfileRequest
+	4,  // 12: loveguru.user.UserService.GetSessions:input_type -> loveguru.user.GetSessionsRequest
+	6,  // 13: loveguru.user.UserService.CreateAnonymousProfile:input_type -> loveguru.user.CreateAnonymousProfileRequest
+	8,  // 14: loveguru.user.UserService.ConvertAnonymousToFull:input_type -> loveguru.user.ConvertAnonymousToFullRequest
+	10, // 15: loveguru.user.UserService.ForgotPassword:input_type -> loveguru.user.ForgotPasswordRequest
+	12, // 16: loveguru.user.UserService.ResetPassword:input_type -> loveguru.user.ResetPasswordRequest
+	14, // 17: loveguru.user.UserService.GetBalance:input_type -> loveguru.user.GetBalanceRequest
+	1,  // 18: loveguru.user.UserService.GetProfile:output_type -> loveguru.user.GetProfileResponse
+	3,  // 19: loveguru.user.UserService.UpdateProfile:output_type -> loveguru.user.UpdateProfileResponse
+	5,  // 20: loveguru.user.UserService.GetSessions:output_type -> loveguru.user.GetSessionsResponse
+	7,  // 21: loveguru.user.UserService.CreateAnonymousProfile:output_type -> loveguru.user.CreateAnonymousProfileResponse
+	9,  // 22: loveguru.user.UserService.ConvertAnonymousToFull:output_type -> loveguru.user.ConvertAnonymousToFullResponse
+	11, // 23: loveguru.user.UserService.ForgotPassword:output_type -> loveguru.user.ForgotPasswordResponse
+	13, // 24: loveguru.user.UserService.ResetPassword:output_type -> loveguru.user.ResetPasswordResponse
+	15, // 25: loveguru.user.UserService.GetBalance:output_type -> loveguru.user.GetBalanceResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_proto_init() }
@@ -381,7 +984,7 @@ func file_proto_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_proto_rawDesc), len(file_proto_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},